@@ -277,3 +277,14 @@ func UnpackRevert(data []byte) (string, error) {
 	}
 	return unpacked[0].(string), nil
 }
+
+// PackRevert encodes the revert reason as if it were a call to a function
+// `Error(string)`, it's the reverse of UnpackRevert.
+func PackRevert(reason string) ([]byte, error) {
+	typ, _ := NewType("string", "", nil)
+	packed, err := (Arguments{{Type: typ}}).Pack(reason)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.CopyBytes(revertSelector), packed...), nil
+}
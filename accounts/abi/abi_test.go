@@ -1146,3 +1146,19 @@ func TestUnpackRevert(t *testing.T) {
 		})
 	}
 }
+
+func TestPackRevert(t *testing.T) {
+	for _, reason := range []string{"", "revert reason", strings.Repeat("long revert reason ", 10)} {
+		packed, err := PackRevert(reason)
+		if err != nil {
+			t.Fatalf("pack revert reason %q failed: %v", reason, err)
+		}
+		got, err := UnpackRevert(packed)
+		if err != nil {
+			t.Fatalf("unpack revert reason %q failed: %v", reason, err)
+		}
+		if got != reason {
+			t.Errorf("revert reason mismatch, want %q, got %q", reason, got)
+		}
+	}
+}
@@ -144,6 +144,13 @@ func makeFullNode(ctx *cli.Context) (*node.Node, ethapi.Backend) {
 	if ctx.GlobalIsSet(utils.OverrideLondonFlag.Name) {
 		cfg.Eth.OverrideLondon = new(big.Int).SetUint64(ctx.GlobalUint64(utils.OverrideLondonFlag.Name))
 	}
+	if ctx.GlobalIsSet(utils.OverrideHotStuffForkFlag.Name) {
+		fork, err := params.LoadHotStuffFork(ctx.GlobalString(utils.OverrideHotStuffForkFlag.Name))
+		if err != nil {
+			utils.Fatalf("Failed to load HotStuff fork override: %v", err)
+		}
+		cfg.Eth.OverrideHotStuffFork = fork
+	}
 	backend, eth := utils.RegisterEthService(stack, &cfg.Eth)
 
 	// Configure catalyst.
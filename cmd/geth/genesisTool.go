@@ -20,12 +20,22 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/tool"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/metrics"
 	"gopkg.in/urfave/cli.v1"
 )
 
@@ -42,8 +52,17 @@ var (
 					basePathFlag,
 					nodeCountFlag,
 					nodePassFlag,
+					manifestFlag,
 				},
 				Action: utils.MigrateFlags(generateMaasGensis),
+				Description: `
+The generate command creates the genesis and node keys of a local network with --nodeCount nodes.
+
+With --manifest, the network is described by a JSON manifest of validator hosts, ports and
+existing keys, pre-allocated balances and maas config governance. Besides genesis.json and
+nodes.json, every node gets a directory containing its genesis.json, config.toml, static and
+trusted node lists, and the node key and keystore if the key is known to the tool. A
+docker-compose.yml and systemd units are generated if configured in the manifest.`,
 			},
 		},
 	}
@@ -62,6 +81,10 @@ var (
 		Name:  "nodePass",
 		Usage: "The node password to generate keystore json",
 	}
+	manifestFlag = cli.StringFlag{
+		Name:  "manifest",
+		Usage: "The JSON manifest of network hosts, validator keys, balances and governance",
+	}
 )
 
 type KeystoreFile struct {
@@ -78,6 +101,16 @@ func generateMaasGensis(ctx *cli.Context) error {
 	} else if basePath[len(basePath)-1:] != "/" {
 		basePath += "/"
 	}
+	if file := ctx.String(manifestFlag.Name); file != "" {
+		manifest, err := utils.LoadMaasManifest(file)
+		if err != nil {
+			utils.Fatalf("Failed to load manifest: %v", err)
+		}
+		if err := generateMaasNetwork(manifest, basePath, ctx.String(nodePassFlag.Name)); err != nil {
+			utils.Fatalf("Failed to generate network: %v", err)
+		}
+		return nil
+	}
 	nodeNum := ctx.Int(nodeCountFlag.Name)
 	if nodeNum < 4 {
 		utils.Fatalf("got %v nodes, but hotstuff BFT requires at least 4 nodes", nodeNum)
@@ -119,10 +152,7 @@ func generateMaasGensis(ctx *cli.Context) error {
 		utils.Fatalf(err.Error())
 	}
 
-	genesis.Alloc = make(map[string]struct {
-		PublicKey string `json:"publicKey"`
-		Balance   string `json:"balance"`
-	}, 0)
+	genesis.Alloc = make(map[string]utils.MaasGenesisAccount, 0)
 
 	for _, v := range sortedNodes {
 		nodeInf, err := tool.NodeKey2NodeInfo(v.NodeKey)
@@ -135,12 +165,9 @@ func generateMaasGensis(ctx *cli.Context) error {
 			utils.Fatalf(err.Error())
 		}
 
-		genesis.Alloc[v.Address] = struct {
-			PublicKey string `json:"publicKey"`
-			Balance   string `json:"balance"`
-		}{
-			pubInf,
-			"100000000000000000000000000000",
+		genesis.Alloc[v.Address] = utils.MaasGenesisAccount{
+			PublicKey: pubInf,
+			Balance:   utils.DefaultMaasBalance,
 		}
 		var keystoreObj KeystoreFile
 		json.Unmarshal([]byte(v.KeyStore), &keystoreObj)
@@ -179,3 +206,142 @@ func generateMaasGensis(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+// generateMaasNetwork writes the genesis and the files of every node described by manifest into basePath.
+func generateMaasNetwork(manifest *utils.MaasManifest, basePath, nodePass string) error {
+	for _, node := range manifest.Nodes {
+		if node.HasKey() && nodePass == "" {
+			return fmt.Errorf("node password is required to generate keystore of %s", node.Name)
+		}
+	}
+
+	genesis, err := manifest.Genesis()
+	if err != nil {
+		return err
+	}
+	genesisJson, err := genesis.Encode()
+	if err != nil {
+		return err
+	}
+	if err := writeNetworkFile(filepath.Join(basePath, "genesis.json"), []byte(genesisJson), 0644); err != nil {
+		return err
+	}
+
+	metaNodes := make([]*utils.Node, 0, len(manifest.Nodes))
+	for _, node := range manifest.Nodes {
+		metaNode, err := generateMaasNode(manifest, node, filepath.Join(basePath, node.Name), genesisJson, nodePass)
+		if err != nil {
+			return err
+		}
+		metaNodes = append(metaNodes, metaNode)
+	}
+	nodesJson, _ := json.MarshalIndent(metaNodes, "", "\t")
+	if err := writeNetworkFile(filepath.Join(basePath, "nodes.json"), nodesJson, 0600); err != nil {
+		return err
+	}
+
+	if manifest.Docker != nil {
+		compose, err := manifest.DockerCompose()
+		if err != nil {
+			return err
+		}
+		if err := writeNetworkFile(filepath.Join(basePath, "docker-compose.yml"), []byte(compose), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateMaasNode writes the files of node into nodePath, which should be deployed to the node
+// directory on its host. The static and trusted nodes are written into the instance directory of
+// data directory where geth loads them.
+func generateMaasNode(manifest *utils.MaasManifest, node *utils.ManifestNode, nodePath, genesisJson, nodePass string) (*utils.Node, error) {
+	metaNode := &utils.Node{
+		Address: node.Address,
+		NodeKey: node.NodeKey,
+		PubKey:  node.PublicKey,
+		Static:  node.Enode(),
+	}
+	instancePath := filepath.Join(nodePath, "data", clientIdentifier)
+	if err := writeNetworkFile(filepath.Join(nodePath, "genesis.json"), []byte(genesisJson), 0644); err != nil {
+		return nil, err
+	}
+
+	config, err := tomlSettings.Marshal(maasNodeConfig(manifest, node))
+	if err != nil {
+		return nil, err
+	}
+	if err := writeNetworkFile(filepath.Join(nodePath, "config.toml"), config, 0644); err != nil {
+		return nil, err
+	}
+
+	staticNodes, _ := json.MarshalIndent(manifest.StaticNodes(node), "", "\t")
+	if err := writeNetworkFile(filepath.Join(instancePath, "static-nodes.json"), staticNodes, 0644); err != nil {
+		return nil, err
+	}
+	if err := writeNetworkFile(filepath.Join(instancePath, "trusted-nodes.json"), staticNodes, 0644); err != nil {
+		return nil, err
+	}
+
+	if node.HasKey() {
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(node.NodeKey, "0x"))
+		if err != nil {
+			return nil, err
+		}
+		if err := writeNetworkFile(filepath.Join(instancePath, "nodekey"), []byte(common.Bytes2Hex(crypto.FromECDSA(key))), 0600); err != nil {
+			return nil, err
+		}
+		keyJson, err := keystore.GenerateKeyJson(key, nodePass)
+		if err != nil {
+			return nil, err
+		}
+		var keystoreObj KeystoreFile
+		json.Unmarshal([]byte(keyJson), &keystoreObj)
+		metaNode.KeyStore = keystoreObj
+
+		name := fmt.Sprintf("UTC--%s--%s", time.Now().UTC().Format("2006-01-02T15-04-05.000000000Z"), common.Bytes2Hex(common.HexToAddress(node.Address).Bytes()))
+		if err := writeNetworkFile(filepath.Join(nodePath, "data", "keystore", name), []byte(keyJson), 0600); err != nil {
+			return nil, err
+		}
+	}
+
+	if manifest.Systemd != nil {
+		unit, err := manifest.SystemdUnit(node)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeNetworkFile(filepath.Join(nodePath, "maas-"+node.Name+".service"), []byte(unit), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return metaNode, nil
+}
+
+// maasNodeConfig returns the geth config of node, it mines with the node key and connects the other
+// validators as static nodes without discovery.
+func maasNodeConfig(manifest *utils.MaasManifest, node *utils.ManifestNode) *gethConfig {
+	cfg := &gethConfig{
+		Eth:     ethconfig.Defaults,
+		Node:    defaultNodeConfig(),
+		Metrics: metrics.DefaultConfig,
+	}
+	cfg.Eth.NetworkId = manifest.ChainId
+	cfg.Eth.SyncMode = downloader.FullSync
+	cfg.Eth.Miner.Etherbase = common.HexToAddress(node.Address)
+
+	cfg.Node.UserIdent = node.Name
+	cfg.Node.DataDir = node.DataDir(manifest)
+	cfg.Node.P2P.ListenAddr = fmt.Sprintf(":%d", node.Port)
+	cfg.Node.P2P.NoDiscovery = true
+	cfg.Node.HTTPHost = "0.0.0.0"
+	cfg.Node.HTTPPort = node.HTTPPort
+	cfg.Node.HTTPVirtualHosts = []string{"*"}
+	return cfg
+}
+
+func writeNetworkFile(file string, content []byte, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, content, perm)
+}
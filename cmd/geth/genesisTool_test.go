@@ -1,9 +1,16 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
-	"github.com/ethereum/go-ethereum/cmd/utils"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGenesisTool(t *testing.T){
@@ -39,3 +46,49 @@ func TestGenesisTool(t *testing.T){
 		}
 	}
 }
+
+func TestGenerateMaasNetwork(t *testing.T) {
+	external, _ := crypto.GenerateKey()
+	manifestFile := filepath.Join(t.TempDir(), "manifest.json")
+	manifestJson := `{
+		"nodes": [
+			{"host": "10.0.0.1"},
+			{"host": "10.0.0.2"},
+			{"host": "10.0.0.3"},
+			{"host": "10.0.0.4", "port": 30301, "httpPort": 8546, "publicKey": "` + common.Bytes2Hex(crypto.CompressPubkey(&external.PublicKey)) + `"}
+		],
+		"owner": "0x0000000000000000000000000000000000000001",
+		"docker": {"image": "zion:latest"},
+		"systemd": {}
+	}`
+	assert.NoError(t, ioutil.WriteFile(manifestFile, []byte(manifestJson), 0644))
+	manifest, err := utils.LoadMaasManifest(manifestFile)
+	assert.NoError(t, err)
+
+	basePath := t.TempDir()
+	assert.Error(t, generateMaasNetwork(manifest, basePath, ""))
+	assert.NoError(t, generateMaasNetwork(manifest, basePath, "pass"))
+
+	for _, file := range []string{"genesis.json", "nodes.json", "docker-compose.yml", "node0/genesis.json", "node0/maas-node0.service"} {
+		assert.FileExists(t, filepath.Join(basePath, file))
+	}
+	assert.FileExists(t, filepath.Join(basePath, "node0/data/geth/nodekey"))
+	assert.NoFileExists(t, filepath.Join(basePath, "node3/data/geth/nodekey"))
+	keystores, _ := ioutil.ReadDir(filepath.Join(basePath, "node0/data/keystore"))
+	assert.Equal(t, 1, len(keystores))
+
+	var staticNodes []string
+	enc, _ := ioutil.ReadFile(filepath.Join(basePath, "node3/data/geth/static-nodes.json"))
+	assert.NoError(t, json.Unmarshal(enc, &staticNodes))
+	assert.Equal(t, []string{manifest.Nodes[0].Enode(), manifest.Nodes[1].Enode(), manifest.Nodes[2].Enode()}, staticNodes)
+	assert.FileExists(t, filepath.Join(basePath, "node3/data/geth/trusted-nodes.json"))
+
+	var cfg gethConfig
+	assert.NoError(t, loadConfig(filepath.Join(basePath, "node3/config.toml"), &cfg))
+	assert.Equal(t, uint64(10898), cfg.Eth.NetworkId)
+	assert.Equal(t, common.HexToAddress(manifest.Nodes[3].Address), cfg.Eth.Miner.Etherbase)
+	assert.Equal(t, "/opt/maas/node3/data", cfg.Node.DataDir)
+	assert.Equal(t, ":30301", cfg.Node.P2P.ListenAddr)
+	assert.Equal(t, 8546, cfg.Node.HTTPPort)
+	assert.True(t, cfg.Node.P2P.NoDiscovery)
+}
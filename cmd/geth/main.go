@@ -69,6 +69,7 @@ var (
 		utils.USBFlag,
 		utils.SmartCardDaemonPathFlag,
 		utils.OverrideLondonFlag,
+		utils.OverrideHotStuffForkFlag,
 		utils.EthashCacheDirFlag,
 		utils.EthashCachesInMemoryFlag,
 		utils.EthashCachesOnDiskFlag,
@@ -127,6 +128,11 @@ var (
 		utils.MinerExtraDataFlag,
 		utils.MinerRecommitIntervalFlag,
 		utils.MinerNoVerfiyFlag,
+		utils.MinerTxOrderingFlag,
+		utils.HotStuffLeaderPolicyFlag,
+		utils.HotStuffPacemakerFlag,
+		utils.HotStuffMaxRoundTimeoutFlag,
+		utils.HotStuffBLSKeyFlag,
 		utils.NATFlag,
 		utils.NoDiscoverFlag,
 		utils.DiscoveryV5Flag,
@@ -188,6 +188,16 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.MinerExtraDataFlag,
 			utils.MinerRecommitIntervalFlag,
 			utils.MinerNoVerfiyFlag,
+			utils.MinerTxOrderingFlag,
+		},
+	},
+	{
+		Name: "HOTSTUFF",
+		Flags: []cli.Flag{
+			utils.HotStuffLeaderPolicyFlag,
+			utils.HotStuffPacemakerFlag,
+			utils.HotStuffMaxRoundTimeoutFlag,
+			utils.HotStuffBLSKeyFlag,
 		},
 	},
 	{
@@ -0,0 +1,193 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// nativegen generates the go glue of native contract from solidity interface and gas annotation.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common/compiler"
+	"github.com/ethereum/go-ethereum/contracts/native/nativegen"
+	"github.com/ethereum/go-ethereum/internal/flags"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	// Git SHA1 commit hash of the release (set via linker flags)
+	gitCommit = ""
+	gitDate   = ""
+
+	app *cli.App
+
+	// Flags needed by nativegen
+	abiFlag = cli.StringFlag{
+		Name:  "abi",
+		Usage: "Path to the native contract ABI json to bind, - for STDIN",
+	}
+	solFlag = cli.StringFlag{
+		Name:  "sol",
+		Usage: "Path to the native contract Solidity interface to build and bind",
+	}
+	solcFlag = cli.StringFlag{
+		Name:  "solc",
+		Usage: "Solidity compiler to use if source builds are requested",
+		Value: "solc",
+	}
+	gasFlag = cli.StringFlag{
+		Name:  "gas",
+		Usage: "Path to the gas annotation json, e.g. {\"name\": 0, \"changeOwner\": 30000}",
+	}
+	typeFlag = cli.StringFlag{
+		Name:  "type",
+		Usage: "Contract name for the binding, required if the Solidity source contains several interfaces (default = package name)",
+	}
+	pkgFlag = cli.StringFlag{
+		Name:  "pkg",
+		Usage: "Package name to generate the binding into",
+	}
+	bindingFlag = cli.BoolFlag{
+		Name:  "binding",
+		Usage: "Generate the go_abi binding for native contract clients instead of the native contract glue",
+	}
+	outFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "Output file for the generated binding (default = stdout)",
+	}
+)
+
+func init() {
+	app = flags.NewApp(gitCommit, gitDate, "native contract binding generator")
+	app.Flags = []cli.Flag{
+		abiFlag,
+		solFlag,
+		solcFlag,
+		gasFlag,
+		typeFlag,
+		pkgFlag,
+		bindingFlag,
+		outFlag,
+	}
+	app.Action = utils.MigrateFlags(generate)
+	cli.CommandHelpTemplate = flags.OriginCommandHelpTemplate
+}
+
+func generate(c *cli.Context) error {
+	utils.CheckExclusive(c, abiFlag, solFlag) // Only one source can be selected.
+	pkg := c.GlobalString(pkgFlag.Name)
+	if pkg == "" {
+		utils.Fatalf("No destination package specified (--pkg)")
+	}
+	binding := c.GlobalBool(bindingFlag.Name)
+	if !binding && c.GlobalString(gasFlag.Name) == "" {
+		utils.Fatalf("No gas annotation specified (--gas)")
+	}
+	kind := c.GlobalString(typeFlag.Name)
+
+	var abiJSON string
+	switch {
+	case c.GlobalIsSet(abiFlag.Name):
+		var (
+			data []byte
+			err  error
+		)
+		input := c.GlobalString(abiFlag.Name)
+		if input == "-" {
+			data, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			data, err = ioutil.ReadFile(input)
+		}
+		if err != nil {
+			utils.Fatalf("Failed to read input ABI: %v", err)
+		}
+		abiJSON = string(data)
+	case c.GlobalIsSet(solFlag.Name):
+		contracts, err := compiler.CompileSolidity(c.GlobalString(solcFlag.Name), c.GlobalString(solFlag.Name))
+		if err != nil {
+			utils.Fatalf("Failed to build Solidity contract: %v", err)
+		}
+		var matched []*compiler.Contract
+		for name, contract := range contracts {
+			nameParts := strings.Split(name, ":")
+			if len(contracts) == 1 || strings.EqualFold(nameParts[len(nameParts)-1], kind) {
+				matched = append(matched, contract)
+				if kind == "" {
+					kind = nameParts[len(nameParts)-1]
+				}
+			}
+		}
+		if len(matched) != 1 {
+			utils.Fatalf("Failed to select contract from Solidity source, specify it by --%s", typeFlag.Name)
+		}
+		data, err := json.Marshal(matched[0].Info.AbiDefinition) // Flatten the compiler parse
+		if err != nil {
+			utils.Fatalf("Failed to parse ABIs from compiler output: %v", err)
+		}
+		abiJSON = string(data)
+	default:
+		utils.Fatalf("No contract ABI or Solidity source specified (--%s or --%s)", abiFlag.Name, solFlag.Name)
+	}
+	if kind == "" {
+		kind = pkg
+	}
+
+	var (
+		code string
+		err  error
+	)
+	if binding {
+		code, err = nativegen.Binding(kind, pkg, abiJSON)
+	} else {
+		code, err = nativegen.Generate(kind, pkg, abiJSON, readGasTable(c.GlobalString(gasFlag.Name)))
+	}
+	if err != nil {
+		utils.Fatalf("Failed to generate native binding: %v", err)
+	}
+	if !c.GlobalIsSet(outFlag.Name) {
+		fmt.Printf("%s\n", code)
+		return nil
+	}
+	if err := ioutil.WriteFile(c.GlobalString(outFlag.Name), []byte(code), 0600); err != nil {
+		utils.Fatalf("Failed to write native binding: %v", err)
+	}
+	return nil
+}
+
+func readGasTable(path string) nativegen.GasTable {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		utils.Fatalf("Failed to read gas annotation: %v", err)
+	}
+	gas, err := nativegen.ParseGasTable(data)
+	if err != nil {
+		utils.Fatalf("Failed to parse gas annotation: %v", err)
+	}
+	return gas
+}
+
+func main() {
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
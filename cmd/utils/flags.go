@@ -40,6 +40,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -247,6 +248,10 @@ var (
 		Name:  "override.london",
 		Usage: "Manually specify London fork-block, overriding the bundled setting",
 	}
+	OverrideHotStuffForkFlag = cli.StringFlag{
+		Name:  "override.hotstufffork",
+		Usage: "Json file of HotStuff fork parameters, overriding the ones in genesis chain config",
+	}
 	// Light server and client settings
 	LightServeFlag = cli.IntFlag{
 		Name:  "light.serve",
@@ -477,6 +482,30 @@ var (
 		Name:  "miner.noverify",
 		Usage: "Disable remote sealing verification",
 	}
+	MinerTxOrderingFlag = cli.StringFlag{
+		Name:  "miner.txordering",
+		Usage: "Transaction ordering policy for mined blocks unless the chain config enforces one (price, fifo, fairshare, gasmanager)",
+		Value: core.TxOrderingPrice,
+	}
+	// HotStuff settings
+	HotStuffLeaderPolicyFlag = cli.StringFlag{
+		Name:  "hotstuff.leaderpolicy",
+		Usage: "HotStuff proposer selection policy (roundrobin, sticky), vrf is switched on by the chain config",
+		Value: hotstuff.RoundRobin.String(),
+	}
+	HotStuffPacemakerFlag = cli.StringFlag{
+		Name:  "hotstuff.pacemaker",
+		Usage: "HotStuff round timeout strategy (exponential, adaptive)",
+		Value: hotstuff.ExponentialPacemaker.String(),
+	}
+	HotStuffMaxRoundTimeoutFlag = cli.Uint64Flag{
+		Name:  "hotstuff.maxroundtimeout",
+		Usage: "Upper bound of HotStuff round timeout in milliseconds (0 = no limit)",
+	}
+	HotStuffBLSKeyFlag = cli.StringFlag{
+		Name:  "hotstuff.blskey",
+		Usage: "HotStuff BLS secret key file signing aggregated committed seals, generated if missing (default = inside the datadir)",
+	}
 	// Account settings
 	UnlockedAccountFlag = cli.StringFlag{
 		Name:  "unlock",
@@ -503,7 +532,7 @@ var (
 	}
 	NodeWhitePathFlag = cli.StringFlag{
 		Name:  "node.whitelist",
-		Usage: "node whitelist config file path",
+		Usage: "node whitelist config file path (deprecated, the node whitelist is governed by maas config contract)",
 	}
 	RPCGlobalGasCapFlag = cli.Uint64Flag{
 		Name:  "rpc.gascap",
@@ -1250,12 +1279,9 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	if ctx.GlobalIsSet(InsecureUnlockAllowedFlag.Name) {
 		cfg.InsecureUnlockAllowed = ctx.GlobalBool(InsecureUnlockAllowedFlag.Name)
 	}
-	// set node whitelist file path
-	if !ctx.GlobalIsSet(NodeWhitePathFlag.Name) {
-		ctx.GlobalSet(NodeWhitePathFlag.Name, "node-whitelist.json")
+	if ctx.GlobalIsSet(NodeWhitePathFlag.Name) {
+		log.Warn("The flag --node.whitelist is deprecated and ignored, the node whitelist is read from maas config contract")
 	}
-	cfg.NodeWhitePath = ctx.GlobalString(NodeWhitePathFlag.Name)
-	// params.StartNodeWhiteLoadTask(cfg.NodeWhitePath)
 }
 
 func setSmartCard(ctx *cli.Context, cfg *node.Config) {
@@ -1369,6 +1395,28 @@ func setTxPool(ctx *cli.Context, cfg *core.TxPoolConfig) {
 	}
 }
 
+func setHotStuff(ctx *cli.Context, cfg *hotstuff.Config) {
+	if ctx.GlobalIsSet(HotStuffBLSKeyFlag.Name) {
+		cfg.BLSKeyFile = ctx.GlobalString(HotStuffBLSKeyFlag.Name)
+	}
+	if ctx.GlobalIsSet(HotStuffLeaderPolicyFlag.Name) {
+		if err := cfg.LeaderPolicy.UnmarshalText([]byte(ctx.GlobalString(HotStuffLeaderPolicyFlag.Name))); err != nil {
+			Fatalf("Invalid hotstuff leader policy: %v", err)
+		}
+		if cfg.LeaderPolicy == hotstuff.VRF {
+			Fatalf("Invalid hotstuff leader policy: vrf is switched on by the chain config fork")
+		}
+	}
+	if ctx.GlobalIsSet(HotStuffPacemakerFlag.Name) {
+		if err := cfg.Pacemaker.UnmarshalText([]byte(ctx.GlobalString(HotStuffPacemakerFlag.Name))); err != nil {
+			Fatalf("Invalid hotstuff pacemaker: %v", err)
+		}
+	}
+	if ctx.GlobalIsSet(HotStuffMaxRoundTimeoutFlag.Name) {
+		cfg.MaxRoundTimeout = ctx.GlobalUint64(HotStuffMaxRoundTimeoutFlag.Name)
+	}
+}
+
 func setEthash(ctx *cli.Context, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(EthashCacheDirFlag.Name) {
 		cfg.Ethash.CacheDir = ctx.GlobalString(EthashCacheDirFlag.Name)
@@ -1419,6 +1467,12 @@ func setMiner(ctx *cli.Context, cfg *miner.Config) {
 	if ctx.GlobalIsSet(MinerNoVerfiyFlag.Name) {
 		cfg.Noverify = ctx.GlobalBool(MinerNoVerfiyFlag.Name)
 	}
+	if ctx.GlobalIsSet(MinerTxOrderingFlag.Name) {
+		cfg.TxOrdering = ctx.GlobalString(MinerTxOrderingFlag.Name)
+		if _, err := core.NewTxOrdering(cfg.TxOrdering); err != nil {
+			Fatalf("Invalid miner transaction ordering: %v", err)
+		}
+	}
 }
 
 func setWhitelist(ctx *cli.Context, cfg *ethconfig.Config) {
@@ -1507,6 +1561,7 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	setTxPool(ctx, &cfg.TxPool)
 	setEthash(ctx, cfg)
 	setMiner(ctx, &cfg.Miner)
+	setHotStuff(ctx, &cfg.HotStuff)
 	setWhitelist(ctx, cfg)
 	setLes(ctx, cfg)
 
@@ -24,6 +24,8 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/core"
 )
 
 type MaasGenesis struct {
@@ -41,18 +43,22 @@ type MaasGenesis struct {
 			Protocol string `json:"protocol"`
 		} `json:"hotstuff"`
 	} `json:"config"`
-	Alloc map[string]struct {
-		PublicKey string `json:"publicKey"`
-		Balance   string `json:"balance"`
-	} `json:"alloc"`
-	Coinbase   string `json:"coinbase"`
-	Difficulty string `json:"difficulty"`
-	ExtraData  string `json:"extraData"`
-	GasLimit   string `json:"gasLimit"`
-	Nonce      string `json:"nonce"`
-	Mixhash    string `json:"mixhash"`
-	ParentHash string `json:"parentHash"`
-	Timestamp  string `json:"timestamp"`
+	Alloc       map[string]MaasGenesisAccount `json:"alloc"`
+	NativeAlloc *core.GenesisNativeAlloc      `json:"nativeAlloc,omitempty"`
+	Coinbase    string                        `json:"coinbase"`
+	Difficulty  string                        `json:"difficulty"`
+	ExtraData   string                        `json:"extraData"`
+	GasLimit    string                        `json:"gasLimit"`
+	Nonce       string                        `json:"nonce"`
+	Mixhash     string                        `json:"mixhash"`
+	ParentHash  string                        `json:"parentHash"`
+	Timestamp   string                        `json:"timestamp"`
+}
+
+// MaasGenesisAccount is a pre-allocated account, the public key is only set for genesis validators.
+type MaasGenesisAccount struct {
+	PublicKey string `json:"publicKey,omitempty"`
+	Balance   string `json:"balance"`
 }
 
 func (m *MaasGenesis) Encode() (string, error) {
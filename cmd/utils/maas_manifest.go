@@ -0,0 +1,434 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/tool"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// DefaultMaasBalance is the balance pre-allocated to each genesis validator
+const DefaultMaasBalance = "100000000000000000000000000000"
+
+const (
+	defaultManifestHost      = "127.0.0.1"
+	defaultManifestPort      = 30300
+	defaultManifestHTTPPort  = 8545
+	defaultManifestDeployDir = "/opt/maas"
+	defaultManifestBinary    = "/usr/local/bin/geth"
+	defaultManifestUser      = "maas"
+)
+
+var manifestNodeNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// MaasManifest describes the hosts, validator keys and governance of a maas network, genesisTool
+// generates the genesis and per node artifacts of the network from it.
+type MaasManifest struct {
+	ChainId   uint64          `json:"chainId"`
+	Protocol  string          `json:"protocol"`
+	DeployDir string          `json:"deployDir"` // the directory node files are deployed to on hosts
+	Nodes     []*ManifestNode `json:"nodes"`
+
+	Balance string            `json:"balance"` // balance of each validator, default DefaultMaasBalance
+	Alloc   map[string]string `json:"alloc"`   // address => balance of pre-allocated accounts
+
+	Owner           string   `json:"owner"`
+	Admins          []string `json:"admins"`
+	NodeWhiteEnable bool     `json:"nodeWhiteEnable"`
+	NodeWhitelist   []string `json:"nodeWhitelist"` // nodes allowed to connect besides the validators
+
+	Docker  *ManifestDocker  `json:"docker"`
+	Systemd *ManifestSystemd `json:"systemd"`
+}
+
+// ManifestNode is a validator of maas network. The node key is generated if neither the node key nor
+// the public key is given, a validator holding its own key should only give the public key.
+type ManifestNode struct {
+	Name      string `json:"name"`
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	HTTPPort  int    `json:"httpPort"`
+	Address   string `json:"address"`
+	PublicKey string `json:"publicKey"`
+	NodeKey   string `json:"nodeKey"`
+
+	nodeInf string
+}
+
+// ManifestDocker enables the docker-compose artifact
+type ManifestDocker struct {
+	Image string `json:"image"`
+}
+
+// ManifestSystemd enables the systemd unit artifacts
+type ManifestSystemd struct {
+	Binary string `json:"binary"`
+	User   string `json:"user"`
+}
+
+// LoadMaasManifest reads the manifest file, fills the default values and resolves the validator keys.
+func LoadMaasManifest(file string) (*MaasManifest, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(MaasManifest)
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %v", file, err)
+	}
+	if err := manifest.resolve(); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func (m *MaasManifest) resolve() error {
+	if len(m.Nodes) < 4 {
+		return fmt.Errorf("got %v nodes, but hotstuff BFT requires at least 4 nodes", len(m.Nodes))
+	}
+	defaults := new(MaasGenesis)
+	defaults.Default()
+	if m.ChainId == 0 {
+		m.ChainId = defaults.Config.ChainId
+	}
+	if m.Protocol == "" {
+		m.Protocol = defaults.Config.HotStuff.Protocol
+	}
+	if m.DeployDir == "" {
+		m.DeployDir = defaultManifestDeployDir
+	}
+	if m.Balance == "" {
+		m.Balance = DefaultMaasBalance
+	}
+	if _, ok := parseBalance(m.Balance); !ok {
+		return fmt.Errorf("invalid validator balance %s", m.Balance)
+	}
+
+	names := make(map[string]struct{})
+	endpoints := make(map[string]struct{})
+	addresses := make(map[string]struct{})
+	for i, node := range m.Nodes {
+		if err := node.resolve(i); err != nil {
+			return err
+		}
+		if _, ok := names[node.Name]; ok {
+			return fmt.Errorf("duplicate node name %s", node.Name)
+		}
+		endpoint := node.Host + ":" + strconv.Itoa(node.Port)
+		if _, ok := endpoints[endpoint]; ok {
+			return fmt.Errorf("duplicate node endpoint %s", endpoint)
+		}
+		if _, ok := addresses[node.Address]; ok {
+			return fmt.Errorf("duplicate node address %s", node.Address)
+		}
+		names[node.Name] = struct{}{}
+		endpoints[endpoint] = struct{}{}
+		addresses[node.Address] = struct{}{}
+	}
+
+	for addr, balance := range m.Alloc {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid alloc address %s", addr)
+		}
+		if _, ok := parseBalance(balance); !ok {
+			return fmt.Errorf("invalid alloc balance %s of %s", balance, addr)
+		}
+	}
+	if m.Owner != "" && !common.IsHexAddress(m.Owner) {
+		return fmt.Errorf("invalid owner address %s", m.Owner)
+	}
+	for _, list := range [][]string{m.Admins, m.NodeWhitelist} {
+		for _, addr := range list {
+			if !common.IsHexAddress(addr) {
+				return fmt.Errorf("invalid address %s", addr)
+			}
+		}
+	}
+
+	if m.Docker != nil && m.Docker.Image == "" {
+		return fmt.Errorf("docker image is required")
+	}
+	if m.Systemd != nil {
+		if m.Systemd.Binary == "" {
+			m.Systemd.Binary = defaultManifestBinary
+		}
+		if m.Systemd.User == "" {
+			m.Systemd.User = defaultManifestUser
+		}
+	}
+	return nil
+}
+
+func (n *ManifestNode) resolve(index int) error {
+	if n.Name == "" {
+		n.Name = fmt.Sprintf("node%d", index)
+	}
+	if !manifestNodeNameRegexp.MatchString(n.Name) {
+		return fmt.Errorf("invalid node name %s", n.Name)
+	}
+	if n.Host == "" {
+		n.Host = defaultManifestHost
+	}
+	if n.Port == 0 {
+		n.Port = defaultManifestPort
+	}
+	if n.HTTPPort == 0 {
+		n.HTTPPort = defaultManifestHTTPPort
+	}
+
+	var err error
+	switch {
+	case n.NodeKey != "":
+		if n.nodeInf, err = tool.NodeKey2NodeInfo(n.NodeKey); err != nil {
+			return fmt.Errorf("invalid node key of %s: %v", n.Name, err)
+		}
+		if !strings.HasPrefix(n.NodeKey, "0x") {
+			n.NodeKey = "0x" + n.NodeKey
+		}
+	case n.PublicKey != "":
+		if n.nodeInf, err = tool.PublicKey2NodeInfo(n.PublicKey); err != nil {
+			return fmt.Errorf("invalid public key of %s: %v", n.Name, err)
+		}
+	case n.Address != "":
+		return fmt.Errorf("the public key of %s is required to connect and register the validator", n.Name)
+	default:
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return err
+		}
+		n.NodeKey = hexutil.Encode(crypto.FromECDSA(key))
+		n.nodeInf, _ = tool.NodeKey2NodeInfo(n.NodeKey)
+	}
+
+	// genesis alloc requires the compressed public key
+	pubKey, err := crypto.UnmarshalPubkey(append([]byte{4}, common.FromHex(n.nodeInf)...))
+	if err != nil {
+		return fmt.Errorf("invalid public key of %s: %v", n.Name, err)
+	}
+	n.PublicKey = hexutil.Encode(crypto.CompressPubkey(pubKey))
+	addr := crypto.PubkeyToAddress(*pubKey).Hex()
+	if n.Address != "" && common.HexToAddress(n.Address).Hex() != addr {
+		return fmt.Errorf("the address of %s is %s, but got %s", n.Name, addr, n.Address)
+	}
+	n.Address = addr
+	return nil
+}
+
+// Enode returns the enode url other nodes use to connect the node.
+func (n *ManifestNode) Enode() string {
+	return tool.NodeStaticInfo(n.nodeInf, n.Host, n.Port)
+}
+
+// HasKey reports whether the node key is known to the tool, keys of existing validators are held
+// by themselves.
+func (n *ManifestNode) HasKey() bool {
+	return n.NodeKey != ""
+}
+
+// DataDir returns the data directory of node on its host.
+func (n *ManifestNode) DataDir(m *MaasManifest) string {
+	return path.Join(m.NodeDir(n), "data")
+}
+
+// NodeDir returns the directory that node files are deployed to on its host.
+func (m *MaasManifest) NodeDir(n *ManifestNode) string {
+	return path.Join(m.DeployDir, n.Name)
+}
+
+// StaticNodes returns the enode urls of the other validators which the node should keep connected.
+func (m *MaasManifest) StaticNodes(self *ManifestNode) []string {
+	list := make([]string, 0, len(m.Nodes)-1)
+	for _, n := range m.Nodes {
+		if n != self {
+			list = append(list, n.Enode())
+		}
+	}
+	return list
+}
+
+// Genesis returns the genesis with the validators sorted in hotstuff order, the pre-allocated balances
+// and the maas config governance seeded by native alloc.
+func (m *MaasManifest) Genesis() (*MaasGenesis, error) {
+	genesis := new(MaasGenesis)
+	genesis.Default()
+	genesis.Config.ChainId = m.ChainId
+	genesis.Config.HotStuff.Protocol = m.Protocol
+
+	nodes := make([]*tool.Node, 0, len(m.Nodes))
+	for _, n := range m.Nodes {
+		nodes = append(nodes, &tool.Node{Address: n.Address, NodeKey: n.NodeKey, Static: n.Enode()})
+	}
+	extra, err := tool.Encode(tool.NodesAddress(tool.SortNodes(nodes)))
+	if err != nil {
+		return nil, err
+	}
+	genesis.ExtraData = extra
+
+	genesis.Alloc = make(map[string]MaasGenesisAccount)
+	for _, n := range m.Nodes {
+		genesis.Alloc[n.Address] = MaasGenesisAccount{PublicKey: n.PublicKey, Balance: m.Balance}
+	}
+	for addr, balance := range m.Alloc {
+		addr = common.HexToAddress(addr).Hex()
+		account := genesis.Alloc[addr]
+		if account.PublicKey != "" {
+			// validator balance is increased by the alloc
+			base, _ := parseBalance(account.Balance)
+			extra, _ := parseBalance(balance)
+			balance = new(big.Int).Add(base, extra).String()
+		}
+		account.Balance = balance
+		genesis.Alloc[addr] = account
+	}
+
+	if m.Owner != "" || len(m.Admins) > 0 || m.NodeWhiteEnable || len(m.NodeWhitelist) > 0 {
+		config := &maas_config.GenesisConfig{
+			Owner:           common.HexToAddress(m.Owner),
+			Admins:          hexToAddresses(m.Admins),
+			NodeWhiteEnable: m.NodeWhiteEnable,
+		}
+		if m.NodeWhiteEnable {
+			for _, n := range m.Nodes {
+				config.NodeWhitelist = append(config.NodeWhitelist, common.HexToAddress(n.Address))
+			}
+		}
+		config.NodeWhitelist = append(config.NodeWhitelist, hexToAddresses(m.NodeWhitelist)...)
+		genesis.NativeAlloc = &core.GenesisNativeAlloc{MaasConfig: config}
+	}
+	return genesis, nil
+}
+
+// parseBalance parses the decimal or hex balance in the same way as genesis
+func parseBalance(s string) (*big.Int, bool) {
+	balance, ok := math.ParseBig256(s)
+	if !ok || balance.Sign() < 0 {
+		return nil, false
+	}
+	return balance, true
+}
+
+func hexToAddresses(list []string) []common.Address {
+	addrs := make([]common.Address, 0, len(list))
+	for _, v := range list {
+		addrs = append(addrs, common.HexToAddress(v))
+	}
+	return addrs
+}
+
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: "3"
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    image: {{ $.Image }}
+    container_name: {{ .Name }}
+    hostname: {{ .Name }}
+    entrypoint: /bin/sh
+    command: -c "geth init --datadir {{ .DataDir }} {{ .NodeDir }}/genesis.json && exec geth --config {{ .NodeDir }}/config.toml --mine"
+    volumes:
+      - ./{{ .Name }}:{{ .NodeDir }}
+    ports:
+      - "{{ .Port }}:{{ .Port }}"
+      - "{{ .HTTPPort }}:{{ .HTTPPort }}"
+    restart: unless-stopped
+{{- end }}
+`))
+
+var systemdTemplate = template.Must(template.New("systemd").Parse(`[Unit]
+Description=Zion maas node {{ .Name }}
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+User={{ .User }}
+ExecStartPre={{ .Binary }} init --datadir {{ .DataDir }} {{ .NodeDir }}/genesis.json
+ExecStart={{ .Binary }} --config {{ .NodeDir }}/config.toml --mine
+Restart=on-failure
+LimitNOFILE=65535
+
+[Install]
+WantedBy=multi-user.target
+`))
+
+type manifestTemplateNode struct {
+	Name     string
+	Port     int
+	HTTPPort int
+	NodeDir  string
+	DataDir  string
+	User     string
+	Binary   string
+}
+
+func (m *MaasManifest) templateNode(n *ManifestNode) *manifestTemplateNode {
+	node := &manifestTemplateNode{
+		Name:     n.Name,
+		Port:     n.Port,
+		HTTPPort: n.HTTPPort,
+		NodeDir:  m.NodeDir(n),
+		DataDir:  n.DataDir(m),
+	}
+	if m.Systemd != nil {
+		node.User = m.Systemd.User
+		node.Binary = m.Systemd.Binary
+	}
+	return node
+}
+
+// DockerCompose returns the docker-compose file running all nodes with their node directories mounted.
+func (m *MaasManifest) DockerCompose() (string, error) {
+	data := struct {
+		Image string
+		Nodes []*manifestTemplateNode
+	}{Image: m.Docker.Image}
+	for _, n := range m.Nodes {
+		data.Nodes = append(data.Nodes, m.templateNode(n))
+	}
+	buf := new(bytes.Buffer)
+	if err := dockerComposeTemplate.Execute(buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// SystemdUnit returns the systemd service unit of node.
+func (m *MaasManifest) SystemdUnit(n *ManifestNode) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := systemdTemplate.Execute(buf, m.templateNode(n)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,159 @@
+package utils
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestManifest(t *testing.T, manifest string) string {
+	file := filepath.Join(t.TempDir(), "manifest.json")
+	if err := ioutil.WriteFile(file, []byte(manifest), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestLoadMaasManifest(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	nodeKey := hexutil.Encode(crypto.FromECDSA(key))
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	external, _ := crypto.GenerateKey()
+	pubKey := hexutil.Encode(crypto.FromECDSAPub(&external.PublicKey))
+
+	manifest, err := LoadMaasManifest(writeTestManifest(t, `{
+		"nodes": [
+			{"host": "10.0.0.1", "nodeKey": "`+nodeKey+`"},
+			{"host": "10.0.0.2", "port": 30301, "publicKey": "`+pubKey+`"},
+			{"name": "backup", "host": "10.0.0.3"},
+			{"host": "10.0.0.3", "port": 30301}
+		],
+		"docker": {"image": "zion:latest"},
+		"systemd": {}
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10898), manifest.ChainId)
+	assert.Equal(t, "basic", manifest.Protocol)
+	assert.Equal(t, "/opt/maas", manifest.DeployDir)
+	assert.Equal(t, defaultManifestBinary, manifest.Systemd.Binary)
+
+	nodes := manifest.Nodes
+	assert.Equal(t, "node0", nodes[0].Name)
+	assert.Equal(t, addr.Hex(), nodes[0].Address)
+	assert.Equal(t, hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)), nodes[0].PublicKey)
+	assert.True(t, nodes[0].HasKey())
+
+	// the existing validator only shares its public key
+	assert.Equal(t, crypto.PubkeyToAddress(external.PublicKey).Hex(), nodes[1].Address)
+	assert.Equal(t, hexutil.Encode(crypto.CompressPubkey(&external.PublicKey)), nodes[1].PublicKey)
+	assert.False(t, nodes[1].HasKey())
+	assert.True(t, strings.HasSuffix(nodes[1].Enode(), "@10.0.0.2:30301?discport=0"))
+
+	// keys are generated for the new validators
+	assert.Equal(t, "backup", nodes[2].Name)
+	assert.True(t, nodes[2].HasKey())
+	assert.NotEqual(t, nodes[2].Address, nodes[3].Address)
+	assert.Equal(t, "/opt/maas/node3/data", nodes[3].DataDir(manifest))
+
+	static := manifest.StaticNodes(nodes[0])
+	assert.Equal(t, 3, len(static))
+	assert.NotContains(t, static, nodes[0].Enode())
+
+	compose, err := manifest.DockerCompose()
+	assert.NoError(t, err)
+	assert.Contains(t, compose, "image: zion:latest")
+	assert.Contains(t, compose, "./backup:/opt/maas/backup")
+	assert.Contains(t, compose, `"30301:30301"`)
+	unit, err := manifest.SystemdUnit(nodes[2])
+	assert.NoError(t, err)
+	assert.Contains(t, unit, "ExecStart=/usr/local/bin/geth --config /opt/maas/backup/config.toml --mine")
+	assert.Contains(t, unit, "User=maas")
+}
+
+func TestLoadMaasManifestInvalid(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	pubKey := hexutil.Encode(crypto.CompressPubkey(&key.PublicKey))
+
+	testCases := []struct {
+		Manifest string
+		Expect   string
+	}{
+		{`{"nodes": [{}, {}, {}]}`, "got 3 nodes, but hotstuff BFT requires at least 4 nodes"},
+		{`{"nodes": [{}, {}, {}, {}], "unknown": 1}`, "unknown field"},
+		{`{"nodes": [{}, {"port": 1}, {"port": 2}, {"name": "node0", "port": 3}]}`, "duplicate node name node0"},
+		{`{"nodes": [{}, {}, {}, {"name": "node4"}]}`, "duplicate node endpoint 127.0.0.1:30300"},
+		{`{"nodes": [{"name": "a/b"}, {}, {}, {}]}`, "invalid node name a/b"},
+		{`{"nodes": [{"address": "0x01"}, {}, {}, {}]}`, "the public key of node0 is required"},
+		{`{"nodes": [{"publicKey": "` + pubKey + `", "address": "0x01"}, {}, {}, {}]}`, "the address of node0 is"},
+		{`{"nodes": [{"port": 1, "publicKey": "` + pubKey + `"}, {"publicKey": "` + pubKey + `"}, {}, {}]}`, "duplicate node address"},
+		{`{"nodes": [{"nodeKey": "0x1234"}, {}, {}, {}]}`, "invalid node key of node0"},
+		{`{"nodes": [{}, {"port": 1}, {"port": 2}, {"port": 3}], "alloc": {"0x01": "1"}}`, "invalid alloc address 0x01"},
+		{`{"nodes": [{}, {"port": 1}, {"port": 2}, {"port": 3}], "alloc": {"` + common.HexToAddress("0x01").Hex() + `": "-1"}}`, "invalid alloc balance"},
+		{`{"nodes": [{}, {"port": 1}, {"port": 2}, {"port": 3}], "owner": "owner"}`, "invalid owner address owner"},
+		{`{"nodes": [{}, {"port": 1}, {"port": 2}, {"port": 3}], "docker": {}}`, "docker image is required"},
+	}
+	for _, c := range testCases {
+		_, err := LoadMaasManifest(writeTestManifest(t, c.Manifest))
+		if assert.Error(t, err, c.Manifest) {
+			assert.Contains(t, err.Error(), c.Expect)
+		}
+	}
+}
+
+func TestMaasManifestGenesis(t *testing.T) {
+	owner, admin, user := common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03")
+	manifest, err := LoadMaasManifest(writeTestManifest(t, `{
+		"chainId": 100,
+		"nodes": [{}, {"port": 30301}, {"port": 30302}, {"port": 30303}],
+		"balance": "1000",
+		"alloc": {"`+user.Hex()+`": "0x10"},
+		"owner": "`+owner.Hex()+`",
+		"admins": ["`+admin.Hex()+`"],
+		"nodeWhiteEnable": true,
+		"nodeWhitelist": ["`+user.Hex()+`"]
+	}`))
+	assert.NoError(t, err)
+	manifest.Alloc[manifest.Nodes[0].Address] = "24"
+
+	genesis, err := manifest.Genesis()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), genesis.Config.ChainId)
+	assert.Equal(t, "1024", genesis.Alloc[manifest.Nodes[0].Address].Balance)
+	assert.Equal(t, manifest.Nodes[1].PublicKey, genesis.Alloc[manifest.Nodes[1].Address].PublicKey)
+	assert.Equal(t, MaasGenesisAccount{Balance: "0x10"}, genesis.Alloc[user.Hex()])
+	assert.Equal(t, owner, genesis.NativeAlloc.MaasConfig.Owner)
+	assert.Equal(t, []common.Address{admin}, genesis.NativeAlloc.MaasConfig.Admins)
+	assert.Equal(t, 5, len(genesis.NativeAlloc.MaasConfig.NodeWhitelist))
+
+	// the generated genesis should be accepted by the chain
+	enc, err := genesis.Encode()
+	assert.NoError(t, err)
+	gen := new(core.Genesis)
+	assert.NoError(t, json.Unmarshal([]byte(enc), gen))
+	assert.Equal(t, int64(0x10), gen.Alloc[user].Balance.Int64())
+	assert.Empty(t, gen.Alloc[user].PublicKey)
+	assert.Equal(t, genesis.NativeAlloc.MaasConfig, gen.NativeAlloc.MaasConfig)
+
+	db := rawdb.NewMemoryDatabase()
+	block, err := gen.Commit(db)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), block.NumberU64())
+	maas_config.InitMaasConfig()
+	statedb, err := state.New(block.Root(), state.NewDatabase(db), nil)
+	assert.NoError(t, err)
+	assert.True(t, native_client.IsNodeWhiteEnabled(statedb))
+	assert.Equal(t, 5, len(native_client.GetNodeWhitelist(statedb)))
+	assert.Equal(t, int64(1024), statedb.GetBalance(common.HexToAddress(manifest.Nodes[0].Address)).Int64())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -58,8 +59,9 @@ type ChainReader interface {
 	// GetBlockByHash retrieves a block from the database by hash
 	GetBlockByHash(hash common.Hash) *types.Block
 
-	// PreExecuteBlock pre-execute block transactions and validate states
-	PreExecuteBlock(block *types.Block) error
+	// PreExecuteBlock pre-execute block transactions and validate states, the ancestors of block
+	// which are not inserted yet are given in ascending order.
+	PreExecuteBlock(block *types.Block, pending []*types.Header) error
 }
 
 // Engine is an algorithm agnostic consensus engine.
@@ -92,9 +94,10 @@ type Engine interface {
 	// but does not assemble the block.
 	//
 	// Note: The block header and state database might be updated to reflect any
-	// consensus rules that happen at finalization (e.g. block rewards).
+	// consensus rules that happen at finalization (e.g. block rewards). The block
+	// is invalid if an error is returned.
 	Finalize(chain ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-		uncles []*types.Header)
+		uncles []*types.Header) error
 
 	// FinalizeAndAssemble runs any post-transaction state modifications (e.g. block
 	// rewards) and assembles the final block.
@@ -156,6 +159,13 @@ type HotStuff interface {
 
 	// ChangeEpoch save validators and start height for next epoch
 	ChangeEpoch(epochStartHeight uint64, list []common.Address) error
+
+	// CertifiedBlocks returns the blocks certified by validators but not inserted into the chain
+	// yet in ascending order, the next block should be built on top of the last one.
+	CertifiedBlocks() []*types.Block
+
+	// SubscribeCertifiedHead subscribes the event of a new block being certified.
+	SubscribeCertifiedHead(ch chan<- *types.Block) event.Subscription
 }
 
 // Handler should be implemented is the consensus needs to handle and send peer's message
@@ -19,6 +19,7 @@
 package hotstuff
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -47,15 +48,24 @@ type Backend interface {
 	// Unicast send a message to single peer
 	Unicast(valSet ValidatorSet, payload []byte) error
 
-	// PreCommit write seal to header and assemble new qc
-	PreCommit(proposal Proposal, seals [][]byte) (Proposal, error)
+	// PreCommit write committers' seal to header and assemble new qc
+	PreCommit(proposal Proposal, committers []common.Address, seals [][]byte) (Proposal, error)
+
+	// CollectSeal keeps the committed seal of vote which arrives after the quorum, the seals of all received
+	// votes are carried in the committed block and the parent seal of next block, so that the late voters
+	// are not tracked as inactive.
+	CollectSeal(number uint64, hash common.Hash, committer common.Address, seal []byte)
 
 	// ForwardCommit assemble unsealed block and sealed extra into an new full block
 	ForwardCommit(proposal Proposal, extra []byte) (Proposal, error)
 
-	// Commit delivers an approved proposal to backend.
-	// The delivered proposal will be put into blockchain.
-	Commit(proposal Proposal) error
+	// Certify delivers a proposal certified by a quorum cert to backend. The certified proposal is not
+	// final yet, but the next proposal is built and executed on top of it.
+	Certify(proposal Proposal) error
+
+	// Commit delivers an approved proposal to backend together with the round in which it was proposed,
+	// the round is nil if unknown. The delivered proposal will be put into blockchain.
+	Commit(proposal Proposal, round *big.Int) error
 
 	// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
 	// the time difference of the proposal and current time is also returned.
@@ -74,6 +84,16 @@ type Backend interface {
 	// ValidateBlock execute block which contained in prepare message, and validate block state
 	ValidateBlock(block *types.Block) error
 
+	// StoreSafetyRules persists the last vote and quorum certs of local validator
+	StoreSafetyRules(rules *SafetyRules) error
+
+	// LoadSafetyRules retrieves the persisted safety rules, it returns nil without error if nothing stored,
+	// any error means the rules may exist but can not be read.
+	LoadSafetyRules() (*SafetyRules, error)
+
+	// ReportEvidence collects the evidence of validator sending conflicting messages
+	ReportEvidence(evidence *Evidence)
+
 	Close() error
 }
 
@@ -92,6 +112,12 @@ type CoreEngine interface {
 	// pending request is populated right at the request stage so this would give us the earliest verification
 	// to avoid any race condition of coming propagated blocks
 	IsCurrentProposal(blockHash common.Hash) bool
+
+	// Status returns the snapshot of current round, it returns nil if the engine is not running
+	Status() *CoreStatus
+
+	// SubscribeRoundChange subscribes the event of consensus core entering a new round
+	SubscribeRoundChange(ch chan<- RoundChangeEvent) event.Subscription
 }
 
 type HotstuffProtocol string
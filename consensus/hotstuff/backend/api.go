@@ -19,44 +19,207 @@
 package backend
 
 import (
+	"context"
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
-// API is a user facing RPC API to allow controlling the address and voting
-// mechanisms of the HotStuff scheme.
+var errEngineNotRunning = errors.New("consensus engine is not running")
+
+// API is a user facing RPC API to inspect the live consensus state of the HotStuff scheme.
 type API struct {
 	chain    consensus.ChainHeaderReader
 	hotstuff *backend
 }
 
-// Proposals returns the current proposals the node tries to uphold and vote on.
-func (api *API) Proposals() map[common.Address]bool {
-	api.hotstuff.sigMu.RLock()
-	defer api.hotstuff.sigMu.RUnlock()
+// QuorumCertResult is the rpc representation of quorum cert.
+type QuorumCertResult struct {
+	Height   hexutil.Uint64 `json:"height"`
+	Round    hexutil.Uint64 `json:"round"`
+	Hash     common.Hash    `json:"hash"`
+	Proposer common.Address `json:"proposer"`
+}
 
-	proposals := make(map[common.Address]bool)
-	for address, auth := range api.hotstuff.proposals {
-		proposals[address] = auth
+func newQuorumCertResult(qc *hotstuff.QuorumCert) *QuorumCertResult {
+	if qc == nil {
+		return nil
+	}
+	return &QuorumCertResult{
+		Height:   hexutil.Uint64(qc.HeightU64()),
+		Round:    hexutil.Uint64(qc.RoundU64()),
+		Hash:     qc.Hash,
+		Proposer: qc.Proposer,
 	}
-	return proposals
 }
 
-// todo: add/del candidate validators approach console or api
-// Propose injects a new authorization candidate that the validator will attempt to
-// push through.
-func (api *API) Propose(address common.Address, auth bool) {
-	api.hotstuff.sigMu.Lock()
-	defer api.hotstuff.sigMu.Unlock()
+// StatusResult is the rpc representation of consensus core status in current round.
+type StatusResult struct {
+	Height    hexutil.Uint64         `json:"height"`
+	Round     hexutil.Uint64         `json:"round"`
+	State     string                 `json:"state"`
+	Proposer  common.Address         `json:"proposer"`
+	LockedQC  *QuorumCertResult      `json:"lockedQC"`
+	PrepareQC *QuorumCertResult      `json:"prepareQC"`
+	Requests  int                    `json:"requests"`
+	Backlogs  map[common.Address]int `json:"backlogs"`
+	Votes     map[common.Address]int `json:"votes"`
+}
+
+// EpochResult is the rpc representation of epoch held by local backend.
+type EpochResult struct {
+	StartHeight          hexutil.Uint64   `json:"startHeight"`
+	LastEpochStartHeight hexutil.Uint64   `json:"lastEpochStartHeight"`
+	Validators           []common.Address `json:"validators"`
+}
+
+// EventResult is the rpc representation of round change and commit notification.
+type EventResult struct {
+	Type     string         `json:"type"` // `round` or `commit`
+	Height   hexutil.Uint64 `json:"height"`
+	Round    hexutil.Uint64 `json:"round,omitempty"`
+	Proposer common.Address `json:"proposer"`
+	Hash     *common.Hash   `json:"hash,omitempty"`
+}
 
-	api.hotstuff.proposals[address] = auth
+// Status returns the current view, state, proposer, locked and prepare quorum certs, backlog sizes and
+// the number of messages received from every validator in current round.
+func (api *API) Status() (*StatusResult, error) {
+	status := api.hotstuff.core.Status()
+	if status == nil {
+		return nil, errEngineNotRunning
+	}
+	return &StatusResult{
+		Height:    hexutil.Uint64(status.View.Height.Uint64()),
+		Round:     hexutil.Uint64(status.View.Round.Uint64()),
+		State:     status.State,
+		Proposer:  status.Proposer,
+		LockedQC:  newQuorumCertResult(status.LockedQC),
+		PrepareQC: newQuorumCertResult(status.PrepareQC),
+		Requests:  status.Requests,
+		Backlogs:  status.Backlogs,
+		Votes:     status.Votes,
+	}, nil
 }
 
-// Discard drops a currently running candidate, stopping the validator from casting
-// further votes (either for or against).
-func (api *API) Discard(address common.Address) {
-	api.hotstuff.sigMu.Lock()
-	defer api.hotstuff.sigMu.Unlock()
+// Epochs returns the epochs held by local backend, sorted by start height.
+func (api *API) Epochs() []*EpochResult {
+	epochs := api.hotstuff.Epochs()
+	result := make([]*EpochResult, 0, len(epochs))
+	for _, epoch := range epochs {
+		result = append(result, &EpochResult{
+			StartHeight:          hexutil.Uint64(epoch.StartHeight),
+			LastEpochStartHeight: hexutil.Uint64(epoch.LastEpochStartHeight),
+			Validators:           epoch.ValSet.AddressList(),
+		})
+	}
+	return result
+}
 
-	delete(api.hotstuff.proposals, address)
+// GetBlockSigners retrieves the proposer, committers and validators of the block, latest block is used if number is nil.
+func (api *API) GetBlockSigners(number *rpc.BlockNumber) (*hotstuff.BlockSigners, error) {
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return api.hotstuff.blockSigners(header)
 }
+
+// GetBlockSignersAtHash retrieves the proposer, committers and validators of the block with given hash.
+func (api *API) GetBlockSignersAtHash(hash common.Hash) (*hotstuff.BlockSigners, error) {
+	header := api.chain.GetHeaderByHash(hash)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return api.hotstuff.blockSigners(header)
+}
+
+// GetParticipation counts the blocks proposed and signed by every validator in range [from, to].
+func (api *API) GetParticipation(from, to rpc.BlockNumber) (*hotstuff.ParticipationStats, error) {
+	current := api.chain.CurrentHeader().Number.Uint64()
+	start, end := uint64(from.Int64()), uint64(to.Int64())
+	if from < 0 {
+		start = current
+	}
+	if to < 0 {
+		end = current
+	}
+	return api.hotstuff.participation(api.chain, start, end)
+}
+
+// Evidences returns the rlp encoded equivocation evidences collected by local validator, every evidence
+// can be submitted to node manager contract by `reportEquivocation`.
+func (api *API) Evidences() ([]hexutil.Bytes, error) {
+	list := api.hotstuff.Evidences()
+	result := make([]hexutil.Bytes, 0, len(list))
+	for _, evidence := range list {
+		enc, err := rlp.EncodeToBytes(evidence)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, enc)
+	}
+	return result, nil
+}
+
+// Events streams round changes of consensus core and committed blocks.
+func (api *API) Events(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		rounds := make(chan hotstuff.RoundChangeEvent, eventChanSize)
+		commits := make(chan hotstuff.FinalCommittedEvent, eventChanSize)
+		roundSub := api.hotstuff.core.SubscribeRoundChange(rounds)
+		commitSub := api.hotstuff.SubscribeCommit(commits)
+		defer roundSub.Unsubscribe()
+		defer commitSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-rounds:
+				notifier.Notify(rpcSub.ID, &EventResult{
+					Type:     "round",
+					Height:   hexutil.Uint64(ev.View.Height.Uint64()),
+					Round:    hexutil.Uint64(ev.View.Round.Uint64()),
+					Proposer: ev.Proposer,
+				})
+			case ev := <-commits:
+				hash := ev.Header.Hash()
+				notifier.Notify(rpcSub.ID, &EventResult{
+					Type:     "commit",
+					Height:   hexutil.Uint64(ev.Header.Number.Uint64()),
+					Proposer: ev.Header.Coinbase,
+					Hash:     &hash,
+				})
+			case <-roundSub.Err():
+				return
+			case <-commitSub.Err():
+				return
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// eventChanSize is the size of channel listening to consensus events.
+const eventChanSize = 16
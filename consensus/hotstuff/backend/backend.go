@@ -27,10 +27,13 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/event_driven"
 	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
@@ -59,9 +62,13 @@ type backend struct {
 	recents        *lru.ARCCache // Snapshots for recent block to speed up reorgs
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+	evidences      *lru.ARCCache // the cache of equivocation evidences
+	seals          *lru.ARCCache // the committed seals of recent proposals, including the late ones
+	sealsMu        sync.Mutex
 
 	epochs              map[uint64]*Epoch // map epoch start height to epochs
 	maxEpochStartHeight uint64
+	epochMu             sync.RWMutex // Protects the epochs which is also read by rpc api
 
 	// The channels for hotstuff engine notifications
 	sealMu            sync.Mutex
@@ -77,15 +84,23 @@ type backend struct {
 
 	eventMux *event.TypeMux
 
-	proposals map[common.Address]bool // Current list of proposals we are pushing
+	commitFeed event.Feed
+
+	certified     map[common.Hash]*types.Block // blocks certified by validators but not inserted yet
+	certifiedHead common.Hash                  // the latest certified block, which the next block extends
+	certifiedMu   sync.RWMutex
+	certifiedFeed event.Feed
 }
 
-func New(config *hotstuff.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database) consensus.HotStuff {
+// New creates the hotstuff consensus engine, an error is returned if the BLS key or the epoch
+// persisted in database can't be loaded.
+func New(config *hotstuff.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database) (consensus.HotStuff, error) {
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	evidences, _ := lru.NewARC(inmemoryEvidences)
+	seals, _ := lru.NewARC(inmemorySeals)
 
-	signer := snr.NewSigner(privateKey)
 	backend := &backend{
 		config:         config,
 		db:             db,
@@ -93,19 +108,38 @@ func New(config *hotstuff.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 		commitCh:       make(chan *types.Block, 1),
 		coreStarted:    false,
 		eventMux:       new(event.TypeMux),
-		signer:         signer,
 		recentMessages: recentMessages,
 		knownMessages:  knownMessages,
+		evidences:      evidences,
+		seals:          seals,
 		recents:        recents,
-		proposals:      make(map[common.Address]bool),
+		certified:      make(map[common.Hash]*types.Block),
 	}
 
-	backend.loadForkConfig()
-	backend.core = core.New(backend, config, signer)
+	if backend.config.HotStuffConfig == nil {
+		backend.config.HotStuffConfig = new(params.HotStuffConfig)
+	}
+	var blsKey *big.Int
+	if config.BLSKeyFile != "" {
+		key, err := snr.LoadOrGenerateBLSKey(config.BLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load bls key failed, err: %v", err)
+		}
+		blsKey = key
+	}
+	signer := snr.NewBLSSigner(privateKey, blsKey, config.HotStuffConfig)
+	backend.signer = signer
+	backend.logger.Info("HotStuff signer", "address", signer.Address(), "bls public key", hexutil.Encode(signer.BLSPublicKey()), "bls proof", hexutil.Encode(signer.BLSProof()))
+	switch hotstuff.HotstuffProtocol(config.HotStuffConfig.Protocol) {
+	case hotstuff.HOTSTUFF_PROTOCOL_EVENT_DRIVEN:
+		backend.core = event_driven.New(backend, config, signer)
+	default:
+		backend.core = core.New(backend, config, signer)
+	}
 	if err := backend.LoadEpoch(); err != nil {
-		panic(fmt.Sprintf("load epoch failed, err: %v", err))
+		return nil, fmt.Errorf("load epoch failed, err: %v", err)
 	}
-	return backend
+	return backend, nil
 }
 
 // Address implements hotstuff.Backend.Address
@@ -206,7 +240,7 @@ func (s *backend) Unicast(valSet hotstuff.ValidatorSet, payload []byte) error {
 }
 
 // PreCommit implements hotstuff.Backend.PreCommit
-func (s *backend) PreCommit(proposal hotstuff.Proposal, seals [][]byte) (hotstuff.Proposal, error) {
+func (s *backend) PreCommit(proposal hotstuff.Proposal, committers []common.Address, seals [][]byte) (hotstuff.Proposal, error) {
 	// Check if the proposal is a valid block
 	block, ok := proposal.(*types.Block)
 	if !ok {
@@ -215,10 +249,10 @@ func (s *backend) PreCommit(proposal hotstuff.Proposal, seals [][]byte) (hotstuf
 	}
 
 	h := block.Header()
-	// Append seals into extra-data
-	if err := s.signer.SealAfterCommit(h, seals); err != nil {
+	if err := s.sealHeader(h, committers, seals); err != nil {
 		return nil, err
 	}
+	s.addSeals(h.Hash(), committers, seals)
 
 	// update block's header
 	block = block.WithSeal(h)
@@ -226,6 +260,14 @@ func (s *backend) PreCommit(proposal hotstuff.Proposal, seals [][]byte) (hotstuf
 	return block, nil
 }
 
+// sealHeader appends seals into extra-data, aggregate them into one BLS signature after fork
+func (s *backend) sealHeader(h *types.Header, committers []common.Address, seals [][]byte) error {
+	if s.config.HotStuffConfig.IsAggregateSeal(h.Number) {
+		return s.signer.SealAggregatedAfterCommit(h, s.Validators(h.Number.Uint64()), committers, seals)
+	}
+	return s.signer.SealAfterCommit(h, seals)
+}
+
 func (s *backend) ForwardCommit(proposal hotstuff.Proposal, extra []byte) (hotstuff.Proposal, error) {
 	block, ok := proposal.(*types.Block)
 	if !ok {
@@ -240,22 +282,35 @@ func (s *backend) ForwardCommit(proposal hotstuff.Proposal, extra []byte) (hotst
 	return block, nil
 }
 
-func (s *backend) Commit(proposal hotstuff.Proposal) error {
+func (s *backend) Commit(proposal hotstuff.Proposal, round *big.Int) error {
 	// Check if the proposal is a valid block
 	block, ok := proposal.(*types.Block)
 	if !ok {
 		s.logger.Error("Committed to miner worker", "proposal", "not block")
 		return errInvalidProposal
 	}
+	// the round is not part of the header, keep it locally for the block inspection api
+	if round != nil {
+		if err := rawdb.WriteCommitRound(s.db, block.Hash(), round.Uint64()); err != nil {
+			s.logger.Warn("Failed to store commit round", "hash", block.Hash(), "err", err)
+		}
+	}
+
+	// carry the seals of late votes in the committed block as well
+	if h, err := s.resealWithCollected(block.Header()); err != nil {
+		s.logger.Warn("Failed to seal late votes", "hash", block.Hash(), "err", err)
+	} else if h != nil {
+		block = block.WithSeal(h)
+	}
 
 	s.logger.Info("Committed", "address", s.Address(), "hash", proposal.Hash(), "number", proposal.Number().Uint64())
-	// - if the proposed and committed blocks are the same, send the proposed hash
-	//   to commit channel, which is being watched inside the engine.Seal() function.
-	// - otherwise, we try to insert the block.
+	// - if the proposed and committed blocks are the same and its parent has been inserted, send
+	//   the proposed hash to commit channel, which is being watched inside the engine.Seal() function.
+	// - otherwise, we try to insert the block, the block fetcher inserts it after the parent.
 	// -- if success, the ChainHeadEvent event will be broadcasted, try to build
 	//    the next block and the previous Seal() will be stopped.
 	// -- otherwise, a error will be returned and a round change event will be fired.
-	if s.proposedBlockHash == block.Hash() {
+	if s.proposedBlockHash == block.Hash() && s.chain.GetHeader(block.ParentHash(), block.NumberU64()-1) != nil {
 		// feed block hash to Seal() and wait the Seal() result
 		s.commitCh <- block
 		return nil
@@ -326,14 +381,13 @@ func (s *backend) VerifyUnsealedProposal(proposal hotstuff.Proposal) (time.Durat
 		return 0, errInvalidUncleHash
 	}
 
-	// verify the header of proposed block
-	if err := s.VerifyHeader(s.chain, block.Header(), false); err == nil {
-		return 0, nil
-	} else if err == consensus.ErrFutureBlock {
+	// verify the header of proposed block, which may extend a certified block not inserted yet
+	if err := s.verifyHeader(s.pendingChain(block.ParentHash()), block.Header(), nil, false); err == consensus.ErrFutureBlock {
 		return time.Unix(int64(block.Header().Time), 0).Sub(now()), consensus.ErrFutureBlock
-	} else {
+	} else if err != nil {
 		return 0, err
 	}
+	return 0, nil
 }
 
 func (s *backend) LastProposal() (hotstuff.Proposal, common.Address) {
@@ -381,13 +435,11 @@ func (s *backend) HasBadProposal(hash common.Hash) bool {
 	return s.hasBadBlock(hash)
 }
 
-func (s *backend) loadForkConfig() {
-	s.config.HotStuffConfig = new(params.HotStuffConfig)
-	s.logger.Info("loadforkconfig")
-	defaultForkFilePath := "maasfork.json"
-	err := s.config.HotStuffConfig.LoadForkConfig(defaultForkFilePath)
-	if err != nil {
-		log.Error("Load fork config file", "loading failed", err)
-		panic(err)
+// blockPeriod returns the minimum difference of two consecutive blocks' timestamp in second, the block
+// period of event-driven protocol is configured in milliseconds.
+func (s *backend) blockPeriod() uint64 {
+	if hotstuff.HotstuffProtocol(s.config.HotStuffConfig.Protocol) == hotstuff.HOTSTUFF_PROTOCOL_EVENT_DRIVEN {
+		return s.config.BlockPeriod / 1000
 	}
+	return s.config.BlockPeriod
 }
@@ -80,10 +80,10 @@ func TestVerifyHeader(t *testing.T) {
 	err = engine.VerifyHeader(chain, header, false)
 	assert.Equal(t, errInvalidDifficulty, err, "error mismatch")
 
-	// invalid timestamp
+	// timestamp in the future
 	block = makeBlockWithoutSeal(chain, engine, chain.Genesis())
 	header = block.Header()
-	header.Time = chain.Genesis().Time() + (engine.config.BlockPeriod - 1)
+	header.Time = uint64(now().Unix()) + engine.blockPeriod() + 1
 	err = engine.VerifyHeader(chain, header, false)
 	assert.Equal(t, errInvalidTimestamp, err, "error mismatch")
 }
@@ -134,8 +134,9 @@ OUT1:
 			break OUT1
 		}
 	}
-	// abort cases
+	// abort cases, results are buffered so that the verification is aborted before they are read
 	abort, results := engine.VerifyHeaders(chain, headers, nil)
+	close(abort)
 	timeout = time.NewTimer(timeoutDuration)
 	index = 0
 OUT2:
@@ -149,9 +150,6 @@ OUT2:
 				}
 			}
 			index++
-			if index == 5 {
-				abort <- struct{}{}
-			}
 			if index >= size {
 				t.Errorf("verifyheaders should be aborted")
 				break OUT2
@@ -199,17 +197,16 @@ func TestPrepareExtra(t *testing.T) {
 	validators[3] = common.BytesToAddress(hexutil.MustDecode("0x8be76812f765c24641ec63dc2852b378aba2b440"))
 
 	vanity := make([]byte, types.HotstuffExtraVanity)
-	expectedResult := append(vanity, hexutil.MustDecode("0xf858f85494294fc7e8f22b3bcdcf955dd7ff3ba2ed833f82129444add0ec310f115a0e603b2d7db9f067778eaf8a946beaaed781d2d2ab6350f5c4566a2c6eaac407a6948be76812f765c24641ec63dc2852b378aba2b44080c0")...)
+	expectedResult := append(vanity, hexutil.MustDecode("0xf859f85494294fc7e8f22b3bcdcf955dd7ff3ba2ed833f82129444add0ec310f115a0e603b2d7db9f067778eaf8a946beaaed781d2d2ab6350f5c4566a2c6eaac407a6948be76812f765c24641ec63dc2852b378aba2b44080c080")...)
 	h := &types.Header{
 		Extra: vanity,
 	}
 	valSet := makeValSet(validators)
-	payload, err := emptySigner.PrepareExtra(h, valSet)
-	assert.NoError(t, err)
-	assert.Equal(t, expectedResult, payload)
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(h, valSet.AddressList()))
+	assert.Equal(t, expectedResult, h.Extra)
 
 	// append useless information to extra-data
 	h.Extra = append(vanity, make([]byte, 15)...)
-	payload, err = emptySigner.PrepareExtra(h, valSet)
-	assert.Equal(t, expectedResult, payload)
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(h, valSet.AddressList()))
+	assert.Equal(t, expectedResult, h.Extra)
 }
@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Certify implements hotstuff.Backend.Certify, the certified block is kept in memory until it is final
+// and inserted, and miner builds the next block on top of it.
+func (s *backend) Certify(proposal hotstuff.Proposal) error {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		s.logger.Error("Invalid proposal, %v", proposal)
+		return errInvalidProposal
+	}
+
+	s.certifiedMu.Lock()
+	s.certified[block.Hash()] = block
+	s.certifiedHead = block.Hash()
+	s.certifiedMu.Unlock()
+
+	s.logger.Debug("Certified", "address", s.Address(), "hash", block.Hash(), "number", block.NumberU64())
+	s.certifiedFeed.Send(block)
+	return nil
+}
+
+// CertifiedBlocks implements consensus.HotStuff.CertifiedBlocks
+func (s *backend) CertifiedBlocks() []*types.Block {
+	s.certifiedMu.RLock()
+	defer s.certifiedMu.RUnlock()
+
+	return s.certifiedBranch(s.certifiedHead)
+}
+
+// SubscribeCertifiedHead implements consensus.HotStuff.SubscribeCertifiedHead
+func (s *backend) SubscribeCertifiedHead(ch chan<- *types.Block) event.Subscription {
+	return s.certifiedFeed.Subscribe(ch)
+}
+
+// certifiedBranch returns the certified blocks from the given one back to the first block whose parent is
+// not certified, in ascending order. The caller should hold the lock.
+func (s *backend) certifiedBranch(hash common.Hash) []*types.Block {
+	var branch []*types.Block
+	for block, ok := s.certified[hash]; ok; block, ok = s.certified[block.ParentHash()] {
+		branch = append(branch, block)
+	}
+	for i, j := 0, len(branch)-1; i < j; i, j = i+1, j-1 {
+		branch[i], branch[j] = branch[j], branch[i]
+	}
+	return branch
+}
+
+// pendingHeaders returns headers of the certified branch ending with the given block.
+func (s *backend) pendingHeaders(hash common.Hash) []*types.Header {
+	s.certifiedMu.RLock()
+	defer s.certifiedMu.RUnlock()
+
+	branch := s.certifiedBranch(hash)
+	headers := make([]*types.Header, len(branch))
+	for i, block := range branch {
+		headers[i] = block.Header()
+	}
+	return headers
+}
+
+// pendingChain returns the chain extended with the certified branch ending with the given block.
+func (s *backend) pendingChain(hash common.Hash) *consensus.PendingChain {
+	return consensus.NewPendingChain(s.chain, s, s.pendingHeaders(hash))
+}
+
+// pruneCertified drops the certified blocks which are not higher than the inserted chain head.
+func (s *backend) pruneCertified(number uint64) {
+	s.certifiedMu.Lock()
+	defer s.certifiedMu.Unlock()
+
+	for hash, block := range s.certified {
+		if block.NumberU64() <= number {
+			delete(s.certified, hash)
+		}
+	}
+}
@@ -19,12 +19,15 @@
 package backend
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/staking"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -97,11 +100,33 @@ func (s *backend) Prepare(chain consensus.ChainHeaderReader, header *types.Heade
 	header.Difficulty = defaultDifficulty
 
 	// set header's timestamp
-	header.Time = parent.Time + s.config.BlockPeriod
+	header.Time = parent.Time + s.blockPeriod()
 	if header.Time < uint64(time.Now().Unix()) {
 		header.Time = uint64(time.Now().Unix())
 	}
 
+	// carry the parent committed seals in the hashed extra fields for settlement
+	if s.settlesParent(header.Number) {
+		// the parent may be committed before the late votes arrived
+		sealed, err := s.resealWithCollected(parent)
+		if err != nil {
+			return err
+		}
+		if sealed == nil {
+			sealed = parent
+		}
+		if err := types.HotstuffHeaderFillWithParentSeal(header, sealed); err != nil {
+			return err
+		}
+	}
+
+	// fill verifiable random output and proof into extra salt
+	if s.config.HotStuffConfig.IsVRF(header.Number) {
+		if err := s.signer.SealVRF(header, parent); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -115,21 +140,121 @@ func accumulateRewards(state *state.StateDB, validators []common.Address, reward
 	}
 }
 
-func (s *backend) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) {
+// settlesParent returns true if the block settles the staking reward and downtime of the parent signers.
+func (s *backend) settlesParent(number *big.Int) bool {
+	cfg := s.config.HotStuffConfig
+	reward := cfg.IsStaking(number) && cfg.StakingBlockReward != nil
+	return (reward || cfg.IsDowntimeJail(number)) && number.Uint64() > 1
+}
+
+// parentCommitters verifies the parent committed seals carried in the header extra against the parent
+// hash and validators, and returns the committers. The seals of parent block itself are not used since
+// they are excluded from the block hash and may differ between nodes.
+func (s *backend) parentCommitters(header *types.Header) ([]common.Address, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	if !s.settlesParent(header.Number) {
+		if extra.HasParentSeal() {
+			return nil, errInvalidParentSeal
+		}
+		return nil, nil
+	}
+
+	number := header.Number.Uint64() - 1
+	valSet := s.Validators(number)
+	var committers []common.Address
+	if s.config.HotStuffConfig.IsAggregateSeal(new(big.Int).SetUint64(number)) {
+		if len(extra.ParentAggregatedSeal) == 0 || len(extra.ParentCommittedSeal) > 0 {
+			return nil, errInvalidParentSeal
+		}
+		committers, err = s.signer.GetSignersFromAggregatedSeal(valSet, header.ParentHash, extra.ParentAggregatedSeal, extra.ParentParticipantBitmap)
+	} else {
+		if len(extra.ParentCommittedSeal) == 0 || len(extra.ParentAggregatedSeal) > 0 || len(extra.ParentParticipantBitmap) > 0 {
+			return nil, errInvalidParentSeal
+		}
+		committers, err = s.signer.GetSignersFromCommittedSeals(header.ParentHash, extra.ParentCommittedSeal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := valSet.CheckQuorum(committers); err != nil {
+		return nil, errInvalidParentSeal
+	}
+	return committers, nil
+}
+
+// settleParentSigners mints the staking block reward to the validators which committed the parent block,
+// and records the proposer and these committers as active for downtime jailing. The committed seals are only
+// available after the block is sealed so that they are settled in the next block with the parent seals in
+// its extra.
+func (s *backend) settleParentSigners(header *types.Header, state *state.StateDB) error {
+	if !s.settlesParent(header.Number) {
+		return nil
+	}
+	committers, err := s.parentCommitters(header)
+	if err != nil {
+		return err
+	}
+	cfg := s.config.HotStuffConfig
+	number := header.Number.Uint64()
+	if cfg.IsStaking(header.Number) && cfg.StakingBlockReward != nil {
+		if err := staking.DistributeRewards(state, committers, cfg.StakingBlockReward); err != nil {
+			return fmt.Errorf("failed to distribute staking rewards: %v", err)
+		}
+	}
+	if cfg.IsDowntimeJail(header.Number) {
+		// the committers signed the parent block, they are tracked among the validators of parent
+		active := append([]common.Address{header.Coinbase}, committers...)
+		if err := node_manager.TrackDowntime(state, number, s.Validators(number-1).AddressList(), active); err != nil {
+			return fmt.Errorf("failed to track validators downtime: %v", err)
+		}
+	}
+	return nil
+}
+
+// rotateEpoch elects the next epoch validators from stake ranking at the end of block, it takes effect
+// only ahead of the epoch boundary.
+func (s *backend) rotateEpoch(header *types.Header, state *state.StateDB) error {
+	cfg := s.config.HotStuffConfig
+	if !cfg.IsEpochRotation(header.Number) {
+		return nil
+	}
+	if err := node_manager.RotateEpoch(state, header.Number.Uint64(), cfg.EpochRotationBlock.Uint64(), cfg.EpochLength); err != nil {
+		return fmt.Errorf("failed to rotate epoch: %v", err)
+	}
+	return nil
+}
+
+func (s *backend) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header) error {
 	// reward validators at forking start block
-	if header.Number.Uint64() == s.config.HotStuffConfig.ForkHeight {
-		accumulateRewards(state, s.Validators(s.config.HotStuffConfig.ForkHeight).AddressList(), s.config.HotStuffConfig.Incentive)
+	if cfg := s.config.HotStuffConfig; cfg.IsForkHeight(header.Number.Uint64()) {
+		accumulateRewards(state, s.Validators(cfg.ForkHeight).AddressList(), cfg.Incentive)
+	}
+	if err := s.settleParentSigners(header, state); err != nil {
+		return err
+	}
+	if err := s.rotateEpoch(header, state); err != nil {
+		return err
 	}
 	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
+	return nil
 }
 
 func (s *backend) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
 	uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
 	// reward validators at forking start block
-	if header.Number.Uint64() == s.config.HotStuffConfig.ForkHeight {
-		accumulateRewards(state, s.Validators(s.config.HotStuffConfig.ForkHeight).AddressList(), s.config.HotStuffConfig.Incentive)
+	if cfg := s.config.HotStuffConfig; cfg.IsForkHeight(header.Number.Uint64()) {
+		accumulateRewards(state, s.Validators(cfg.ForkHeight).AddressList(), cfg.Incentive)
+	}
+	if err := s.settleParentSigners(header, state); err != nil {
+		return nil, err
+	}
+	if err := s.rotateEpoch(header, state); err != nil {
+		return nil, err
 	}
 	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
@@ -188,7 +313,7 @@ func (s *backend) SealHash(header *types.Header) common.Hash {
 }
 
 func (s *backend) ValidateBlock(block *types.Block) error {
-	return s.chain.PreExecuteBlock(block)
+	return s.chain.PreExecuteBlock(block, s.pendingHeaders(block.ParentHash()))
 }
 
 // useless
@@ -198,7 +323,7 @@ func (s *backend) CalcDifficulty(chain consensus.ChainHeaderReader, time uint64,
 
 func (s *backend) APIs(chain consensus.ChainHeaderReader) []rpc.API {
 	return []rpc.API{{
-		Namespace: "istanbul",
+		Namespace: "hotstuff",
 		Version:   "1.0",
 		Service:   &API{chain: chain, hotstuff: s},
 		Public:    true,
@@ -293,7 +418,7 @@ func (s *backend) verifyHeader(chain consensus.ChainHeaderReader, header *types.
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
-	if header.Time > parent.Time+s.config.BlockPeriod && header.Time > uint64(now().Unix()) {
+	if header.Time > parent.Time+s.blockPeriod() && header.Time > uint64(now().Unix()) {
 		return errInvalidTimestamp
 	}
 
@@ -301,7 +426,16 @@ func (s *backend) verifyHeader(chain consensus.ChainHeaderReader, header *types.
 		return err
 	}
 	vals := s.Validators(number)
-	return s.signer.VerifyHeader(header, vals, seal)
+	if err := s.signer.VerifyHeader(header, vals, seal); err != nil {
+		return err
+	}
+	if _, err := s.parentCommitters(header); err != nil {
+		return err
+	}
+	if s.config.HotStuffConfig.IsVRF(header.Number) {
+		return s.signer.VerifyVRF(header, parent)
+	}
+	return nil
 }
 
 func (s *backend) getPendingParentHeader(chain consensus.ChainHeaderReader, header *types.Header) (*types.Header, error) {
@@ -20,11 +20,20 @@ package backend
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"math/big"
 	"reflect"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +41,8 @@ import (
 func TestSealStopChannel(t *testing.T) {
 	chain, engine := singleNodeChain()
 	block := makeBlockWithoutSeal(chain, engine, chain.Genesis())
+	// the single validator commits the block on its own, stop the core so that only the stop channel fires
+	assert.NoError(t, engine.Stop())
 	stop := make(chan struct{}, 1)
 	eventSub := engine.EventMux().Subscribe(hotstuff.RequestEvent{})
 	eventLoop := func() {
@@ -71,7 +82,7 @@ func TestSealPreCommitOtherHash(t *testing.T) {
 		if _, ok := ev.Data.(hotstuff.RequestEvent); !ok {
 			t.Errorf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
 		}
-		if _, err := engine.PreCommit(otherBlock, [][]byte{expectedCommittedSeal}); err != nil {
+		if _, err := engine.PreCommit(otherBlock, nil, [][]byte{expectedCommittedSeal}); err != nil {
 			t.Error(err.Error())
 		}
 		eventSub.Unsubscribe()
@@ -119,6 +130,9 @@ func TestSealCommitted(t *testing.T) {
 	if finalBlock.Hash() != expectedBlock.Hash() {
 		t.Errorf("hash mismatch: have %v, want %v", finalBlock.Hash(), expectedBlock.Hash())
 	}
+	// the commit round is recorded, which is not always the first round if the view timed out
+	_, ok := rawdb.ReadCommitRound(engine.db, finalBlock.Hash())
+	assert.True(t, ok)
 }
 
 // go test -v -count=1 github.com/ethereum/go-ethereum/consensus/hotstuff/basic/backend -run TestInsertChain
@@ -127,7 +141,7 @@ func TestInsertChain(t *testing.T) {
 	expectBlock := makeBlock(t, chain, engine, chain.Genesis())
 	chain.InsertChain(types.Blocks{expectBlock})
 	block := chain.GetBlockByNumber(1)
-	assert.Equal(t, expectBlock, block)
+	assert.Equal(t, expectBlock.Hash(), block.Hash())
 }
 
 func TestContinueBlock(t *testing.T) {
@@ -142,3 +156,83 @@ func TestContinueBlock(t *testing.T) {
 		t.Logf("generate block %d, hash %s", block.NumberU64(), block.Hash().Hex())
 	}
 }
+
+func TestParentCommitters(t *testing.T) {
+	s, chain, addrs := newInspectBackend(t)
+	s.config.HotStuffConfig.DowntimeJailBlock = big.NewInt(5)
+
+	header := &types.Header{ParentHash: chain[4].Hash(), Number: big.NewInt(5), MixDigest: types.HotstuffDigest}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, nil))
+
+	// parent seals are required after fork
+	_, err := s.parentCommitters(header)
+	assert.Equal(t, errInvalidParentSeal, err)
+
+	assert.NoError(t, types.HotstuffHeaderFillWithParentSeal(header, chain[4]))
+	committers, err := s.parentCommitters(header)
+	assert.NoError(t, err)
+	assert.Equal(t, addrs[:2], committers)
+
+	// seals signed for another block
+	other := types.CopyHeader(header)
+	assert.NoError(t, types.HotstuffHeaderFillWithParentSeal(other, chain[3]))
+	_, err = s.parentCommitters(other)
+	assert.Error(t, err)
+
+	// parent seals are not allowed before fork
+	s.config.HotStuffConfig.DowntimeJailBlock = big.NewInt(6)
+	_, err = s.parentCommitters(header)
+	assert.Equal(t, errInvalidParentSeal, err)
+}
+
+func TestCollectSeal(t *testing.T) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	addrs := make([]common.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	seals, _ := lru.NewARC(inmemorySeals)
+	s := &backend{
+		config: &hotstuff.Config{HotStuffConfig: &params.HotStuffConfig{DowntimeJailBlock: common.Big1}},
+		signer: snr.NewSigner(keys[0], &params.HotStuffConfig{}),
+		epochs: map[uint64]*Epoch{0: {StartHeight: 0, ValSet: newValSet(addrs)}},
+		seals:  seals,
+		logger: log.New(),
+	}
+	genesis := &types.Header{Number: common.Big0, Difficulty: defaultDifficulty, MixDigest: types.HotstuffDigest}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(genesis, addrs))
+
+	// the parent is sealed with the quorum, the last validator votes late
+	parent := makeSignedHeader(t, genesis, keys[0], keys[:3])
+	extra, err := types.ExtractHotstuffExtra(parent)
+	assert.NoError(t, err)
+	s.addSeals(parent.Hash(), addrs[:3], extra.CommittedSeal)
+	sealed, err := s.resealWithCollected(parent)
+	assert.NoError(t, err)
+	assert.Nil(t, sealed)
+
+	late, err := snr.NewSigner(keys[3], &params.HotStuffConfig{}).SignHash(parent.Hash())
+	assert.NoError(t, err)
+	// seals claimed by another validator or signed by stranger are dropped
+	s.CollectSeal(1, parent.Hash(), addrs[2], late)
+	stranger, _ := crypto.GenerateKey()
+	forged, err := snr.NewSigner(stranger, &params.HotStuffConfig{}).SignHash(parent.Hash())
+	assert.NoError(t, err)
+	s.CollectSeal(1, parent.Hash(), crypto.PubkeyToAddress(stranger.PublicKey), forged)
+	sealed, err = s.resealWithCollected(parent)
+	assert.NoError(t, err)
+	assert.Nil(t, sealed)
+
+	s.CollectSeal(1, parent.Hash(), addrs[3], late)
+	sealed, err = s.resealWithCollected(parent)
+	assert.NoError(t, err)
+	assert.Equal(t, parent.Hash(), sealed.Hash())
+
+	header := &types.Header{ParentHash: parent.Hash(), Number: big.NewInt(2), MixDigest: types.HotstuffDigest}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, nil))
+	assert.NoError(t, types.HotstuffHeaderFillWithParentSeal(header, sealed))
+	committers, err := s.parentCommitters(header)
+	assert.NoError(t, err)
+	assert.Equal(t, addrs, committers)
+}
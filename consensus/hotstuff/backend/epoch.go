@@ -21,8 +21,11 @@ package backend
 import (
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
 	"github.com/ethereum/go-ethereum/core"
@@ -42,22 +45,52 @@ func init() {
 			StartHeight:          0,
 			ValSet:               newValSet(extra.Validators),
 			LastEpochStartHeight: 0,
+			BLSKeys:              extraBLSKeys(extra),
 		}
 		return storeCurEpoch(db, epoch)
 	}
 }
 
 func (s *backend) Validators(height uint64) hotstuff.ValidatorSet {
+	s.epochMu.RLock()
+	defer s.epochMu.RUnlock()
+
 	startHeight := s.maxEpochStartHeight
+	if height > startHeight {
+		if list := s.announcedValidators(height); len(list) > 0 {
+			return s.policyValSet(newValSet(list), height)
+		}
+	}
 	for height < startHeight {
 		epoch := s.epochs[startHeight]
 		if height >= epoch.StartHeight {
-			return s.epochs[epoch.StartHeight].ValSet.Copy()
+			return s.policyValSet(s.epochs[epoch.StartHeight].ValSet, height)
 		} else {
 			startHeight = epoch.LastEpochStartHeight
 		}
 	}
-	return s.epochs[startHeight].ValSet.Copy()
+	return s.policyValSet(s.epochs[startHeight].ValSet, height)
+}
+
+// leaderPolicy returns the proposer selection policy at height, vrf is switched on by the chain
+// config fork for all validators at once and the local config only chooses among the others.
+func (s *backend) leaderPolicy(height uint64) hotstuff.SelectProposerPolicy {
+	if s.config.HotStuffConfig.IsVRF(new(big.Int).SetUint64(height)) {
+		return hotstuff.VRF
+	}
+	if s.config.LeaderPolicy == hotstuff.VRF {
+		return hotstuff.RoundRobin
+	}
+	return s.config.LeaderPolicy
+}
+
+// policyValSet copy the epoch validators with the leader policy at height.
+func (s *backend) policyValSet(valSet hotstuff.ValidatorSet, height uint64) hotstuff.ValidatorSet {
+	policy := s.leaderPolicy(height)
+	if valSet.Policy() == policy {
+		return valSet.Copy()
+	}
+	return validator.NewSet(valSet.AddressList(), policy)
 }
 
 func (s *backend) LoadEpoch() error {
@@ -85,17 +118,59 @@ func (s *backend) LoadEpoch() error {
 			return err
 		}
 	}
+
+	// the later epoch overrides the BLS keys of earlier ones
+	for _, epoch := range s.Epochs() {
+		if err := s.addBLSKeys(epoch.BLSKeys); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// announceEpoch saves the epoch starting at the next height when the imported block announces the
+// next validators in header extra, or the next height is the fork height, so that the consensus core
+// switches to the new validators atomically when it enters the new height.
+func (s *backend) announceEpoch(header *types.Header) error {
+	height := header.Number.Uint64() + 1
+	if s.config.HotStuffConfig.IsForkHeight(height) {
+		return s.saveEpoch(height, s.config.HotStuffConfig.Validators(), nil)
+	}
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	if len(extra.Validators) == 0 {
+		return nil
+	}
+	return s.saveEpoch(height, extra.Validators, extraBLSKeys(extra))
+}
+
+// announcedValidators returns the validators of the epoch starting at height which is announced by the
+// committed parent block but not saved yet, the core may enter the new height before the block import
+// is handled. It only reads the chain and never writes the epoch, the caller should hold the epoch lock.
+func (s *backend) announcedValidators(height uint64) []common.Address {
+	if height <= 1 || s.chain == nil {
+		return nil
+	}
+	if s.config.HotStuffConfig.IsForkHeight(height) {
+		return s.config.HotStuffConfig.Validators()
+	}
+	parent := s.chain.GetHeaderByNumber(height - 1)
+	if parent == nil {
+		return nil
+	}
+	extra, err := types.ExtractHotstuffExtra(parent)
+	if err != nil {
+		return nil
+	}
+	return extra.Validators
+}
+
 func (s *backend) UpdateEpoch(parent, header *types.Header) error {
 	height := header.Number.Uint64()
-	if height == s.config.HotStuffConfig.ForkHeight {
-		forkingValidators := make([]common.Address, 0)
-		for _, validator := range s.config.HotStuffConfig.ForkValidators {
-			forkingValidators = append(forkingValidators, common.HexToAddress(validator))
-		}
-		s.saveEpoch(height, forkingValidators)
+	if s.config.HotStuffConfig.IsForkHeight(height) {
+		s.saveEpoch(height, s.config.HotStuffConfig.Validators(), nil)
 	}
 	if height <= s.maxEpochStartHeight || height == 1 {
 		return nil
@@ -109,14 +184,17 @@ func (s *backend) UpdateEpoch(parent, header *types.Header) error {
 	if parentExt.Validators == nil || len(parentExt.Validators) == 0 {
 		return nil
 	}
-	return s.saveEpoch(height, parentExt.Validators)
+	return s.saveEpoch(height, parentExt.Validators, extraBLSKeys(parentExt))
 }
 
 func (s *backend) ChangeEpoch(height uint64, list []common.Address) error {
-	return s.saveEpoch(height, list)
+	return s.saveEpoch(height, list, nil)
 }
 
 func (s *backend) DumpEpochs() string {
+	s.epochMu.RLock()
+	defer s.epochMu.RUnlock()
+
 	str := ""
 	for _, v := range s.epochs {
 		str += v.String() + "\r\n"
@@ -124,7 +202,27 @@ func (s *backend) DumpEpochs() string {
 	return str
 }
 
-func (s *backend) saveEpoch(height uint64, list []common.Address) error {
+// Epochs returns a copy of epochs held by backend, sorted by start height.
+func (s *backend) Epochs() []*Epoch {
+	s.epochMu.RLock()
+	defer s.epochMu.RUnlock()
+
+	list := make([]*Epoch, 0, len(s.epochs))
+	for _, epoch := range s.epochs {
+		list = append(list, epoch.Copy())
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].StartHeight < list[j].StartHeight
+	})
+	return list
+}
+
+// saveEpoch persists the epoch starting at height, the BLS keys published in node manager are registered
+// in signer, and it returns an error without saving the epoch if any of them is invalid.
+func (s *backend) saveEpoch(height uint64, list []common.Address, keys map[common.Address]*BLSKey) error {
+	s.epochMu.Lock()
+	defer s.epochMu.Unlock()
+
 	if _, ok := s.epochs[height]; ok {
 		return nil
 	}
@@ -132,11 +230,15 @@ func (s *backend) saveEpoch(height uint64, list []common.Address) error {
 		log.Warn("[epoch]", "dump epoch", "epoch should be persisted before", "max epoch height", s.maxEpochStartHeight)
 		return nil
 	}
+	if err := s.addBLSKeys(keys); err != nil {
+		return err
+	}
 
 	epoch := &Epoch{
 		StartHeight:          height,
 		ValSet:               newValSet(list),
 		LastEpochStartHeight: s.maxEpochStartHeight,
+		BLSKeys:              keys,
 	}
 	if err := storeCurEpoch(s.db, epoch); err != nil {
 		return err
@@ -148,16 +250,42 @@ func (s *backend) saveEpoch(height uint64, list []common.Address) error {
 	return nil
 }
 
+func (s *backend) addBLSKeys(keys map[common.Address]*BLSKey) error {
+	for addr, key := range keys {
+		if err := s.signer.AddBLSPublicKey(addr, key.PubKey, key.Proof); err != nil {
+			return fmt.Errorf("invalid BLS key of validator %s, err: %v", addr.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// extraBLSKeys returns the BLS keys of validators announced in header extra, validators without key
+// published are skipped.
+func extraBLSKeys(extra *types.HotstuffExtra) map[common.Address]*BLSKey {
+	if !extra.HasBLSKeys() || len(extra.ValidatorBLSKeys) != len(extra.Validators) || len(extra.ValidatorBLSProofs) != len(extra.Validators) {
+		return nil
+	}
+	keys := make(map[common.Address]*BLSKey)
+	for i, addr := range extra.Validators {
+		if len(extra.ValidatorBLSKeys[i]) > 0 {
+			keys[addr] = &BLSKey{PubKey: extra.ValidatorBLSKeys[i], Proof: extra.ValidatorBLSProofs[i]}
+		}
+	}
+	return keys
+}
+
 func (s *backend) readEpoch(height uint64) (*Epoch, error) {
 	epoch, err := getEpochByHeight(s.db, height)
 	if err != nil {
 		log.Warn("[epoch]", "read epoch failed", err)
 		return nil, err
 	}
+	s.epochMu.Lock()
 	s.epochs[epoch.StartHeight] = epoch
 	if epoch.StartHeight > s.maxEpochStartHeight {
 		s.maxEpochStartHeight = epoch.StartHeight
 	}
+	s.epochMu.Unlock()
 	log.Info("[epoch]", "read epoch", epoch.String())
 	return epoch, nil
 }
@@ -197,6 +325,13 @@ type Epoch struct {
 	StartHeight          uint64
 	ValSet               hotstuff.ValidatorSet
 	LastEpochStartHeight uint64
+	BLSKeys              map[common.Address]*BLSKey // BLS keys of validators published in node manager
+}
+
+// BLSKey is the BLS public key of validator with the proof of possession.
+type BLSKey struct {
+	PubKey hexutil.Bytes `json:"pub_key"`
+	Proof  hexutil.Bytes `json:"proof"`
 }
 
 func (e *Epoch) Copy() *Epoch {
@@ -204,6 +339,7 @@ func (e *Epoch) Copy() *Epoch {
 		StartHeight:          e.StartHeight,
 		ValSet:               e.ValSet.Copy(),
 		LastEpochStartHeight: e.LastEpochStartHeight,
+		BLSKeys:              e.BLSKeys,
 	}
 }
 
@@ -213,9 +349,10 @@ func (e *Epoch) String() string {
 }
 
 type epochJSON struct {
-	StartHeight          uint64           `json:"start_height"`
-	Validators           []common.Address `json:"validators"`
-	LastEpochStartHeight uint64           `json:"last_epoch_start_height"`
+	StartHeight          uint64                     `json:"start_height"`
+	Validators           []common.Address           `json:"validators"`
+	LastEpochStartHeight uint64                     `json:"last_epoch_start_height"`
+	BLSKeys              map[common.Address]*BLSKey `json:"bls_keys,omitempty"`
 }
 
 func (e *Epoch) toJSONStruct() *epochJSON {
@@ -223,6 +360,7 @@ func (e *Epoch) toJSONStruct() *epochJSON {
 		StartHeight:          e.StartHeight,
 		Validators:           e.ValSet.AddressList(),
 		LastEpochStartHeight: e.LastEpochStartHeight,
+		BLSKeys:              e.BLSKeys,
 	}
 }
 
@@ -236,6 +374,7 @@ func (e *Epoch) UnmarshalJSON(b []byte) error {
 	e.StartHeight = j.StartHeight
 	e.ValSet = newValSet(j.Validators)
 	e.LastEpochStartHeight = j.LastEpochStartHeight
+	e.BLSKeys = j.BLSKeys
 	return nil
 }
 
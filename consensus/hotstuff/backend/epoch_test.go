@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// testChainReader is a testHeaderChain without block bodies.
+type testChainReader struct {
+	testHeaderChain
+}
+
+func (c *testChainReader) GetBlock(hash common.Hash, number uint64) *types.Block { return nil }
+func (c *testChainReader) GetBlockByHash(hash common.Hash) *types.Block          { return nil }
+func (c *testChainReader) PreExecuteBlock(block *types.Block, pending []*types.Header) error {
+	return nil
+}
+
+func TestAnnounceEpoch(t *testing.T) {
+	s, chain, addrs := newInspectBackend(t)
+	s.db = rawdb.NewMemoryDatabase()
+	reader := &testChainReader{chain}
+	s.chain = reader
+
+	// block 5 announces the validators of the epoch starting at block 6
+	header := &types.Header{
+		ParentHash: chain[4].Hash(),
+		Number:     big.NewInt(5),
+		Difficulty: defaultDifficulty,
+		MixDigest:  types.HotstuffDigest,
+	}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, addrs[:2]))
+
+	// the block without announcement does not change epoch
+	assert.Equal(t, ErrStoppedEngine, s.NewChainHead(chain[4]))
+	assert.Equal(t, uint64(3), s.maxEpochStartHeight)
+	assert.ElementsMatch(t, addrs[:3], s.Validators(5).AddressList())
+
+	// the core may ask for the new validators before the block import is handled, which never saves the epoch
+	reader.testHeaderChain = append(reader.testHeaderChain, header)
+	assert.ElementsMatch(t, addrs[:2], s.Validators(6).AddressList())
+	assert.ElementsMatch(t, addrs[:3], s.Validators(5).AddressList())
+	assert.Equal(t, uint64(3), s.maxEpochStartHeight)
+	_, err := getEpochByHeight(s.db, 6)
+	assert.Error(t, err)
+
+	// the epoch is persisted on block import even if the engine is stopped
+	assert.Equal(t, ErrStoppedEngine, s.NewChainHead(header))
+	assert.Equal(t, uint64(6), s.maxEpochStartHeight)
+	epoch, err := getCurEpoch(s.db)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), epoch.StartHeight)
+	assert.Equal(t, uint64(3), epoch.LastEpochStartHeight)
+	assert.ElementsMatch(t, addrs[:2], epoch.ValSet.AddressList())
+	assert.ElementsMatch(t, addrs[:2], s.Validators(6).AddressList())
+	assert.ElementsMatch(t, addrs[:3], s.Validators(5).AddressList())
+
+	// the announced epoch is saved once
+	assert.NoError(t, s.announceEpoch(header))
+	assert.Len(t, s.epochs, 3)
+}
+
+func TestAnnounceEpochBLSKeys(t *testing.T) {
+	s, chain, addrs := newInspectBackend(t)
+	s.db = rawdb.NewMemoryDatabase()
+
+	key, _ := crypto.GenerateKey()
+	blsKey, err := snr.GenerateBLSKey()
+	assert.NoError(t, err)
+	validator := snr.NewBLSSigner(key, blsKey, nil)
+
+	header := &types.Header{
+		ParentHash: chain[4].Hash(),
+		Number:     big.NewInt(5),
+		Difficulty: defaultDifficulty,
+		MixDigest:  types.HotstuffDigest,
+	}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, addrs[:2]))
+	assert.NoError(t, types.HotstuffHeaderFillWithBLSKeys(header, [][]byte{validator.BLSPublicKey(), nil}, [][]byte{s.signer.BLSProof(), nil}))
+
+	// the epoch is not saved with invalid BLS keys
+	assert.Error(t, s.announceEpoch(header))
+	assert.Equal(t, uint64(3), s.maxEpochStartHeight)
+
+	assert.NoError(t, types.HotstuffHeaderFillWithBLSKeys(header, [][]byte{validator.BLSPublicKey(), nil}, [][]byte{validator.BLSProof(), nil}))
+	assert.NoError(t, s.announceEpoch(header))
+	epoch, err := getCurEpoch(s.db)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), epoch.StartHeight)
+	assert.Len(t, epoch.BLSKeys, 1)
+	assert.Equal(t, hexutil.Bytes(validator.BLSPublicKey()), epoch.BLSKeys[addrs[0]].PubKey)
+
+	// the key published in node manager is used to verify the committed seal
+	hash := common.HexToHash("0x01")
+	seal, err := validator.SignAggregatableHash(hash)
+	assert.NoError(t, err)
+	assert.NoError(t, s.signer.VerifyAggregatableHash(addrs[0], hash, seal))
+	assert.Error(t, s.signer.VerifyAggregatableHash(addrs[1], hash, seal))
+}
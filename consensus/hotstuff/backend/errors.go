@@ -47,10 +47,16 @@ var (
 	errInvalidCommittedSeals = errors.New("invalid committed seals")
 	// errEmptyCommittedSeals is returned if the field of committed seals is zero.
 	errEmptyCommittedSeals = errors.New("zero committed seals")
+	// errInvalidParentSeal is returned if the parent committed seals carried in extra are missing, unexpected or not a quorum.
+	errInvalidParentSeal = errors.New("invalid parent committed seals")
 	// errMismatchTxhashes is returned if the TxHash in header is mismatch.
 	errMismatchTxhashes = errors.New("mismatch transactions hashes")
 	// errDecodeFailed is returned if the message can't be decode
 	errDecodeFailed = errors.New("decode p2p message failed")
 	// errBadProposal
 	errBADProposal = errors.New("bad proposal")
+	// errUnknownEpoch is returned if no epoch held by backend takes effect at the height.
+	errUnknownEpoch = errors.New("unknown epoch")
+	// errInvalidBlockRange is returned if the block range of participation statistics is invalid or too large.
+	errInvalidBlockRange = errors.New("invalid block range")
 )
@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// inmemoryEvidences is the number of equivocation evidences kept for submitting to node manager contract
+const inmemoryEvidences = 128
+
+// ReportEvidence implements hotstuff.Backend.ReportEvidence
+func (s *backend) ReportEvidence(evidence *hotstuff.Evidence) {
+	offender, view, _, err := evidence.Verify(s.config.ChainID)
+	if err != nil {
+		s.logger.Warn("Drop invalid evidence", "evidence", evidence.Hash(), "err", err)
+		return
+	}
+	if s.evidences.Contains(evidence.Hash()) {
+		return
+	}
+	s.evidences.Add(evidence.Hash(), evidence)
+	s.logger.Warn("Collect equivocation evidence", "offender", offender, "view", view, "evidence", evidence.Hash())
+}
+
+// Evidences returns the collected equivocation evidences.
+func (s *backend) Evidences() []*hotstuff.Evidence {
+	keys := s.evidences.Keys()
+	list := make([]*hotstuff.Evidence, 0, len(keys))
+	for _, key := range keys {
+		if data, ok := s.evidences.Peek(key); ok {
+			list = append(list, data.(*hotstuff.Evidence))
+		}
+	}
+	return list
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -116,11 +118,27 @@ func (s *backend) SetBroadcaster(broadcaster consensus.Broadcaster) {
 }
 
 func (s *backend) NewChainHead(header *types.Header) error {
+	if err := s.announceEpoch(header); err != nil {
+		log.Error("[epoch]", "save announced epoch failed", err, "height", header.Number)
+		return err
+	}
+
 	s.coreMu.RLock()
-	defer s.coreMu.RUnlock()
 	if !s.coreStarted {
+		s.coreMu.RUnlock()
 		return ErrStoppedEngine
 	}
+	s.pruneCertified(header.Number.Uint64())
 	go s.eventMux.Post(hotstuff.FinalCommittedEvent{Header: header})
+	s.coreMu.RUnlock()
+
+	// subscribers may be slow to receive, send the event out of the lock so that the engine can be
+	// started or stopped meanwhile.
+	s.commitFeed.Send(hotstuff.FinalCommittedEvent{Header: header})
 	return nil
 }
+
+// SubscribeCommit subscribes the event of new block committed
+func (s *backend) SubscribeCommit(ch chan<- hotstuff.FinalCommittedEvent) event.Subscription {
+	return s.commitFeed.Subscribe(ch)
+}
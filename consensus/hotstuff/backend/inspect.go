@@ -0,0 +1,143 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// maxParticipationRange is the max number of blocks counted in participation statistics
+const maxParticipationRange = 10000
+
+// blockSigners decodes the header extra into proposer and committers, and attaches the epoch at the height.
+func (s *backend) blockSigners(header *types.Header) (*hotstuff.BlockSigners, error) {
+	number := header.Number.Uint64()
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := s.epochAt(number)
+	if epoch == nil {
+		return nil, errUnknownEpoch
+	}
+	valSet := s.Validators(number)
+	result := &hotstuff.BlockSigners{
+		Number:           hexutil.Uint64(number),
+		Hash:             header.Hash(),
+		Committers:       []common.Address{},
+		Aggregated:       extra.IsAggregated(),
+		EpochStartHeight: hexutil.Uint64(epoch.StartHeight),
+		Validators:       valSet.AddressList(),
+	}
+	// genesis block is not signed
+	if number == 0 {
+		return result, nil
+	}
+
+	if result.Proposer, err = s.signer.Recover(header); err != nil {
+		return nil, err
+	}
+	if extra.IsAggregated() {
+		result.Committers, err = s.signer.GetSignersFromAggregatedSeal(valSet, header.Hash(), extra.AggregatedSeal, extra.ParticipantBitmap)
+	} else {
+		result.Committers, err = s.signer.GetSignersFromCommittedSeals(header.Hash(), extra.CommittedSeal)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if round, ok := rawdb.ReadCommitRound(s.db, result.Hash); ok {
+		value := hexutil.Uint64(round)
+		result.Round = &value
+	}
+	return result, nil
+}
+
+// participation counts proposed and signed blocks of every validator in range [from, to].
+func (s *backend) participation(chain consensus.ChainHeaderReader, from, to uint64) (*hotstuff.ParticipationStats, error) {
+	if from > to || to-from >= maxParticipationRange {
+		return nil, errInvalidBlockRange
+	}
+
+	var (
+		stats = make(map[common.Address]*hotstuff.Participation)
+		order = make([]common.Address, 0)
+	)
+	get := func(addr common.Address) *hotstuff.Participation {
+		if _, ok := stats[addr]; !ok {
+			stats[addr] = &hotstuff.Participation{Address: addr}
+			order = append(order, addr)
+		}
+		return stats[addr]
+	}
+
+	for number := from; number <= to; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+		signers, err := s.blockSigners(header)
+		if err != nil {
+			return nil, err
+		}
+		if number == 0 {
+			continue
+		}
+		for _, addr := range signers.Validators {
+			get(addr).Blocks++
+		}
+		get(signers.Proposer).Proposed++
+		for _, addr := range signers.Committers {
+			get(addr).Signed++
+		}
+	}
+
+	result := &hotstuff.ParticipationStats{
+		From:       hexutil.Uint64(from),
+		To:         hexutil.Uint64(to),
+		Validators: make([]*hotstuff.Participation, 0, len(order)),
+	}
+	for _, addr := range order {
+		result.Validators = append(result.Validators, stats[addr])
+	}
+	return result, nil
+}
+
+// epochAt returns the epoch which takes effect at the height.
+func (s *backend) epochAt(height uint64) *Epoch {
+	s.epochMu.RLock()
+	defer s.epochMu.RUnlock()
+
+	startHeight := s.maxEpochStartHeight
+	for {
+		epoch, ok := s.epochs[startHeight]
+		if !ok {
+			return nil
+		}
+		if height >= epoch.StartHeight || startHeight == 0 {
+			return epoch.Copy()
+		}
+		startHeight = epoch.LastEpochStartHeight
+	}
+}
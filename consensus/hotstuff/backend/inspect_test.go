@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	snr "github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+// testHeaderChain is a canonical chain of headers indexed by number.
+type testHeaderChain []*types.Header
+
+func (c testHeaderChain) Config() *params.ChainConfig  { return params.TestChainConfig }
+func (c testHeaderChain) CurrentHeader() *types.Header { return c[len(c)-1] }
+func (c testHeaderChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.GetHeaderByNumber(number); header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+func (c testHeaderChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number < uint64(len(c)) {
+		return c[number]
+	}
+	return nil
+}
+func (c testHeaderChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+// makeSignedHeader creates a header sealed by proposer and committed by the signers.
+func makeSignedHeader(t *testing.T, parent *types.Header, proposer *ecdsa.PrivateKey, signers []*ecdsa.PrivateKey) *types.Header {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Difficulty: defaultDifficulty,
+		MixDigest:  types.HotstuffDigest,
+	}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, nil))
+	assert.NoError(t, snr.NewSigner(proposer, &params.HotStuffConfig{}).SealBeforeCommit(header))
+
+	seals := make([][]byte, 0, len(signers))
+	for _, key := range signers {
+		seal, err := snr.NewSigner(key, &params.HotStuffConfig{}).SignHash(header.Hash())
+		assert.NoError(t, err)
+		seals = append(seals, seal)
+	}
+	assert.NoError(t, snr.NewSigner(proposer, &params.HotStuffConfig{}).SealAfterCommit(header, seals))
+	return header
+}
+
+// newInspectBackend creates a chain of 5 blocks, the validators of genesis epoch are all of the keys,
+// and the last validator leaves from the epoch starting at block 3. the last signer of every block is offline.
+func newInspectBackend(t *testing.T) (*backend, testHeaderChain, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, 4)
+	addrs := make([]common.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	genesis := &types.Header{Number: common.Big0, Difficulty: defaultDifficulty, MixDigest: types.HotstuffDigest}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(genesis, addrs))
+
+	chain := testHeaderChain{genesis}
+	for number := 1; number <= 4; number++ {
+		validators := keys
+		if number >= 3 {
+			validators = keys[:3]
+		}
+		proposer := validators[number%len(validators)]
+		chain = append(chain, makeSignedHeader(t, chain[number-1], proposer, validators[:len(validators)-1]))
+	}
+
+	s := &backend{
+		db:     rawdb.NewMemoryDatabase(),
+		config: &hotstuff.Config{HotStuffConfig: &params.HotStuffConfig{}},
+		signer: snr.NewSigner(keys[0], &params.HotStuffConfig{}),
+		epochs: map[uint64]*Epoch{
+			0: {StartHeight: 0, ValSet: newValSet(addrs)},
+			3: {StartHeight: 3, ValSet: newValSet(addrs[:3]), LastEpochStartHeight: 0},
+		},
+		maxEpochStartHeight: 3,
+	}
+	return s, chain, addrs
+}
+
+func TestBlockSigners(t *testing.T) {
+	s, chain, addrs := newInspectBackend(t)
+
+	// genesis block is not signed
+	signers, err := s.blockSigners(chain[0])
+	assert.NoError(t, err)
+	assert.Equal(t, chain[0].Hash(), signers.Hash)
+	assert.Equal(t, common.Address{}, signers.Proposer)
+	assert.Empty(t, signers.Committers)
+	assert.ElementsMatch(t, addrs, signers.Validators)
+
+	signers, err = s.blockSigners(chain[2])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), uint64(signers.Number))
+	assert.Equal(t, addrs[2], signers.Proposer)
+	assert.Equal(t, addrs[:3], signers.Committers)
+	assert.False(t, signers.Aggregated)
+	assert.Equal(t, uint64(0), uint64(signers.EpochStartHeight))
+	assert.ElementsMatch(t, addrs, signers.Validators)
+	assert.Nil(t, signers.Round, "block not committed locally")
+
+	// round recorded by local commit
+	assert.NoError(t, rawdb.WriteCommitRound(s.db, chain[2].Hash(), 3))
+	signers, err = s.blockSigners(chain[2])
+	assert.NoError(t, err)
+	if assert.NotNil(t, signers.Round) {
+		assert.Equal(t, uint64(3), uint64(*signers.Round))
+	}
+
+	// validators of the new epoch
+	signers, err = s.blockSigners(chain[3])
+	assert.NoError(t, err)
+	assert.Equal(t, addrs[0], signers.Proposer)
+	assert.Equal(t, addrs[:2], signers.Committers)
+	assert.Equal(t, uint64(3), uint64(signers.EpochStartHeight))
+	assert.ElementsMatch(t, addrs[:3], signers.Validators)
+
+	// header without hotstuff extra
+	_, err = s.blockSigners(&types.Header{Number: common.Big1})
+	assert.Error(t, err)
+}
+
+func TestParticipation(t *testing.T) {
+	s, chain, addrs := newInspectBackend(t)
+
+	stats, err := s.participation(chain, 0, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), uint64(stats.From))
+	assert.Equal(t, uint64(4), uint64(stats.To))
+
+	expect := map[common.Address]hotstuff.Participation{
+		addrs[0]: {Address: addrs[0], Blocks: 4, Proposed: 1, Signed: 4},
+		addrs[1]: {Address: addrs[1], Blocks: 4, Proposed: 2, Signed: 4},
+		addrs[2]: {Address: addrs[2], Blocks: 4, Proposed: 1, Signed: 2},
+		addrs[3]: {Address: addrs[3], Blocks: 2, Proposed: 0, Signed: 0},
+	}
+	assert.Len(t, stats.Validators, len(expect))
+	for _, v := range stats.Validators {
+		assert.Equal(t, expect[v.Address], *v)
+	}
+
+	// invalid and unknown range
+	_, err = s.participation(chain, 3, 2)
+	assert.Equal(t, errInvalidBlockRange, err)
+	_, err = s.participation(chain, 0, maxParticipationRange)
+	assert.Equal(t, errInvalidBlockRange, err)
+	_, err = s.participation(chain, 3, 5)
+	assert.Equal(t, errUnknownBlock, err)
+}
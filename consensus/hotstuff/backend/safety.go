@@ -0,0 +1,50 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// StoreSafetyRules implements hotstuff.Backend.StoreSafetyRules
+func (s *backend) StoreSafetyRules(rules *hotstuff.SafetyRules) error {
+	blob, err := rlp.EncodeToBytes(rules)
+	if err != nil {
+		return err
+	}
+	return rawdb.WriteSafetyRules(s.db, blob)
+}
+
+// LoadSafetyRules implements hotstuff.Backend.LoadSafetyRules
+func (s *backend) LoadSafetyRules() (*hotstuff.SafetyRules, error) {
+	blob, err := rawdb.ReadSafetyRules(s.db)
+	if err != nil {
+		return nil, err
+	}
+	if len(blob) == 0 {
+		return nil, nil
+	}
+	rules := new(hotstuff.SafetyRules)
+	if err := rlp.DecodeBytes(blob, rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package backend
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// inmemorySeals is the number of recent proposals whose committed seals of votes are collected
+const inmemorySeals = 32
+
+// collectedSeals are the committed seals of votes for one proposal, including the quorum sealed in the
+// proposal and the late ones.
+type collectedSeals struct {
+	committers []common.Address
+	seals      [][]byte
+}
+
+func (c *collectedSeals) add(committer common.Address, seal []byte) {
+	for _, addr := range c.committers {
+		if addr == committer {
+			return
+		}
+	}
+	c.committers = append(c.committers, committer)
+	c.seals = append(c.seals, seal)
+}
+
+// addSeals records the committed seals of proposal `hash`.
+func (s *backend) addSeals(hash common.Hash, committers []common.Address, seals [][]byte) {
+	s.sealsMu.Lock()
+	defer s.sealsMu.Unlock()
+
+	collected := &collectedSeals{}
+	if data, ok := s.seals.Get(hash); ok {
+		collected = data.(*collectedSeals)
+	}
+	for i := range committers {
+		collected.add(committers[i], seals[i])
+	}
+	s.seals.Add(hash, collected)
+}
+
+// CollectSeal implements hotstuff.Backend.CollectSeal, the seal is verified since the consensus core
+// only checks the seals within the quorum.
+func (s *backend) CollectSeal(number uint64, hash common.Hash, committer common.Address, seal []byte) {
+	if _, val := s.Validators(number).GetByAddress(committer); val == nil {
+		return
+	}
+	if s.config.HotStuffConfig.IsAggregateSeal(new(big.Int).SetUint64(number)) {
+		if err := s.signer.VerifyAggregatableHash(committer, hash, seal); err != nil {
+			s.logger.Trace("Drop invalid late seal", "committer", committer, "hash", hash, "err", err)
+			return
+		}
+	} else if signers, err := s.signer.GetSignersFromCommittedSeals(hash, [][]byte{seal}); err != nil || signers[0] != committer {
+		s.logger.Trace("Drop invalid late seal", "committer", committer, "hash", hash, "err", err)
+		return
+	}
+	s.addSeals(hash, []common.Address{committer}, [][]byte{seal})
+}
+
+// moreSeals returns the collected seals of proposal if there are more than `n` committers.
+func (s *backend) moreSeals(hash common.Hash, n int) ([]common.Address, [][]byte, bool) {
+	s.sealsMu.Lock()
+	defer s.sealsMu.Unlock()
+
+	data, ok := s.seals.Get(hash)
+	if !ok {
+		return nil, nil, false
+	}
+	collected := data.(*collectedSeals)
+	if len(collected.committers) <= n {
+		return nil, nil, false
+	}
+	committers := make([]common.Address, len(collected.committers))
+	copy(committers, collected.committers)
+	seals := make([][]byte, len(collected.seals))
+	copy(seals, collected.seals)
+	return committers, seals, true
+}
+
+// resealWithCollected seals the header again with all collected seals of it, if they are more than the
+// sealed ones. It returns nil if there is nothing to add.
+func (s *backend) resealWithCollected(header *types.Header) (*types.Header, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	sealed := len(extra.CommittedSeal)
+	for _, b := range extra.ParticipantBitmap {
+		for ; b > 0; b &= b - 1 {
+			sealed++
+		}
+	}
+	committers, seals, ok := s.moreSeals(header.Hash(), sealed)
+	if !ok {
+		return nil, nil
+	}
+	h := types.CopyHeader(header)
+	if err := s.sealHeader(h, committers, seals); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
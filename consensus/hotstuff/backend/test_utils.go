@@ -137,15 +137,17 @@ func makeBlockWithoutSeal(chain *core.BlockChain, engine *backend, parent *types
 func singleNodeChain() (*core.BlockChain, *backend) {
 	testLogger.SetHandler(elog.StdoutHandler)
 
-	genesis, nodeKeys, valset := getGenesisAndKeys(1)
+	genesis, nodeKeys, _ := getGenesisAndKeys(1)
 	memDB := rawdb.NewMemoryDatabase()
 	config := hotstuff.DefaultBasicConfig
+	// genesis commit stores the epoch of genesis validators which is loaded by the engine
+	genesis.MustCommit(memDB)
 	// Use the first key as private key
-	b, _ := New(config, nodeKeys[0], memDB).(*backend)
-	b.epochs = map[uint64]*Epoch{
-		0: {StartHeight: 0, ValSet: valset},
+	engine, err := New(config, nodeKeys[0], memDB)
+	if err != nil {
+		panic(err)
 	}
-	genesis.MustCommit(memDB)
+	b := engine.(*backend)
 
 	txLookUpLimit := uint64(100)
 	cacheConfig := &core.CacheConfig{
@@ -271,5 +273,5 @@ func makeValSet(validators []common.Address) hotstuff.ValidatorSet {
 
 func newTestSigner() hotstuff.Signer {
 	key, _ := generatePrivateKey()
-	return snr.NewSigner(key)
+	return snr.NewSigner(key, nil)
 }
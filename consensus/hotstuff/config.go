@@ -18,7 +18,12 @@
 
 package hotstuff
 
-import "github.com/ethereum/go-ethereum/params"
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+)
 
 type SelectProposerPolicy uint64
 
@@ -28,28 +33,90 @@ const (
 	VRF
 )
 
+var selectProposerPolicyNames = map[SelectProposerPolicy]string{
+	RoundRobin: "roundrobin",
+	Sticky:     "sticky",
+	VRF:        "vrf",
+}
+
+func (p SelectProposerPolicy) String() string {
+	if name, ok := selectProposerPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint64(p))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p SelectProposerPolicy) MarshalText() ([]byte, error) {
+	if _, ok := selectProposerPolicyNames[p]; !ok {
+		return nil, fmt.Errorf("unknown leader policy %d", uint64(p))
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *SelectProposerPolicy) UnmarshalText(text []byte) error {
+	for policy, name := range selectProposerPolicyNames {
+		if name == string(text) {
+			*p = policy
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown leader policy %q", text)
+}
+
+// Config is the configuration of hotstuff engine, only the fields with toml tag are node local
+// settings which may be overridden by the user, the others are decided by the protocol.
 type Config struct {
-	RequestTimeout uint64               `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
-	BlockPeriod    uint64               `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second for basic hotstuff and mill-seconds for event-driven
-	LeaderPolicy   SelectProposerPolicy `toml:",omitempty"` // The policy for speaker selection
-	Test           bool                 `toml:",omitempty"`
-	Epoch          uint64               `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
-	HotStuffConfig *params.HotStuffConfig
+	RequestTimeout  uint64                 `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
+	BlockPeriod     uint64                 `toml:"-"`          // Default minimum difference between two consecutive block's timestamps in second for basic hotstuff and mill-seconds for event-driven
+	LeaderPolicy    SelectProposerPolicy   `toml:",omitempty"` // The policy for speaker selection, vrf is switched on by the chain config fork
+	Test            bool                   `toml:"-"`
+	Epoch           uint64                 `toml:"-"`          // The number of blocks after which to checkpoint and reset the pending votes
+	Pacemaker       PacemakerPolicy        `toml:",omitempty"` // The strategy deciding round timeout
+	MaxRoundTimeout uint64                 `toml:",omitempty"` // The upper bound of round timeout in milliseconds, zero means no limit
+	BLSKeyFile      string                 `toml:",omitempty"` // The file of BLS secret key signing aggregatable committed seals, generated if missing
+	HotStuffConfig  *params.HotStuffConfig `toml:"-"`
+	ChainID         *big.Int               `toml:"-"` // The chain id signed in consensus messages
+}
+
+// Override replaces the node local settings of config with the non-zero ones of user. The zero values
+// of the policies are explicit choices as well, e.g: round robin, which are also the protocol defaults,
+// so that the policies of user are always taken.
+func (config *Config) Override(user *Config) {
+	if user == nil {
+		return
+	}
+	if user.RequestTimeout != 0 {
+		config.RequestTimeout = user.RequestTimeout
+	}
+	config.LeaderPolicy = user.LeaderPolicy
+	config.Pacemaker = user.Pacemaker
+	if user.MaxRoundTimeout != 0 {
+		config.MaxRoundTimeout = user.MaxRoundTimeout
+	}
+	if user.BLSKeyFile != "" {
+		config.BLSKeyFile = user.BLSKeyFile
+	}
 }
 
 // todo: modify request timeout, and miner recommit default value is 3s. recommit time should be > blockPeriod
 var DefaultBasicConfig = &Config{
-	RequestTimeout: 6000,
-	BlockPeriod:    3,
-	LeaderPolicy:   RoundRobin,
-	Epoch:          30000,
-	Test:           false,
+	RequestTimeout:  6000,
+	BlockPeriod:     3,
+	LeaderPolicy:    RoundRobin,
+	Epoch:           30000,
+	Test:            false,
+	Pacemaker:       ExponentialPacemaker,
+	MaxRoundTimeout: 0,
 }
 
 var DefaultEventDrivenConfig = &Config{
-	RequestTimeout: 4000,
-	BlockPeriod:    2000,
-	LeaderPolicy:   RoundRobin,
-	Epoch:          0,
-	Test:           false,
+	RequestTimeout:  4000,
+	BlockPeriod:     2000,
+	LeaderPolicy:    RoundRobin,
+	Epoch:           0,
+	Test:            false,
+	Pacemaker:       ExponentialPacemaker,
+	MaxRoundTimeout: 0,
 }
@@ -0,0 +1,78 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectProposerPolicyText(t *testing.T) {
+	for _, policy := range []SelectProposerPolicy{RoundRobin, Sticky, VRF} {
+		text, err := policy.MarshalText()
+		assert.NoError(t, err)
+
+		var got SelectProposerPolicy
+		assert.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, policy, got)
+	}
+	var policy SelectProposerPolicy
+	assert.Error(t, policy.UnmarshalText([]byte("random")))
+	_, err := SelectProposerPolicy(10).MarshalText()
+	assert.Error(t, err)
+}
+
+func TestPacemakerPolicyText(t *testing.T) {
+	for _, policy := range []PacemakerPolicy{ExponentialPacemaker, AdaptivePacemaker} {
+		text, err := policy.MarshalText()
+		assert.NoError(t, err)
+
+		var got PacemakerPolicy
+		assert.NoError(t, got.UnmarshalText(text))
+		assert.Equal(t, policy, got)
+	}
+	var policy PacemakerPolicy
+	assert.Error(t, policy.UnmarshalText([]byte("linear")))
+	_, err := PacemakerPolicy(10).MarshalText()
+	assert.Error(t, err)
+}
+
+func TestConfigOverride(t *testing.T) {
+	config := *DefaultBasicConfig
+	config.Override(&Config{LeaderPolicy: Sticky, BlockPeriod: 1})
+	assert.Equal(t, Sticky, config.LeaderPolicy)
+	assert.Equal(t, DefaultBasicConfig.RequestTimeout, config.RequestTimeout)
+	assert.Equal(t, DefaultBasicConfig.BlockPeriod, config.BlockPeriod, "protocol setting overridden")
+
+	config.Override(&Config{LeaderPolicy: Sticky, Pacemaker: AdaptivePacemaker, MaxRoundTimeout: 30000})
+	assert.Equal(t, AdaptivePacemaker, config.Pacemaker)
+	assert.Equal(t, uint64(30000), config.MaxRoundTimeout)
+	assert.Equal(t, Sticky, config.LeaderPolicy)
+
+	config.Override(nil)
+	assert.Equal(t, Sticky, config.LeaderPolicy)
+	assert.Equal(t, AdaptivePacemaker, config.Pacemaker)
+
+	// explicit round robin and exponential pacemaker override the others
+	config.Override(&Config{LeaderPolicy: RoundRobin, Pacemaker: ExponentialPacemaker})
+	assert.Equal(t, RoundRobin, config.LeaderPolicy)
+	assert.Equal(t, ExponentialPacemaker, config.Pacemaker)
+	assert.Equal(t, uint64(30000), config.MaxRoundTimeout)
+}
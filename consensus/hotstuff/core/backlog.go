@@ -79,7 +79,7 @@ func (c *core) processBacklog() {
 			}
 
 			logger.Trace("Replay the backlog", "msg", msg)
-			go c.sendEvent(backlogEvent{src: src, msg: msg})
+			c.postEvent(backlogEvent{src: src, msg: msg})
 		}
 	}
 }
@@ -136,6 +136,18 @@ var messagePriorityTable = map[hotstuff.MsgType]int64{
 	MsgTypeDecide:        8,
 }
 
+// Sizes returns the number of backlog messages of every validator
+func (b *backlog) Sizes() map[common.Address]int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sizes := make(map[common.Address]int)
+	for addr, queue := range b.queue {
+		sizes[addr] = queue.Size()
+	}
+	return sizes
+}
+
 func (b *backlog) toPriority(msgCode hotstuff.MsgType, view *hotstuff.View) int64 {
 	priority := -(view.Height.Int64()*100 + view.Round.Int64()*10 + int64(messagePriorityTable[msgCode]))
 	return priority
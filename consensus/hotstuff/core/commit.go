@@ -136,6 +136,10 @@ func (c *core) sendCommitVote() {
 		logger.Error("Failed to send vote", "msg", msgTyp, "err", "current vote is nil")
 		return
 	}
+	if err := c.persistVote(msgTyp, vote); err != nil {
+		logger.Warn("Failed to persist vote", "msg", msgTyp, "err", err)
+		return
+	}
 	payload, err := Encode(vote)
 	if err != nil {
 		logger.Error("Failed to encode", "msg", msgTyp, "err", err)
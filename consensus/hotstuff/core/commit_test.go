@@ -19,6 +19,7 @@
 package core
 
 import (
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -138,6 +139,7 @@ func TestHandlePreCommitVote(t *testing.T) {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			proposal := makeBlock(int64(H))
 			votes := make(map[hotstuff.Validator]*hotstuff.Message)
+			var expect error
 			for _, v := range sys.backends {
 				core := v.core()
 				core.current.SetProposal(proposal)
@@ -145,6 +147,7 @@ func TestHandlePreCommitVote(t *testing.T) {
 
 				vote := newVote(core, proposal.Hash())
 				vote.Digest = common.HexToHash("0x1234")
+				expect = inconsistentVoteErr(core, vote)
 				msg := newVoteMsg(vote)
 				msg.Address = core.Address()
 				val := validator.New(msg.Address)
@@ -154,7 +157,7 @@ func TestHandlePreCommitVote(t *testing.T) {
 			return &testcase{
 				Sys:       sys,
 				Votes:     votes,
-				ExpectErr: errInconsistentVote,
+				ExpectErr: expect,
 			}
 		}(),
 	}
@@ -295,18 +298,19 @@ func TestHandleCommit(t *testing.T) {
 			}
 		}(),
 
-		// errInconsistentPrepareQC
+		// inconsistent prepareQC
 		func() *testcase {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			var (
 				proposal hotstuff.Proposal
 				qc       *hotstuff.QuorumCert
+				local    = common.HexToHash("0x124")
 			)
 			for _, backend := range sys.backends {
 				core := backend.core()
 				proposal, qc = newPreCommitMsg(core)
 				core.current.SetProposal(proposal)
-				core.current.SetPrepareQC(&hotstuff.QuorumCert{Hash: common.HexToHash("0x124")})
+				core.current.SetPrepareQC(&hotstuff.QuorumCert{View: qc.View, Proposer: qc.Proposer, Hash: local})
 			}
 			msg := newP2PMsg(qc)
 			val := validator.New(sys.getLeader().Address())
@@ -314,11 +318,11 @@ func TestHandleCommit(t *testing.T) {
 				Sys:       sys,
 				Msg:       msg,
 				Leader:    val,
-				ExpectErr: nil,
+				ExpectErr: fmt.Errorf("expect %v, got %v", local, qc.Hash),
 			}
 		}(),
 
-		// errState
+		// duplicate commit is ignored once the qc locked
 		func() *testcase {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			var (
@@ -330,7 +334,7 @@ func TestHandleCommit(t *testing.T) {
 				proposal, qc = newPreCommitMsg(core)
 				core.current.SetProposal(proposal)
 				core.current.SetPrepareQC(qc)
-				core.current.SetState(StatePreCommitted)
+				core.current.SetState(StateCommitted)
 			}
 			msg := newP2PMsg(qc)
 			val := validator.New(sys.getLeader().Address())
@@ -338,7 +342,7 @@ func TestHandleCommit(t *testing.T) {
 				Sys:       sys,
 				Msg:       msg,
 				Leader:    val,
-				ExpectErr: errState,
+				ExpectErr: nil,
 			}
 		}(),
 	}
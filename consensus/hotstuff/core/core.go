@@ -19,14 +19,16 @@
 package core
 
 import (
-	"math"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru"
 )
 
 type core struct {
@@ -39,12 +41,24 @@ type core struct {
 	valSet   hotstuff.ValidatorSet
 	requests *requestSet
 	backlogs *backlog
+	safety   *hotstuff.SafetyRules // persisted last vote and quorum certs
+
+	signedMsgs *lru.ARCCache // recent signed messages used to detect equivocation
 
 	events            *event.TypeMuxSubscription
 	timeoutSub        *event.TypeMuxSubscription
 	finalCommittedSub *event.TypeMuxSubscription
 
-	roundChangeTimer *time.Timer
+	clock            mclock.Clock        // source of time for round timer, replaced by simulated clock in tests
+	postEvent        func(interface{})   // deliver internal events to event loop without blocking the caller
+	sleep            func(time.Duration) // wait for chain reader catching up, replaced in tests running on simulated clock
+	pacemaker        hotstuff.Pacemaker
+	timerMu          sync.Mutex // protects round change timer, which is stopped out of the event loop
+	roundChangeTimer mclock.Timer
+	roundStart       mclock.AbsTime // the time entering current round, used to measure round latency
+
+	statusCh  chan chan *hotstuff.CoreStatus // snapshot requests served by the event loop
+	roundFeed hotstuff.RoundChangeFeed
 
 	validateFn func([]byte, []byte) (common.Address, error)
 	isRunning  bool
@@ -57,8 +71,16 @@ func New(backend hotstuff.Backend, config *hotstuff.Config, signer hotstuff.Sign
 		logger:  log.New("address", backend.Address()),
 		backend: backend,
 	}
+	c.signedMsgs = newSignedMessages()
+	c.statusCh = make(chan chan *hotstuff.CoreStatus)
 	c.validateFn = c.checkValidatorSignature
 	c.signer = signer
+	c.pacemaker = hotstuff.NewPacemaker(config)
+	c.clock = mclock.System{}
+	c.postEvent = func(ev interface{}) {
+		go c.sendEvent(ev)
+	}
+	c.sleep = time.Sleep
 
 	return c
 }
@@ -114,7 +136,7 @@ catchup:
 				logger.Warn("Sync last proposal failed", "height", c.current.Height())
 				return
 			} else {
-				time.Sleep(retryPeriod)
+				c.sleep(retryPeriod)
 				goto catchup
 			}
 		} else if round.Cmp(c.current.Round()) < 0 {
@@ -127,6 +149,11 @@ catchup:
 		return
 	}
 
+	// only rounds finished by this node itself reflect the latency of network
+	if !changeView && c.current != nil && c.currentState() == StateCommitted && c.roundStart > 0 {
+		c.pacemaker.Observe(c.clock.Now().Sub(c.roundStart))
+	}
+
 	newView := &hotstuff.View{
 		Height: new(big.Int).Add(lastProposal.Number(), common.Big1),
 		Round:  common.Big0,
@@ -148,6 +175,9 @@ catchup:
 
 	// calculate new proposal and init round state
 	c.valSet = c.backend.Validators(newView.Height.Uint64())
+	if c.valSet.Policy() == hotstuff.VRF {
+		c.valSet.SetRandomSeed(vrfSeed(lastProposal))
+	}
 	c.valSet.CalcProposer(lastProposer, newView.Round.Uint64())
 	prepareQC := proposal2QC(lastProposal, common.Big0)
 	c.current = newRoundState(newView, c.valSet, prepareQC)
@@ -161,6 +191,8 @@ catchup:
 
 	logger.Debug("New round", "state", c.currentState(), "newView", newView, "new_proposer", c.valSet.GetProposer(), "valSet", c.valSet.List(), "size", c.valSet.Size(), "IsProposer", c.IsProposer())
 
+	c.roundFeed.Send(hotstuff.RoundChangeEvent{View: c.currentView(), Proposer: c.valSet.GetProposer().Address()})
+
 	// process pending request
 	c.setCurrentState(StateAcceptRequest)
 	c.sendNewView(newView)
@@ -194,21 +226,26 @@ func (c *core) Q() int {
 }
 
 func (c *core) stopTimer() {
+	c.timerMu.Lock()
+	defer c.timerMu.Unlock()
+
 	if c.roundChangeTimer != nil {
 		c.roundChangeTimer.Stop()
 	}
 }
 
 func (c *core) newRoundChangeTimer() {
-	c.stopTimer()
+	c.timerMu.Lock()
+	defer c.timerMu.Unlock()
 
-	// set timeout based on the round number
-	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
-	round := c.current.Round().Uint64()
-	if round > 0 {
-		timeout += time.Duration(math.Pow(2, float64(round))) * time.Second
+	if c.roundChangeTimer != nil {
+		c.roundChangeTimer.Stop()
 	}
-	c.roundChangeTimer = time.AfterFunc(timeout, func() {
-		c.sendEvent(timeoutEvent{})
+
+	// set timeout based on the round number
+	timeout := c.pacemaker.Timeout(c.current.Round().Uint64())
+	c.roundStart = c.clock.Now()
+	c.roundChangeTimer = c.clock.AfterFunc(timeout, func() {
+		c.postEvent(timeoutEvent{})
 	})
 }
@@ -19,6 +19,7 @@
 package core
 
 import (
+	"fmt"
 	"math/big"
 	"reflect"
 	"testing"
@@ -66,6 +67,11 @@ func makeView(h, r uint64) *hotstuff.View {
 	}
 }
 
+// inconsistentVoteErr returns the error checkVote reports for a vote unlike the local one.
+func inconsistentVoteErr(c *core, got *Vote) error {
+	return fmt.Errorf("expect %s, got %s", c.current.Vote().String(), got.String())
+}
+
 func TestNewRequest(t *testing.T) {
 	testLogger.SetHandler(elog.StdoutHandler)
 
@@ -74,30 +80,44 @@ func TestNewRequest(t *testing.T) {
 	H := uint64(1)
 	R := uint64(0)
 
+	needBroadCast = true
+	defer func() { needBroadCast = false }()
 	sys := NewTestSystemWithBackend(N, F, H, R)
 
 	close := sys.Run(true)
 	defer close()
 
-	request1 := makeBlock(1)
-	sys.backends[0].NewRequest(request1)
-
-	<-time.After(1 * time.Second)
-
-	request2 := makeBlock(2)
-	sys.backends[0].NewRequest(request2)
+	// the request of next height is stored as future request until the first one committed
+	request1 := makeBlockWithParentHash(1, makeBlock(0).Hash())
+	request2 := makeBlockWithParentHash(2, request1.Hash())
+	for _, backend := range sys.backends {
+		backend.NewRequest(request1)
+		backend.NewRequest(request2)
+	}
 
-	<-time.After(1 * time.Second)
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); {
+		done := true
+		for _, backend := range sys.backends {
+			if len(backend.committed()) < 2 {
+				done = false
+			}
+		}
+		if done {
+			break
+		}
+		<-time.After(100 * time.Millisecond)
+	}
 
 	for _, backend := range sys.backends {
-		if len(backend.committedMsgs) != 2 {
-			t.Errorf("the number of executed requests mismatch: have %v, want 2", len(backend.committedMsgs))
+		committedMsgs := backend.committed()
+		if len(committedMsgs) != 2 {
+			t.Fatalf("the number of executed requests mismatch: have %v, want 2", len(committedMsgs))
 		}
-		if !reflect.DeepEqual(request1.Number(), backend.committedMsgs[0].commitProposal.Number()) {
-			t.Errorf("the number of requests mismatch: have %v, want %v", request1.Number(), backend.committedMsgs[0].commitProposal.Number())
+		if !reflect.DeepEqual(request1.Number(), committedMsgs[0].commitProposal.Number()) {
+			t.Errorf("the number of requests mismatch: have %v, want %v", request1.Number(), committedMsgs[0].commitProposal.Number())
 		}
-		if !reflect.DeepEqual(request2.Number(), backend.committedMsgs[1].commitProposal.Number()) {
-			t.Errorf("the number of requests mismatch: have %v, want %v", request2.Number(), backend.committedMsgs[1].commitProposal.Number())
+		if !reflect.DeepEqual(request2.Number(), committedMsgs[1].commitProposal.Number()) {
+			t.Errorf("the number of requests mismatch: have %v, want %v", request2.Number(), committedMsgs[1].commitProposal.Number())
 		}
 	}
 }
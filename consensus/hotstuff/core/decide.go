@@ -63,7 +63,7 @@ func (c *core) handleCommitVote(data *hotstuff.Message, src hotstuff.Validator)
 		c.current.SetState(StateCommitted)
 		c.current.SetCommittedQC(c.current.PreCommittedQC())
 		logger.Trace("acceptCommit", "msg", msgTyp, "src", src.Address(), "hash", vote.Digest, "msgSize", size)
-		if err := c.backend.Commit(c.current.Proposal()); err != nil {
+		if err := c.backend.Commit(c.current.Proposal(), c.current.Round()); err != nil {
 			logger.Trace("Failed to commit proposal", "err", err)
 			return err
 		}
@@ -138,6 +138,7 @@ func TestHandleCommitVote(t *testing.T) {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			proposal := makeBlock(int64(H))
 			votes := make(map[hotstuff.Validator]*hotstuff.Message)
+			var expect error
 			for _, v := range sys.backends {
 				core := v.core()
 				core.current.SetProposal(proposal)
@@ -145,6 +146,7 @@ func TestHandleCommitVote(t *testing.T) {
 
 				vote := newVote(core, proposal.Hash())
 				vote.Digest = common.HexToHash("0x1234")
+				expect = inconsistentVoteErr(core, vote)
 				msg := newVoteMsg(vote)
 				msg.Address = core.Address()
 				val := validator.New(msg.Address)
@@ -154,7 +156,7 @@ func TestHandleCommitVote(t *testing.T) {
 			return &testcase{
 				Sys:       sys,
 				Votes:     votes,
-				ExpectErr: errInconsistentVote,
+				ExpectErr: expect,
 			}
 		}(),
 
@@ -62,5 +62,6 @@ var (
 	errAddNewViews            = errors.New("add new view error")
 	errAddPrepareVote         = errors.New("add prepare vote error")
 	errAddPreCommitVote       = errors.New("add pre commit vote error")
+	errInvalidCommittedSeal   = errors.New("invalid committed seal")
 	errBadEpochValidators     = errors.New("last epoch validator set is empty")
 )
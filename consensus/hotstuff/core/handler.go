@@ -31,6 +31,19 @@ var once sync.Once
 
 // Start implements core.Engine.Start
 func (c *core) Start(chain consensus.ChainReader) error {
+	if err := c.start(); err != nil {
+		return err
+	}
+
+	// Tests will handle events itself, so we have to make subscribeEvents()
+	// be able to call in test.
+	c.subscribeEvents()
+	go c.handleEvents()
+	return nil
+}
+
+// start resets the core and enters the first round, events should be handled by the caller.
+func (c *core) start() error {
 	once.Do(func() {
 		hotstuff.RegisterMsgTypeConvertHandler(func(data interface{}) hotstuff.MsgType {
 			code := data.(uint64)
@@ -45,11 +58,10 @@ func (c *core) Start(chain consensus.ChainReader) error {
 
 	// Start a new round from last sequence + 1
 	c.startNewRound(common.Big0)
-
-	// Tests will handle events itself, so we have to make subscribeEvents()
-	// be able to call in test.
-	c.subscribeEvents()
-	go c.handleEvents()
+	if err := c.restoreSafetyRules(); err != nil {
+		c.isRunning = false
+		return err
+	}
 	return nil
 }
 
@@ -126,6 +138,9 @@ func (c *core) handleEvents() {
 			case hotstuff.FinalCommittedEvent:
 				c.handleFinalCommitted(ev.Header)
 			}
+
+		case ch := <-c.statusCh:
+			ch <- c.status()
 		}
 	}
 }
@@ -151,6 +166,7 @@ func (c *core) handleMsg(payload []byte) error {
 		logger.Error("Invalid address in Message", "msg", msg)
 		return errInvalidSigner
 	}
+	c.checkEquivocation(msg)
 
 	// handle checked Message
 	if err := c.handleCheckedMsg(msg, src); err != nil {
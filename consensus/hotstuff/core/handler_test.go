@@ -42,7 +42,7 @@ func TestHandleMsg(t *testing.T) {
 
 	v0 := sys.backends[0]
 	r0 := v0.core()
-	_, val := v0.Validators().GetByAddress(v0.Address())
+	_, val := v0.Validators(0).GetByAddress(v0.Address())
 
 	// decode new view
 	{
@@ -81,16 +81,17 @@ func TestHandleMsg(t *testing.T) {
 	// decode prepareVote failed
 	{
 		block := makeBlock(int64(H))
-		payload, _ := Encode(&Vote{
+		vote := &Vote{
 			View:   makeView(H, R),
 			Digest: block.Hash(),
-		})
+		}
+		payload, _ := Encode(vote)
 		// with a matched payload. msg prepare vote should match with *hotstuff.MsgPrepareVote in normal case.
 		msg := &hotstuff.Message{
 			Code:    MsgTypePrepareVote,
 			Msg:     payload,
 			Address: v0.Address(),
 		}
-		assert.Equal(t, errInconsistentVote, r0.handleCheckedMsg(msg, val))
+		assert.Equal(t, inconsistentVoteErr(r0, vote), r0.handleCheckedMsg(msg, val))
 	}
 }
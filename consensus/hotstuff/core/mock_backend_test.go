@@ -21,6 +21,7 @@ package core
 import (
 	"crypto/ecdsa"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -48,6 +49,8 @@ type mockBackend struct {
 	peers  hotstuff.ValidatorSet
 	events *event.TypeMux
 
+	head            hotstuff.Proposal // local chain head before any block committed in test
+	committedMu     sync.RWMutex      // protects the committed blocks which are synced by other validators
 	committedMsgs   []testCommittedMsgs
 	committedMsgMap map[common.Hash]testCommittedMsgs
 	sentMsgs        [][]byte // store the messages when Send is called by core
@@ -80,7 +83,7 @@ func (m *mockBackend) Address() common.Address {
 }
 
 // Peers returns all connected peers
-func (m *mockBackend) Validators() hotstuff.ValidatorSet {
+func (m *mockBackend) Validators(height uint64) hotstuff.ValidatorSet {
 	return m.peers
 }
 
@@ -117,7 +120,7 @@ func (m *mockBackend) Unicast(valSet hotstuff.ValidatorSet, payload []byte) erro
 	return nil
 }
 
-func (m *mockBackend) PreCommit(proposal hotstuff.Proposal, seals [][]byte) (hotstuff.Proposal, error) {
+func (m *mockBackend) PreCommit(proposal hotstuff.Proposal, committers []common.Address, seals [][]byte) (hotstuff.Proposal, error) {
 	//qc := &hotstuff.QuorumCert{
 	//	View: view,
 	//	Hash: proposal.Hash(),
@@ -127,19 +130,55 @@ func (m *mockBackend) PreCommit(proposal hotstuff.Proposal, seals [][]byte) (hot
 	return proposal, nil
 }
 
-func (m *mockBackend) Commit(proposal hotstuff.Proposal) error {
+func (m *mockBackend) CollectSeal(number uint64, hash common.Hash, committer common.Address, seal []byte) {
+}
+
+func (m *mockBackend) ForwardCommit(proposal hotstuff.Proposal, extra []byte) (hotstuff.Proposal, error) {
+	return proposal, nil
+}
+
+func (m *mockBackend) Certify(proposal hotstuff.Proposal) error {
+	return nil
+}
+
+func (m *mockBackend) Commit(proposal hotstuff.Proposal, round *big.Int) error {
 	testLogger.Info("commit Message", "address", m.Address())
-	msg := testCommittedMsgs{
-		commitProposal: proposal,
+	m.insert(proposal)
+
+	// fake block sync and new head events of the other validators
+	for _, peer := range m.sys.backends {
+		if peer != m && peer.insert(proposal) {
+			go peer.events.Post(hotstuff.FinalCommittedEvent{Header: proposal.(*types.Block).Header()})
+		}
 	}
-	m.committedMsgs = append(m.committedMsgs, msg)
+	return nil
+}
+
+// insert appends the proposal to local chain, it returns false if the proposal already exist.
+func (m *mockBackend) insert(proposal hotstuff.Proposal) bool {
+	m.committedMu.Lock()
+	defer m.committedMu.Unlock()
+
 	if m.committedMsgMap == nil {
 		m.committedMsgMap = make(map[common.Hash]testCommittedMsgs)
 	}
+	if _, ok := m.committedMsgMap[proposal.Hash()]; ok {
+		return false
+	}
+	msg := testCommittedMsgs{
+		commitProposal: proposal,
+	}
+	m.committedMsgs = append(m.committedMsgs, msg)
 	m.committedMsgMap[proposal.Hash()] = msg
-	// fake new head events
-	// go self.events.Post(istanbul.FinalCommittedEvent{})
-	return nil
+	return true
+}
+
+// committed returns a copy of the committed blocks.
+func (m *mockBackend) committed() []testCommittedMsgs {
+	m.committedMu.RLock()
+	defer m.committedMu.RUnlock()
+
+	return append([]testCommittedMsgs{}, m.committedMsgs...)
 }
 
 func (m *mockBackend) Verify(proposal hotstuff.Proposal) (time.Duration, error) {
@@ -150,14 +189,31 @@ func (m *mockBackend) VerifyUnsealedProposal(proposal hotstuff.Proposal) (time.D
 	return 0, nil
 }
 
+func (m *mockBackend) ValidateBlock(block *types.Block) error {
+	return nil
+}
+
+func (m *mockBackend) StoreSafetyRules(rules *hotstuff.SafetyRules) error {
+	return nil
+}
+
+func (m *mockBackend) LoadSafetyRules() (*hotstuff.SafetyRules, error) {
+	return nil, nil
+}
+
+func (m *mockBackend) ReportEvidence(evidence *hotstuff.Evidence) {}
+
 func (m *mockBackend) HasBadProposal(hash common.Hash) bool {
 	return false
 }
 
 func (m *mockBackend) LastProposal() (hotstuff.Proposal, common.Address) {
+	m.committedMu.RLock()
+	defer m.committedMu.RUnlock()
+
 	l := len(m.committedMsgs)
 	if l == 0 {
-		return nil, EmptyAddress
+		return m.head, EmptyAddress
 	} else {
 		proposal := m.committedMsgs[l-1].commitProposal
 		block := proposal.(*types.Block)
@@ -166,6 +222,9 @@ func (m *mockBackend) LastProposal() (hotstuff.Proposal, common.Address) {
 }
 
 func (m *mockBackend) GetProposal(hash common.Hash) hotstuff.Proposal {
+	m.committedMu.RLock()
+	defer m.committedMu.RUnlock()
+
 	msg, ok := m.committedMsgMap[hash]
 	if ok {
 		return msg.commitProposal
@@ -232,6 +291,30 @@ func (m *mockSinger) SealAfterCommit(h *types.Header, committedSeals [][]byte) e
 	return nil
 }
 
+func (m *mockSinger) BLSPublicKey() []byte {
+	return nil
+}
+
+func (m *mockSinger) BLSProof() []byte {
+	return nil
+}
+
+func (m *mockSinger) AddBLSPublicKey(addr common.Address, pubKey, proof []byte) error {
+	return nil
+}
+
+func (m *mockSinger) SignAggregatableHash(hash common.Hash) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *mockSinger) VerifyAggregatableHash(signer common.Address, hash common.Hash, sig []byte) error {
+	return nil
+}
+
+func (m *mockSinger) SealAggregatedAfterCommit(h *types.Header, valSet hotstuff.ValidatorSet, committers []common.Address, committedSeals [][]byte) error {
+	return nil
+}
+
 func (m *mockSinger) VerifyHeader(header *types.Header, valSet hotstuff.ValidatorSet, seal bool) error {
 	return nil
 }
@@ -260,6 +343,22 @@ func (m *mockSinger) VerifyCommittedSeal(valSet hotstuff.ValidatorSet, hash comm
 	return nil
 }
 
+func (m *mockSinger) GetSignersFromCommittedSeals(hash common.Hash, seals [][]byte) ([]common.Address, error) {
+	return nil, nil
+}
+
+func (m *mockSinger) GetSignersFromAggregatedSeal(valSet hotstuff.ValidatorSet, hash common.Hash, seal []byte, bitmap []byte) ([]common.Address, error) {
+	return nil, nil
+}
+
+func (m *mockSinger) SealVRF(header *types.Header, parent *types.Header) error {
+	return nil
+}
+
+func (m *mockSinger) VerifyVRF(header *types.Header, parent *types.Header) error {
+	return nil
+}
+
 // ==============================================
 //
 // define the struct that need to be provided for integration tests.
@@ -347,16 +446,19 @@ func NewTestSystemWithBackend(n, f, h, r uint64) *testSystem {
 		backend := sys.NewBackend(i)
 		backend.peers = vset
 		backend.address = vset.GetByIndex(i).Address()
+		backend.head = makeBlock(int64(h) - 1)
 
 		signer := &mockSinger{address: backend.address}
 		backend.signer = signer
 
-		core := New(backend, config, signer, vset).(*core)
+		core := New(backend, config, signer).(*core)
 		core.current = newRoundState(&hotstuff.View{
 			Height: new(big.Int).SetUint64(h),
 			Round:  new(big.Int).SetUint64(r),
 		}, vset, nil)
 		core.valSet = vset
+		core.requests = newRequestSet()
+		core.backlogs = newBackLog()
 		core.logger = testLogger
 		core.backend = backend
 		core.signer = signer
@@ -395,7 +497,7 @@ func (t *testSystem) listen() {
 func (t *testSystem) Run(core bool) func() {
 	for _, b := range t.backends {
 		if core {
-			b.engine.Start() // start hotstuff core
+			b.engine.Start(nil) // start hotstuff core
 		}
 	}
 
@@ -423,6 +525,7 @@ func (t *testSystem) NewBackend(id uint64) *mockBackend {
 		events: new(event.TypeMux),
 		db:     ethDB,
 		signer: nil,
+		head:   makeBlock(0),
 	}
 
 	t.backends[id] = backend
@@ -26,7 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 )
 
-// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/basic/core -run TestNewRound
+// go test -v github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestNewRound
 func TestNewRound(t *testing.T) {
 	N := uint64(1)
 	F := uint64(1)
@@ -59,8 +59,8 @@ func TestNewRound(t *testing.T) {
 	<-time.After(2 * time.Second)
 
 	for _, v := range sys.backends {
-		if len(v.committedMsgs) > 1 {
-			block := v.committedMsgs[1].commitProposal
+		if committedMsgs := v.committed(); len(committedMsgs) > 1 {
+			block := committedMsgs[1].commitProposal
 			t.Logf("proposer %s committed block %d hash %s", v.address.Hex(), block.Number().Uint64(), block.Hash().Hex())
 		}
 	}
@@ -113,7 +113,9 @@ func TestHandleNewView(t *testing.T) {
 		msgList[index] = msg
 	}
 
+	// leader proposes the request after new views reached quorum
 	leader := sys.getLeader()
+	leader.requests.StoreRequest(&hotstuff.Request{Proposal: makeBlockWithParentHash(int64(H), makeBlock(int64(H-1)).Hash())})
 	for _, msg := range msgList {
 		val := validator.New(msg.Address)
 		assert.NoError(t, leader.handleNewView(msg, val))
@@ -45,6 +45,12 @@ func (c *core) handlePrepareVote(data *hotstuff.Message, src hotstuff.Validator)
 		logger.Trace("Failed to check proposer", "msg", msgTyp, "err", err)
 		return err
 	}
+	if c.isAggregateSeal(c.current.Proposal()) {
+		if err := c.signer.VerifyAggregatableHash(src.Address(), vote.Digest, data.CommittedSeal); err != nil {
+			logger.Trace("Failed to verify committed seal", "msg", msgTyp, "err", err)
+			return errInvalidCommittedSeal
+		}
+	}
 	if err := c.current.AddPrepareVote(data); err != nil {
 		logger.Trace("Failed to add vote", "msg", msgTyp, "err", err)
 		return errAddPrepareVote
@@ -53,8 +59,8 @@ func (c *core) handlePrepareVote(data *hotstuff.Message, src hotstuff.Validator)
 	logger.Trace("handlePrepareVote", "msg", msgTyp, "src", src.Address(), "hash", vote.Digest)
 
 	if size := c.current.PrepareVoteSize(); size >= c.Q() && c.currentState() < StatePrepared {
-		seals := c.getMessageSeals(size)
-		newProposal, err := c.backend.PreCommit(c.current.Proposal(), seals)
+		committers, seals := c.getMessageSeals(size)
+		newProposal, err := c.backend.PreCommit(c.current.Proposal(), committers, seals)
 		if err != nil {
 			logger.Trace("Failed to assemble committed seal", "err", err)
 			return err
@@ -65,6 +71,9 @@ func (c *core) handlePrepareVote(data *hotstuff.Message, src hotstuff.Validator)
 		logger.Trace("acceptPrepare", "msg", msgTyp, "src", src.Address(), "hash", newProposal.Hash(), "msgSize", size)
 
 		c.sendPreCommit()
+	} else if c.currentState() >= StatePrepared {
+		// the proposal has been sealed with a quorum, keep the seal of late vote
+		c.backend.CollectSeal(vote.View.Height.Uint64(), vote.Digest, src.Address(), data.CommittedSeal)
 	}
 
 	return nil
@@ -150,6 +159,10 @@ func (c *core) sendPreCommitVote() {
 		logger.Trace("Failed to send vote", "msg", msgTyp, "err", "current vote is nil")
 		return
 	}
+	if err := c.persistVote(msgTyp, vote); err != nil {
+		logger.Warn("Failed to persist vote", "msg", msgTyp, "err", err)
+		return
+	}
 	payload, err := Encode(vote)
 	if err != nil {
 		logger.Error("Failed to encode", "msg", msgTyp, "err", err)
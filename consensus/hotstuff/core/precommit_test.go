@@ -135,12 +135,14 @@ func TestHandlePrepareVote(t *testing.T) {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			proposal := makeBlock(int64(H))
 			votes := make(map[hotstuff.Validator]*hotstuff.Message)
+			var expect error
 			for _, v := range sys.backends {
 				core := v.core()
 				core.current.SetProposal(proposal)
 
 				vote := newVote(core, proposal.Hash())
 				vote.Digest = common.HexToHash("0x1234")
+				expect = inconsistentVoteErr(core, vote)
 				msg := newVoteMsg(vote)
 				msg.Address = core.Address()
 				val := validator.New(msg.Address)
@@ -150,7 +152,7 @@ func TestHandlePrepareVote(t *testing.T) {
 			return &testcase{
 				Sys:       sys,
 				Votes:     votes,
-				ExpectErr: errInconsistentVote,
+				ExpectErr: expect,
 			}
 		}(),
 	}
@@ -179,8 +181,12 @@ func TestHandlePreCommit(t *testing.T) {
 		r := coreView.Round.Uint64()
 		return newProposalAndQC(c, h, r)
 	}
-	newP2PMsg := func(msg *hotstuff.QuorumCert) *hotstuff.Message {
-		payload, _ := Encode(msg)
+	newP2PMsg := func(proposal hotstuff.Proposal, qc *hotstuff.QuorumCert) *hotstuff.Message {
+		payload, _ := Encode(&MsgPreCommit{
+			View:      qc.View,
+			Proposal:  proposal,
+			PrepareQC: qc,
+		})
 		return &hotstuff.Message{
 			Code: MsgTypePreCommit,
 			Msg:  payload,
@@ -208,7 +214,7 @@ func TestHandlePreCommit(t *testing.T) {
 				proposal, qc = newPreCommitMsg(core)
 				core.current.SetProposal(proposal)
 			}
-			msg := newP2PMsg(qc)
+			msg := newP2PMsg(proposal, qc)
 			return &testcase{
 				Sys:       sys,
 				Msg:       msg,
@@ -232,7 +238,7 @@ func TestHandlePreCommit(t *testing.T) {
 				core.current.SetProposal(proposal)
 			}
 			qc.View.Height = new(big.Int).SetUint64(H - 1)
-			msg := newP2PMsg(qc)
+			msg := newP2PMsg(proposal, qc)
 			return &testcase{
 				Sys:       sys,
 				Msg:       msg,
@@ -256,7 +262,7 @@ func TestHandlePreCommit(t *testing.T) {
 				core.current.SetProposal(proposal)
 			}
 			qc.View.Round = new(big.Int).SetUint64(R + 1)
-			msg := newP2PMsg(qc)
+			msg := newP2PMsg(proposal, qc)
 			return &testcase{
 				Sys:       sys,
 				Msg:       msg,
@@ -277,7 +283,7 @@ func TestHandlePreCommit(t *testing.T) {
 				proposal, qc = newPreCommitMsg(core)
 				core.current.SetProposal(proposal)
 			}
-			msg := newP2PMsg(qc)
+			msg := newP2PMsg(proposal, qc)
 			val := validator.New(sys.getRepos()[0].Address())
 			return &testcase{
 				Sys:       sys,
@@ -287,7 +293,7 @@ func TestHandlePreCommit(t *testing.T) {
 			}
 		}(),
 
-		// errState
+		// duplicate preCommit is ignored once the qc prepared
 		func() *testcase {
 			sys := NewTestSystemWithBackend(N, F, H, R)
 			var (
@@ -298,15 +304,15 @@ func TestHandlePreCommit(t *testing.T) {
 				core := backend.core()
 				proposal, qc = newPreCommitMsg(core)
 				core.current.SetProposal(proposal)
-				core.current.SetState(StatePrepared)
+				core.current.SetState(StatePreCommitted)
 			}
-			msg := newP2PMsg(qc)
+			msg := newP2PMsg(proposal, qc)
 			val := validator.New(sys.getLeader().Address())
 			return &testcase{
 				Sys:       sys,
 				Msg:       msg,
 				Leader:    val,
-				ExpectErr: errState,
+				ExpectErr: nil,
 			}
 		}(),
 	}
@@ -143,6 +143,10 @@ func (c *core) sendPrepareVote() {
 		logger.Trace("Failed to send vote", "msg", msgTyp, "err", "current vote is nil")
 		return
 	}
+	if err := c.persistVote(msgTyp, vote); err != nil {
+		logger.Warn("Failed to persist vote", "msg", msgTyp, "err", err)
+		return
+	}
 	payload, err := Encode(vote)
 	if err != nil {
 		logger.Trace("Failed to encode", "msg", msgTyp, "err", err)
@@ -180,7 +184,7 @@ func (c *core) extend(proposal hotstuff.Proposal, highQC *hotstuff.QuorumCert) e
 	return nil
 }
 
-// proposal extend lockedQC `OR` hiqhQC.view > lockedQC.view
+// proposal is the locked one `OR` extend lockedQC `OR` hiqhQC.view > lockedQC.view
 func (c *core) safeNode(proposal hotstuff.Proposal, highQC *hotstuff.QuorumCert) error {
 	logger := c.newLogger()
 
@@ -193,7 +197,9 @@ func (c *core) safeNode(proposal hotstuff.Proposal, highQC *hotstuff.QuorumCert)
 		logger.Trace("safeNodeChecking", "lockQC", "is nil")
 		return errSafeNode
 	}
-	if err := c.extend(proposal, c.current.PreCommittedQC()); err == nil {
+	if proposal.Hash() == c.current.PreCommittedQC().Hash {
+		safety = true
+	} else if err := c.extend(proposal, c.current.PreCommittedQC()); err == nil {
 		safety = true
 	} else {
 		logger.Trace("safeNodeChecking", "extend err", err)
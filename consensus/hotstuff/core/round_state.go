@@ -21,6 +21,7 @@ package core
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/message_set"
 )
@@ -183,6 +184,17 @@ func (s *roundState) CommitVoteSize() int {
 	return s.commitVotes.Size()
 }
 
+// VoteCounts returns the number of messages received from every validator in current round.
+func (s *roundState) VoteCounts() map[common.Address]int {
+	counts := make(map[common.Address]int)
+	for _, set := range []*message_set.MessageSet{s.newViews, s.prepareVotes, s.preCommitVotes, s.commitVotes} {
+		for _, msg := range set.Values() {
+			counts[msg.Address] += 1
+		}
+	}
+	return counts
+}
+
 func (s *roundState) SetHighQC(qc *hotstuff.QuorumCert) {
 	s.highQC = qc
 }
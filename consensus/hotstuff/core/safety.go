@@ -0,0 +1,76 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// persistVote checks the vote with the persisted safety rules, and stores it together with current locked
+// and prepare quorum certs before the vote being signed.
+func (c *core) persistVote(code MsgType, vote *Vote) error {
+	if err := c.safety.CheckVote(code, vote.View, vote.Digest, c.current.HighQC()); err != nil {
+		return err
+	}
+
+	// locked QC of the same height should be kept after round change
+	lockedQC := c.current.PreCommittedQC()
+	if c.safety != nil && c.safety.LockedQC != nil && (lockedQC == nil || c.safety.LockedQC.Height().Cmp(lockedQC.Height()) > 0) {
+		lockedQC = c.safety.LockedQC
+	}
+	rules := &hotstuff.SafetyRules{
+		LastVoteView:   vote.View,
+		LastVoteCode:   code.Value(),
+		LastVoteDigest: vote.Digest,
+		LockedQC:       lockedQC,
+		PrepareQC:      c.current.PrepareQC(),
+	}
+	if err := c.backend.StoreSafetyRules(rules); err != nil {
+		return err
+	}
+	c.safety = rules
+	return nil
+}
+
+// restoreSafetyRules loads the persisted safety rules, and catch up with the round of last vote if it
+// voted in current height before. The validator refuses to start if the rules can not be read, because
+// voting without them may sign conflicting messages.
+func (c *core) restoreSafetyRules() error {
+	logger := c.newLogger()
+
+	rules, err := c.backend.LoadSafetyRules()
+	if err != nil {
+		logger.Error("Failed to load safety rules", "err", err)
+		return err
+	}
+	c.safety = rules
+	if rules == nil || rules.LastVoteView == nil {
+		return nil
+	}
+
+	logger.Info("Restore safety rules", "rules", rules)
+	if rules.LastVoteView.Height.Cmp(c.current.Height()) == 0 && rules.LastVoteView.Round.Cmp(c.current.Round()) > 0 {
+		c.startNewRound(rules.LastVoteView.Round)
+	}
+	// the round state created above only knows about the chain head, keep the lock of current height.
+	if rules.LockedQC != nil && rules.LockedQC.View != nil && rules.LockedQC.Height().Cmp(c.current.Height()) == 0 {
+		c.current.SetPreCommittedQC(rules.LockedQC)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// go test -v -count 1 github.com/ethereum/go-ethereum/consensus/hotstuff/core -run TestSimulation
+func TestSimulationHappyPath(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 4,
+		Seed:       1,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+	})
+	sim.start()
+
+	assert.True(t, sim.run(time.Minute, sim.heightReached(10)), "cluster stuck")
+	sim.checkSafety()
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	replay := func() []string {
+		sim := newSimulator(t, &simConfig{
+			Validators: 4,
+			Seed:       2,
+			MinDelay:   5 * time.Millisecond,
+			MaxDelay:   200 * time.Millisecond,
+			DropRate:   0.05,
+			Reorder:    true,
+		})
+		sim.start()
+		sim.run(time.Minute, sim.heightReached(5))
+		return sim.trace
+	}
+
+	first, second := replay(), replay()
+	assert.NotEmpty(t, first)
+	assert.True(t, reflect.DeepEqual(first, second), "same seed should replay the same execution")
+}
+
+func TestSimulationLossyNetwork(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 7,
+		Seed:       3,
+		MinDelay:   5 * time.Millisecond,
+		MaxDelay:   300 * time.Millisecond,
+		DropRate:   0.1,
+		Reorder:    true,
+	})
+	sim.start()
+
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(5)), "cluster stuck")
+	sim.checkSafety()
+}
+
+func TestSimulationPartition(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 4,
+		Seed:       4,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+	})
+	sim.start()
+	assert.True(t, sim.run(time.Minute, sim.heightReached(3)), "cluster stuck")
+
+	// neither side has a quorum
+	sim.setPartition([]int{0, 1}, []int{2, 3})
+	sim.run(5*time.Second, nil)
+	height := len(sim.committed)
+	sim.run(time.Minute, nil)
+	assert.Equal(t, height, len(sim.committed), "minority should never commit")
+
+	sim.setPartition()
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(uint64(height+3))), "cluster stuck after healed")
+
+	// majority keeps going and the isolated validator catches up after healed
+	sim.setPartition([]int{3})
+	assert.True(t, sim.run(10*time.Minute, func() bool {
+		return sim.nodes[0].height() >= uint64(height+6)
+	}), "majority stuck")
+	sim.setPartition()
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(uint64(height+8))), "cluster stuck after healed")
+	sim.checkSafety()
+}
+
+func TestSimulationCrashedValidator(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 4,
+		Seed:       5,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+		Behaviours: map[int]simBehaviour{1: simCrashed},
+	})
+	sim.start()
+
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(8)), "cluster stuck")
+	sim.checkSafety()
+}
+
+func TestSimulationSilentLeader(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 4,
+		Seed:       6,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+		Behaviours: map[int]simBehaviour{2: simSilentLeader},
+	})
+	sim.start()
+
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(8)), "cluster stuck")
+	sim.checkSafety()
+}
+
+func TestSimulationConflictingVotes(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 4,
+		Seed:       7,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+		Reorder:    true,
+		Behaviours: map[int]simBehaviour{0: simConflictingVoter},
+	})
+	sim.start()
+
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(8)), "cluster stuck")
+	sim.checkSafety()
+
+	offender := sim.nodes[0].address
+	assert.Contains(t, sim.evidences, offender, "equivocation not reported")
+	assert.Len(t, sim.evidences, 1)
+}
+
+func TestSimulationEpochRotation(t *testing.T) {
+	sim := newSimulator(t, &simConfig{
+		Validators: 7,
+		Seed:       8,
+		MinDelay:   10 * time.Millisecond,
+		MaxDelay:   50 * time.Millisecond,
+		Epochs: map[uint64][]int{
+			1:  {0, 1, 2, 3},
+			6:  {2, 3, 4, 5},
+			11: {3, 4, 5, 6, 0},
+			16: {1, 6},
+			21: {0, 1, 2, 3, 4, 5, 6},
+		},
+	})
+	sim.start()
+
+	// validators leaving epoch keep syncing blocks, and validators joining epoch start to vote at the
+	// start height without restarting.
+	assert.True(t, sim.run(10*time.Minute, sim.heightReached(25)), "cluster stuck")
+	sim.checkSafety()
+
+	chain := sim.nodes[0].chain
+	for _, block := range chain[1:] {
+		valSet := sim.validators(block.NumberU64())
+		_, proposer := valSet.GetByAddress(block.Coinbase())
+		assert.NotNil(t, proposer, "block %d proposed by validator out of epoch", block.NumberU64())
+	}
+}
@@ -0,0 +1,544 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// simBehaviour defines how a simulated validator deviates from the protocol.
+type simBehaviour int
+
+const (
+	simHonest           simBehaviour = iota
+	simCrashed                       // never sends or receives any message
+	simSilentLeader                  // drops all of its messages while being the proposer
+	simConflictingVoter              // sends an extra vote for a random digest along with every vote
+)
+
+// simConfig describes the cluster and network conditions of simulation.
+type simConfig struct {
+	Validators int
+	Seed       int64
+
+	MinDelay time.Duration // lower bound of message latency, should be positive
+	MaxDelay time.Duration // upper bound of message latency
+	DropRate float64       // probability of dropping a consensus message, blocks are never dropped
+	Reorder  bool          // allow messages of the same link to overtake each other
+
+	Behaviours map[int]simBehaviour // byzantine validators indexed by their position in cluster
+	Epochs     map[uint64][]int     // validators of epoch indexed by start height, all nodes validate by default
+	Config     *hotstuff.Config     // consensus config shared by all validators
+}
+
+// simulator runs a cluster of basic hotstuff cores over an in-memory network in the test goroutine.
+// Timers, message latency and every random decision are driven by the simulated clock and the seeded
+// random source, so that the same config always replays exactly the same execution.
+type simulator struct {
+	t      *testing.T
+	config *simConfig
+	clock  *mclock.Simulated
+	rand   *rand.Rand
+
+	nodes   []*simNode
+	epochs  map[uint64]hotstuff.ValidatorSet // validators of epoch indexed by start height
+	genesis *types.Block
+
+	partition map[common.Address]int                // validators in different groups can't reach each other
+	links     map[[2]int]mclock.AbsTime             // latest delivery time of every link, used to keep fifo order
+	committed map[uint64]common.Hash                // the block committed at every height by honest validators
+	evidences map[common.Address]*hotstuff.Evidence // equivocations reported by honest validators
+	trace     []string                              // ordered commit records, used to check determinism
+	violated  []string                              // safety violations
+}
+
+// simNode implements hotstuff.Backend for a single validator of simulation.
+type simNode struct {
+	index     int
+	address   common.Address
+	behaviour simBehaviour
+	sim       *simulator
+	core      *core
+	events    *event.TypeMux
+	chain     []*types.Block
+	safety    *hotstuff.SafetyRules
+}
+
+func newSimulator(t *testing.T, config *simConfig) *simulator {
+	if config.MinDelay <= 0 {
+		config.MinDelay = time.Millisecond
+	}
+	if config.MaxDelay < config.MinDelay {
+		config.MaxDelay = config.MinDelay
+	}
+	if config.Config == nil {
+		config.Config = &hotstuff.Config{
+			RequestTimeout: 1000,
+			BlockPeriod:    1,
+			LeaderPolicy:   hotstuff.RoundRobin,
+		}
+	}
+
+	sim := &simulator{
+		t:         t,
+		config:    config,
+		clock:     new(mclock.Simulated),
+		rand:      rand.New(rand.NewSource(config.Seed)),
+		links:     make(map[[2]int]mclock.AbsTime),
+		committed: make(map[uint64]common.Hash),
+		evidences: make(map[common.Address]*hotstuff.Evidence),
+		genesis:   makeBlock(0),
+	}
+
+	addrs := make([]common.Address, config.Validators)
+	for i := range addrs {
+		addrs[i] = common.BytesToAddress(crypto.Keccak256(big.NewInt(config.Seed).Bytes(), big.NewInt(int64(i)).Bytes()))
+	}
+	sim.epochs = map[uint64]hotstuff.ValidatorSet{0: validator.NewSet(addrs, config.Config.LeaderPolicy)}
+	for start, members := range config.Epochs {
+		list := make([]common.Address, len(members))
+		for i, index := range members {
+			list[i] = addrs[index]
+		}
+		sim.epochs[start] = validator.NewSet(list, config.Config.LeaderPolicy)
+	}
+
+	for i, addr := range addrs {
+		node := &simNode{
+			index:     i,
+			address:   addr,
+			behaviour: config.Behaviours[i],
+			sim:       sim,
+			events:    new(event.TypeMux),
+			chain:     []*types.Block{sim.genesis},
+		}
+		c := New(node, config.Config, &mockSinger{address: addr}).(*core)
+		c.logger = log.New("sim", i)
+		c.clock = sim.clock
+		c.postEvent = node.post
+		c.sleep = func(time.Duration) {} // blocks arrive through the event loop, which can not progress while sleeping
+		node.core = c
+		sim.nodes = append(sim.nodes, node)
+	}
+	return sim
+}
+
+// start enters the first round of every validator and feeds them with the first request.
+func (s *simulator) start() {
+	for _, node := range s.nodes {
+		node.core.start()
+		node.post(node.newRequest())
+	}
+}
+
+// run moves the simulated clock forward until `until` returns true or the duration exhausted, it returns
+// whether the condition satisfied.
+func (s *simulator) run(d time.Duration, until func() bool) bool {
+	const step = time.Millisecond
+
+	for elapsed := time.Duration(0); elapsed < d; elapsed += step {
+		s.clock.Run(step)
+		if until != nil && until() {
+			return true
+		}
+	}
+	return until == nil
+}
+
+// heightReached returns a condition satisfied when all honest validators committed the given height.
+func (s *simulator) heightReached(height uint64) func() bool {
+	return func() bool {
+		for _, node := range s.honest() {
+			if node.height() < height {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// validators returns the validators of epoch which the height belongs to.
+func (s *simulator) validators(height uint64) hotstuff.ValidatorSet {
+	var (
+		start  uint64
+		valSet hotstuff.ValidatorSet
+	)
+	for h, set := range s.epochs {
+		if h <= height && (valSet == nil || h > start) {
+			start, valSet = h, set
+		}
+	}
+	return valSet.Copy()
+}
+
+func (s *simulator) honest() []*simNode {
+	list := make([]*simNode, 0, len(s.nodes))
+	for _, node := range s.nodes {
+		if node.behaviour == simHonest {
+			list = append(list, node)
+		}
+	}
+	return list
+}
+
+// setPartition splits validators into groups indexed by their position, validators not listed stay
+// in group 0. Calling it with no groups heals the network.
+func (s *simulator) setPartition(groups ...[]int) {
+	if len(groups) == 0 {
+		s.partition = nil
+		return
+	}
+	s.partition = make(map[common.Address]int)
+	for id, group := range groups {
+		for _, index := range group {
+			s.partition[s.nodes[index].address] = id + 1
+		}
+	}
+}
+
+func (s *simulator) reachable(from, to *simNode) bool {
+	if from.behaviour == simCrashed || to.behaviour == simCrashed {
+		return false
+	}
+	return s.partition == nil || s.partition[from.address] == s.partition[to.address]
+}
+
+func (s *simulator) node(addr common.Address) *simNode {
+	for _, node := range s.nodes {
+		if node.address == addr {
+			return node
+		}
+	}
+	return nil
+}
+
+// send delivers the payload from one validator to another after a random latency.
+func (s *simulator) send(from, to *simNode, payload []byte) {
+	if from == to {
+		from.post(hotstuff.MessageEvent{Payload: payload})
+		return
+	}
+	if !s.reachable(from, to) || s.rand.Float64() < s.config.DropRate {
+		return
+	}
+	s.deliver(from, to, func() {
+		to.post(hotstuff.MessageEvent{Payload: payload})
+	})
+}
+
+// deliver schedules fn after a random latency of the link, it is dropped if the link is broken by
+// then.
+func (s *simulator) deliver(from, to *simNode, fn func()) {
+	delay := s.config.MinDelay
+	if jitter := s.config.MaxDelay - s.config.MinDelay; jitter > 0 {
+		delay += time.Duration(s.rand.Int63n(int64(jitter)))
+	}
+	now := s.clock.Now()
+	at := now.Add(delay)
+	if link := [2]int{from.index, to.index}; !s.config.Reorder {
+		if last := s.links[link]; at < last {
+			at = last
+		}
+		s.links[link] = at
+	}
+	s.clock.AfterFunc(at.Sub(now), func() {
+		if s.reachable(from, to) {
+			fn()
+		}
+	})
+}
+
+// propagate announces the new block to other validators, the receiver downloads all of missing blocks
+// from the sender as the block fetcher does.
+func (s *simulator) propagate(from *simNode, block *types.Block) {
+	for _, to := range s.nodes {
+		if to == from || !s.reachable(from, to) {
+			continue
+		}
+		to := to
+		s.deliver(from, to, func() {
+			to.sync(from, block.NumberU64())
+		})
+	}
+}
+
+// commit records the block inserted by validator, and checks that honest validators never commit
+// conflicting blocks.
+func (s *simulator) commit(node *simNode, block *types.Block) {
+	if node.behaviour != simHonest {
+		return
+	}
+	height := block.NumberU64()
+	if hash, ok := s.committed[height]; !ok {
+		s.committed[height] = block.Hash()
+	} else if hash != block.Hash() {
+		s.violated = append(s.violated, fmt.Sprintf("validator %d committed %s at height %d, conflict with %s", node.index, block.Hash().TerminalString(), height, hash.TerminalString()))
+	}
+	s.trace = append(s.trace, fmt.Sprintf("%d:%d:%d:%s", s.clock.Now(), node.index, height, block.Hash().TerminalString()))
+}
+
+// checkSafety fails the test if any conflicting commits observed.
+func (s *simulator) checkSafety() {
+	s.t.Helper()
+	for _, v := range s.violated {
+		s.t.Error(v)
+	}
+}
+
+// ==============================================
+//
+// define the functions of simulated validator.
+
+func (n *simNode) post(ev interface{}) {
+	n.sim.clock.AfterFunc(0, func() {
+		n.handle(ev)
+	})
+}
+
+// handle dispatches events to core in the same way as `core.handleEvents`.
+func (n *simNode) handle(ev interface{}) {
+	if n.behaviour == simCrashed || !n.core.isRunning {
+		return
+	}
+	c := n.core
+	switch ev := ev.(type) {
+	case hotstuff.RequestEvent:
+		c.handleRequest(&hotstuff.Request{Proposal: ev.Proposal})
+	case hotstuff.MessageEvent:
+		c.handleMsg(ev.Payload)
+	case backlogEvent:
+		c.handleCheckedMsg(ev.msg, ev.src)
+	case timeoutEvent:
+		c.handleTimeoutMsg()
+	case hotstuff.FinalCommittedEvent:
+		c.handleFinalCommitted(ev.Header)
+	}
+}
+
+func (n *simNode) head() *types.Block {
+	return n.chain[len(n.chain)-1]
+}
+
+func (n *simNode) height() uint64 {
+	return n.head().NumberU64()
+}
+
+// newRequest builds the block of next height as the miner does, blocks built by different validators
+// are distinguished by coinbase.
+func (n *simNode) newRequest() hotstuff.RequestEvent {
+	parent := n.head()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   n.address,
+		Difficulty: big.NewInt(0),
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+	}
+	return hotstuff.RequestEvent{Proposal: types.NewBlockWithHeader(header)}
+}
+
+// insert appends the block to local chain and notifies core as the miner worker does.
+func (n *simNode) insert(block *types.Block) bool {
+	if block.ParentHash() != n.head().Hash() {
+		return false
+	}
+	n.chain = append(n.chain, block)
+	n.sim.commit(n, block)
+	n.post(hotstuff.FinalCommittedEvent{Header: block.Header()})
+	return true
+}
+
+// sync downloads blocks up to the given height from peer.
+func (n *simNode) sync(peer *simNode, height uint64) {
+	if n.height() >= height || n.behaviour == simCrashed {
+		return
+	}
+	for number := n.height() + 1; number <= height && number < uint64(len(peer.chain)); number++ {
+		if !n.insert(peer.chain[number]) {
+			return
+		}
+	}
+	n.post(n.newRequest())
+}
+
+func (n *simNode) Address() common.Address {
+	return n.address
+}
+
+func (n *simNode) Validators(height uint64) hotstuff.ValidatorSet {
+	return n.sim.validators(height)
+}
+
+func (n *simNode) EventMux() *event.TypeMux {
+	return n.events
+}
+
+func (n *simNode) Broadcast(valSet hotstuff.ValidatorSet, payload []byte) error {
+	for _, val := range valSet.List() {
+		n.sendTo(val.Address(), payload)
+	}
+	return nil
+}
+
+func (n *simNode) Gossip(valSet hotstuff.ValidatorSet, payload []byte) error {
+	for _, val := range valSet.List() {
+		if val.Address() != n.address {
+			n.sendTo(val.Address(), payload)
+		}
+	}
+	return nil
+}
+
+func (n *simNode) Unicast(valSet hotstuff.ValidatorSet, payload []byte) error {
+	n.sendTo(valSet.GetProposer().Address(), payload)
+	return nil
+}
+
+// sendTo applies byzantine behaviour of local validator to the outgoing message.
+func (n *simNode) sendTo(addr common.Address, payload []byte) {
+	to := n.sim.node(addr)
+	if to == nil {
+		return
+	}
+	switch n.behaviour {
+	case simCrashed:
+		return
+	case simSilentLeader:
+		if n.core.IsProposer() {
+			return
+		}
+	case simConflictingVoter:
+		if forged := n.forgeVote(payload); forged != nil {
+			n.sim.send(n, to, forged)
+		}
+	}
+	n.sim.send(n, to, payload)
+}
+
+// forgeVote signs another vote for a random digest in the same view, it returns nil if the payload
+// is not a vote.
+func (n *simNode) forgeVote(payload []byte) []byte {
+	msg := new(hotstuff.Message)
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		return nil
+	}
+	switch msg.Code {
+	case MsgTypePrepareVote, MsgTypePreCommitVote, MsgTypeCommitVote:
+	default:
+		return nil
+	}
+	var vote *Vote
+	if err := msg.Decode(&vote); err != nil {
+		return nil
+	}
+	var digest common.Hash
+	n.sim.rand.Read(digest[:])
+	vote.Digest = digest
+	if msg.Msg, _ = Encode(vote); msg.Msg == nil {
+		return nil
+	}
+	data, _ := msg.PayloadToSign(n.core.config.ChainID, hotstuff.MsgTagBasic)
+	msg.Signature, _ = n.core.signer.Sign(data)
+	forged, _ := msg.Payload()
+	return forged
+}
+
+func (n *simNode) PreCommit(proposal hotstuff.Proposal, committers []common.Address, seals [][]byte) (hotstuff.Proposal, error) {
+	return proposal, nil
+}
+
+func (n *simNode) CollectSeal(number uint64, hash common.Hash, committer common.Address, seal []byte) {
+}
+
+func (n *simNode) ForwardCommit(proposal hotstuff.Proposal, extra []byte) (hotstuff.Proposal, error) {
+	return proposal, nil
+}
+
+func (n *simNode) Certify(proposal hotstuff.Proposal) error {
+	return nil
+}
+
+func (n *simNode) Commit(proposal hotstuff.Proposal, round *big.Int) error {
+	block := proposal.(*types.Block)
+	if !n.insert(block) {
+		return fmt.Errorf("block %d %s not extend local chain", block.NumberU64(), block.Hash().TerminalString())
+	}
+	n.sim.propagate(n, block)
+	n.post(n.newRequest())
+	return nil
+}
+
+func (n *simNode) Verify(proposal hotstuff.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (n *simNode) VerifyUnsealedProposal(proposal hotstuff.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (n *simNode) LastProposal() (hotstuff.Proposal, common.Address) {
+	head := n.head()
+	return head, head.Coinbase()
+}
+
+func (n *simNode) HasBadProposal(hash common.Hash) bool {
+	return false
+}
+
+func (n *simNode) ValidateBlock(block *types.Block) error {
+	return nil
+}
+
+func (n *simNode) StoreSafetyRules(rules *hotstuff.SafetyRules) error {
+	n.safety = rules
+	return nil
+}
+
+func (n *simNode) LoadSafetyRules() (*hotstuff.SafetyRules, error) {
+	return n.safety, nil
+}
+
+func (n *simNode) ReportEvidence(evidence *hotstuff.Evidence) {
+	if n.behaviour != simHonest {
+		return
+	}
+	// mock signer doesn't sign messages with ecdsa, so that the offender is taken from the message
+	// rather than `Evidence.Verify`.
+	msg := new(hotstuff.Message)
+	if err := rlp.DecodeBytes(evidence.First, msg); err == nil {
+		n.sim.evidences[msg.Address] = evidence
+	}
+}
+
+func (n *simNode) Close() error {
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// statusTimeout is the max duration waiting for event loop to take a snapshot of round state
+const statusTimeout = time.Second
+
+// Status implements hotstuff.CoreEngine.Status, round state is only accessed in the event loop.
+func (c *core) Status() *hotstuff.CoreStatus {
+	if !c.isRunning {
+		return nil
+	}
+	ch := make(chan *hotstuff.CoreStatus, 1)
+	select {
+	case c.statusCh <- ch:
+		return <-ch
+	case <-time.After(statusTimeout):
+		return nil
+	}
+}
+
+// SubscribeRoundChange implements hotstuff.CoreEngine.SubscribeRoundChange
+func (c *core) SubscribeRoundChange(ch chan<- hotstuff.RoundChangeEvent) event.Subscription {
+	return c.roundFeed.Subscribe(ch)
+}
+
+func (c *core) status() *hotstuff.CoreStatus {
+	if c.current == nil {
+		return nil
+	}
+	status := &hotstuff.CoreStatus{
+		View:      c.currentView(),
+		State:     c.currentState().String(),
+		LockedQC:  c.current.PreCommittedQC(),
+		PrepareQC: c.current.PrepareQC(),
+		Requests:  c.requests.Size(),
+		Backlogs:  c.backlogs.Sizes(),
+		Votes:     c.current.VoteCounts(),
+	}
+	if proposer := c.valSet.GetProposer(); proposer != nil {
+		status.Proposer = proposer.Address()
+	}
+	return status
+}
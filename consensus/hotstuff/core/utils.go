@@ -167,7 +167,12 @@ func (c *core) finalizeMessage(msg *hotstuff.Message) ([]byte, error) {
 	// Add proof of consensus
 	proposal := c.current.Proposal()
 	if msg.Code == MsgTypePrepareVote && proposal != nil {
-		seal, err := c.signer.SignHash(proposal.Hash())
+		var seal []byte
+		if c.isAggregateSeal(proposal) {
+			seal, err = c.signer.SignAggregatableHash(proposal.Hash())
+		} else {
+			seal, err = c.signer.SignHash(proposal.Hash())
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -175,7 +180,7 @@ func (c *core) finalizeMessage(msg *hotstuff.Message) ([]byte, error) {
 	}
 
 	// Sign Message
-	data, err := msg.PayloadNoSig()
+	data, err := msg.PayloadToSign(c.config.ChainID, hotstuff.MsgTagBasic)
 	if err != nil {
 		return nil, err
 	}
@@ -193,14 +198,21 @@ func (c *core) finalizeMessage(msg *hotstuff.Message) ([]byte, error) {
 	return payload, nil
 }
 
-func (c *core) getMessageSeals(n int) [][]byte {
-	seals := make([][]byte, n)
-	for i, data := range c.current.PrepareVotes() {
-		if i < n {
-			seals[i] = data.CommittedSeal
+func (c *core) getMessageSeals(n int) ([]common.Address, [][]byte) {
+	committers := make([]common.Address, 0, n)
+	seals := make([][]byte, 0, n)
+	for _, data := range c.current.PrepareVotes() {
+		if len(seals) < n {
+			committers = append(committers, data.Address)
+			seals = append(seals, data.CommittedSeal)
 		}
 	}
-	return seals
+	return committers, seals
+}
+
+// isAggregateSeal returns true if the committed seals of proposal should be aggregated
+func (c *core) isAggregateSeal(proposal hotstuff.Proposal) bool {
+	return c.config.HotStuffConfig.IsAggregateSeal(proposal.Number())
 }
 
 func (c *core) broadcast(msg *hotstuff.Message) {
@@ -226,7 +238,12 @@ func (c *core) broadcast(msg *hotstuff.Message) {
 	}
 }
 
-func (c *core) checkValidatorSignature(data []byte, sig []byte) (common.Address, error) {
+// checkValidatorSignature recover the signer of message, the payload is signed with chain id and protocol tag.
+func (c *core) checkValidatorSignature(payload []byte, sig []byte) (common.Address, error) {
+	data, err := hotstuff.SigningPayload(c.config.ChainID, hotstuff.MsgTagBasic, payload)
+	if err != nil {
+		return common.Address{}, err
+	}
 	return c.signer.CheckSignature(c.valSet, data, sig)
 }
 
@@ -243,6 +260,21 @@ func (c *core) newLogger() log.Logger {
 	return logger
 }
 
+// vrfSeed returns the verifiable random output carried in proposal's extra salt, it will be nil
+// if the proposal is genesis block or not sealed with vrf.
+func vrfSeed(proposal hotstuff.Proposal) []byte {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return nil
+	}
+	extra, err := types.ExtractHotstuffExtra(block.Header())
+	if err != nil {
+		return nil
+	}
+	value, _ := extra.VRF()
+	return value
+}
+
 func proposal2QC(proposal hotstuff.Proposal, round *big.Int) *hotstuff.QuorumCert {
 	block := proposal.(*types.Block)
 	h := block.Header()
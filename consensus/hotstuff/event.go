@@ -18,7 +18,11 @@
 
 package hotstuff
 
-import "github.com/ethereum/go-ethereum/core/types"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
 
 // RequestEvent is posted to propose a proposal (posting the incoming block to
 // the main hotstuff engine anyway regardless of being the speaker or delegators)
@@ -36,3 +40,45 @@ type MessageEvent struct {
 type FinalCommittedEvent struct {
 	Header *types.Header
 }
+
+// RoundChangeEvent is posted when consensus core enters a new round
+type RoundChangeEvent struct {
+	View     *View
+	Proposer common.Address
+}
+
+// roundChangeQueue is the number of round change events buffered for each subscriber
+const roundChangeQueue = 16
+
+// RoundChangeFeed delivers round change events without blocking the consensus core, events are dropped
+// for the subscriber which doesn't keep up with them.
+type RoundChangeFeed struct {
+	feed event.Feed
+}
+
+// Send delivers the event to subscribers, it never blocks on a slow subscriber.
+func (f *RoundChangeFeed) Send(ev RoundChangeEvent) {
+	f.feed.Send(ev)
+}
+
+// Subscribe adds a channel to the feed, events are forwarded to the channel only if it's ready to receive.
+func (f *RoundChangeFeed) Subscribe(ch chan<- RoundChangeEvent) event.Subscription {
+	queue := make(chan RoundChangeEvent, roundChangeQueue)
+	sub := f.feed.Subscribe(queue)
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case ev := <-queue:
+				select {
+				case ch <- ev:
+				default:
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	})
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/prque"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+func (c *core) storeBacklog(msg *hotstuff.Message, src hotstuff.Validator) {
+	logger := c.newLogger()
+
+	if src.Address() == c.Address() {
+		logger.Trace("Backlog from self")
+		return
+	}
+
+	logger.Trace("Store backlog", "msg", msg, "msg view", msg.View)
+	c.backlogs.Push(msg)
+}
+
+func (c *core) processBacklog() {
+	logger := c.newLogger()
+
+	c.backlogs.mu.Lock()
+	defer c.backlogs.mu.Unlock()
+
+	for addr, queue := range c.backlogs.queue {
+		if queue == nil {
+			continue
+		}
+		src := c.getValidator(addr)
+		if src == nil {
+			logger.Trace("Skip the backlog", "unknown validator", addr)
+			delete(c.backlogs.queue, addr)
+			continue
+		}
+
+		for !queue.Empty() {
+			data, priority := queue.Pop()
+			msg, ok := data.(*hotstuff.Message)
+			if !ok {
+				logger.Trace("Skip the backlog, invalid Message")
+				continue
+			}
+			if err := c.checkView(msg.View); err != nil {
+				if err == errFutureMessage {
+					queue.Push(data, priority)
+					break
+				}
+				logger.Trace("Skip the backlog", "msg view", msg.View, "err", err)
+				continue
+			}
+
+			logger.Trace("Replay the backlog", "msg", msg)
+			go c.sendEvent(backlogEvent{src: src, msg: msg})
+		}
+	}
+}
+
+type backlog struct {
+	mu    *sync.Mutex
+	queue map[common.Address]*prque.Prque
+}
+
+func newBackLog() *backlog {
+	return &backlog{
+		mu:    new(sync.Mutex),
+		queue: make(map[common.Address]*prque.Prque),
+	}
+}
+
+// Sizes returns the number of backlog messages of every validator
+func (b *backlog) Sizes() map[common.Address]int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sizes := make(map[common.Address]int)
+	for addr, queue := range b.queue {
+		sizes[addr] = queue.Size()
+	}
+	return sizes
+}
+
+func (b *backlog) Push(msg *hotstuff.Message) {
+	if msg == nil || msg.View == nil || msg.Address == (common.Address{}) {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	addr := msg.Address
+	if _, ok := b.queue[addr]; !ok {
+		b.queue[addr] = prque.New(nil)
+	}
+	b.queue[addr].Push(msg, toPriority(msg.Code, msg.View))
+}
+
+// toPriority sorts messages by round first, and the proposal should be handled before votes in the same round.
+func toPriority(msgCode hotstuff.MsgType, view *hotstuff.View) int64 {
+	return -(view.Round.Int64()*10 + int64(msgCode.Value()))
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/message_set"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// core implements the event-driven (chained) hotstuff protocol. every proposal carries exactly one generic
+// quorum cert which certifies its parent, and the votes of the proposal are sent to the leader of next round,
+// who assembles them into the quorum cert of the next proposal. so that phases of different heights are pipelined:
+//
+//   - one-chain: a certified block is delivered to backend without being inserted into the chain, the next
+//     proposal is built and executed on top of it.
+//   - two-chain: the round of the parent quorum cert of the highest qc is locked, validators never vote
+//     for a proposal whose justify qc is lower than the locked one.
+//   - three-chain: if three certified blocks are proposed in consecutive rounds, the first one is final and
+//     committed into the chain together with its uncommitted ancestors.
+//
+// the round number increases monotonically across heights, and the view height is always `highQC.Height + 1`.
+type core struct {
+	config *hotstuff.Config
+	logger log.Logger
+
+	backend hotstuff.Backend
+	signer  hotstuff.Signer
+	chain   consensus.ChainReader
+
+	view      *hotstuff.View
+	valSet    hotstuff.ValidatorSet
+	proposal  hotstuff.Proposal // proposal voted in current view
+	proposed  bool              // leader already sent proposal in current view
+	delay     *time.Timer       // timer waiting for the timestamp of proposal in current view
+	certified bool              // proposal of current view has been certified
+	failures  uint64            // number of consecutive timeout rounds since the last quorum cert
+
+	highQC          *hotstuff.QuorumCert                 // the highest quorum cert, it certifies the parent of current proposal
+	lockQC          *hotstuff.QuorumCert                 // two-chain locked quorum cert
+	lastVoteView    *hotstuff.View                       // validator never votes twice in one round
+	safety          *hotstuff.SafetyRules                // persisted last vote and locked qc
+	committedHeight uint64                               // the latest height finalized by three-chain rule
+	justifies       map[common.Hash]*hotstuff.QuorumCert // proposal hash to its justify qc
+	blocks          map[common.Hash]hotstuff.Proposal    // certified blocks waiting for the three-chain commit
+	proposals       map[common.Hash]hotstuff.Proposal    // received proposals which may be certified without local vote
+
+	votes    *message_set.MessageSet // votes of current proposal, collected by the leader of next round
+	timeouts *message_set.MessageSet // timeout messages of current view, collected by the leader of current view
+
+	requests  *requestSet
+	backlogs  *backlog
+	pacemaker *pacemaker
+
+	events            *event.TypeMuxSubscription
+	timeoutSub        *event.TypeMuxSubscription
+	finalCommittedSub *event.TypeMuxSubscription
+
+	statusCh   chan chan *hotstuff.CoreStatus // snapshot requests served by the event loop
+	roundFeed  hotstuff.RoundChangeFeed
+	signedMsgs *lru.ARCCache // recent signed messages used to detect equivocation
+
+	validateFn func([]byte, []byte) (common.Address, error)
+	isRunning  bool
+}
+
+// New creates an event-driven HotStuff consensus core
+func New(backend hotstuff.Backend, config *hotstuff.Config, signer hotstuff.Signer) hotstuff.CoreEngine {
+	c := &core{
+		config:  config,
+		logger:  log.New("address", backend.Address()),
+		backend: backend,
+		signer:  signer,
+	}
+	c.validateFn = c.checkValidatorSignature
+	c.signedMsgs = newSignedMessages()
+	c.statusCh = make(chan chan *hotstuff.CoreStatus)
+	c.pacemaker = newPacemaker(config, func() {
+		c.sendEvent(timeoutEvent{})
+	})
+	return c
+}
+
+func (c *core) Address() common.Address {
+	return c.signer.Address()
+}
+
+func (c *core) IsProposer() bool {
+	if c.valSet == nil {
+		return false
+	}
+	return c.valSet.IsProposer(c.backend.Address())
+}
+
+func (c *core) IsCurrentProposal(blockHash common.Hash) bool {
+	if c.proposal != nil && c.proposal.Hash() == blockHash {
+		return true
+	}
+	if c.requests != nil && c.requests.Has(blockHash) {
+		return true
+	}
+	return false
+}
+
+func (c *core) currentView() *hotstuff.View {
+	return &hotstuff.View{
+		Height: new(big.Int).Set(c.view.Height),
+		Round:  new(big.Int).Set(c.view.Round),
+	}
+}
+
+func (c *core) Q() int {
+	return c.valSet.Q()
+}
+
+// startNewView enter the view of `highQC.Height + 1` with given round, the leader is elected by round only,
+// so that validators agree on the leader even if they have different highQC. vrf policy seeds the election
+// with the random output of highQC's block, which is the parent of the proposal in this view, validators with
+// different highQC agree on the leader again once the highQC carried by timeouts synchronized them.
+func (c *core) startNewView(round *big.Int) {
+	height := new(big.Int).Add(c.highQC.Height(), common.Big1)
+	c.view = &hotstuff.View{
+		Height: height,
+		Round:  new(big.Int).Set(round),
+	}
+	c.valSet = c.roundLeaderSet(height, round, c.highQC.Hash)
+
+	c.proposal = nil
+	c.proposed = false
+	c.certified = false
+	c.votes = message_set.NewMessageSet(c.valSet)
+	c.timeouts = message_set.NewMessageSet(c.valSet)
+	c.requests.Prune(height)
+
+	c.logger.Debug("New view", "view", c.view, "highQC", c.highQC.Hash, "new_proposer", c.valSet.GetProposer(), "size", c.valSet.Size(), "IsProposer", c.IsProposer())
+
+	c.roundFeed.Send(hotstuff.RoundChangeEvent{View: c.currentView(), Proposer: c.valSet.GetProposer().Address()})
+
+	c.pacemaker.Reset(c.failures)
+	c.processBacklog()
+	c.sendProposal()
+}
+
+// advanceHighQC update highQC if the quorum cert is higher than local one, lock and finalize blocks by two-chain
+// and three-chain rules, and enter the next height with a higher round.
+func (c *core) advanceHighQC(qc *hotstuff.QuorumCert) bool {
+	if c.highQC != nil && qc.Height().Cmp(c.highQC.Height()) <= 0 {
+		return false
+	}
+
+	if c.highQC != nil && c.failures == 0 {
+		c.pacemaker.Observe()
+	}
+	c.highQC = qc
+	c.failures = 0
+	c.updateLockQCAndCommit(qc)
+
+	round := new(big.Int).Set(c.view.Round)
+	if qc.Round().Cmp(round) > 0 {
+		round.Set(qc.Round())
+	}
+	c.startNewView(round.Add(round, common.Big1))
+	return true
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import "errors"
+
+var (
+	// errNotFromProposer is returned when received Message is supposed to be from proposer.
+	errNotFromProposer = errors.New("Message does not come from proposer")
+	errNotToProposer   = errors.New("Message does not send to proposer")
+	// errFutureMessage is returned when current view is earlier than the
+	// view of the received Message.
+	errFutureMessage        = errors.New("future Message")
+	errFarAwayFutureMessage = errors.New("far away future Message")
+	// errOldMessage is returned when the received Message's view is earlier
+	// than current view.
+	errOldMessage = errors.New("old Message")
+	// errInvalidMessage is returned when the Message is malformed.
+	errInvalidMessage       = errors.New("invalid Message")
+	errFailedDecodeProposal = errors.New("failed to decode PROPOSAL")
+	errFailedDecodeVote     = errors.New("failed to decode VOTE")
+	errFailedDecodeTimeout  = errors.New("failed to decode TIMEOUT")
+	// errInvalidSigner is returned when the Message is signed by a validator different than Message sender
+	errInvalidSigner          = errors.New("Message not signed by the sender")
+	errNoRequest              = errors.New("no valid request")
+	errInvalidProposal        = errors.New("invalid proposal")
+	errInvalidDigest          = errors.New("invalid digest")
+	errInvalidQC              = errors.New("invalid quorum cert")
+	errVerifyUnsealedProposal = errors.New("verify unsealed proposal failed")
+	errExtend                 = errors.New("proposal extend relationship error")
+	errSafeNode               = errors.New("safeNode checking failed")
+	errVoted                  = errors.New("already voted in this view")
+	errUnknownParent          = errors.New("parent of proposal not found")
+	errAddVote                = errors.New("add vote error")
+	errAddTimeout             = errors.New("add timeout error")
+	errInvalidCommittedSeal   = errors.New("invalid committed seal")
+)
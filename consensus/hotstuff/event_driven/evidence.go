@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// signedMessages is the number of recent signed messages kept for equivocation detection
+const signedMessages = 1024
+
+// newSignedMessages creates cache for recent signed messages, key is the sender, message type and view.
+func newSignedMessages() *lru.ARCCache {
+	cache, _ := lru.NewARC(signedMessages)
+	return cache
+}
+
+// checkEquivocation compares the proposal or vote with the last one which sent by the same validator in
+// the same view, and reports evidence to backend if they are conflicting.
+func (c *core) checkEquivocation(msg *hotstuff.Message) {
+	// timeout message carries the validator's highQC which may be changed in the same view.
+	if (msg.Code != MsgTypeProposal && msg.Code != MsgTypeVote) || msg.View == nil {
+		return
+	}
+
+	key := hotstuff.RLPHash([]interface{}{msg.Address, msg.Code.Value(), msg.View})
+	data, ok := c.signedMsgs.Get(key)
+	if !ok {
+		c.signedMsgs.Add(key, msg)
+		return
+	}
+	last := data.(*hotstuff.Message)
+	if !hotstuff.IsEquivocation(last, msg) {
+		return
+	}
+
+	evidence, err := hotstuff.NewEvidence(hotstuff.MsgTagEventDriven, last, msg)
+	if err != nil {
+		c.logger.Warn("Failed to package evidence", "msg", msg.Code, "src", msg.Address, "err", err)
+		return
+	}
+	c.logger.Warn("Equivocation detected", "msg", msg.Code, "src", msg.Address, "view", msg.View, "evidence", evidence.Hash())
+	c.backend.ReportEvidence(evidence)
+}
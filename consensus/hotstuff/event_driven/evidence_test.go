@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckEquivocation(t *testing.T) {
+	cluster := newTestCluster(t, 4)
+	node := cluster.nodes[0]
+	chainID := big.NewInt(60801)
+	node.core.config.ChainID = chainID
+
+	key, _ := crypto.GenerateKey()
+	view := &hotstuff.View{Height: big.NewInt(3), Round: big.NewInt(5)}
+	sign := func(key *ecdsa.PrivateKey, code MsgType, msg []byte) *hotstuff.Message {
+		m := &hotstuff.Message{Code: code, View: view, Msg: msg, Address: crypto.PubkeyToAddress(key.PublicKey)}
+		data, err := m.PayloadToSign(chainID, hotstuff.MsgTagEventDriven)
+		assert.NoError(t, err)
+		m.Signature, err = crypto.Sign(crypto.Keccak256(data), key)
+		assert.NoError(t, err)
+		return m
+	}
+
+	// the same vote received twice
+	node.core.checkEquivocation(sign(key, MsgTypeVote, []byte{1}))
+	node.core.checkEquivocation(sign(key, MsgTypeVote, []byte{1}))
+	assert.Len(t, node.evidences, 0)
+
+	// timeout carries the local highQC which may be changed in the same view
+	node.core.checkEquivocation(sign(key, MsgTypeTimeout, []byte{1}))
+	node.core.checkEquivocation(sign(key, MsgTypeTimeout, []byte{2}))
+	assert.Len(t, node.evidences, 0)
+
+	// conflicting votes and proposals are reported with the event-driven tag
+	node.core.checkEquivocation(sign(key, MsgTypeVote, []byte{2}))
+	node.core.checkEquivocation(sign(key, MsgTypeProposal, []byte{1}))
+	node.core.checkEquivocation(sign(key, MsgTypeProposal, []byte{2}))
+	assert.Len(t, node.evidences, 2)
+	for i, code := range []MsgType{MsgTypeVote, MsgTypeProposal} {
+		offender, evidenceView, tagged, err := node.evidences[i].Verify(chainID)
+		assert.NoError(t, err)
+		assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), offender)
+		assert.Equal(t, 0, view.Cmp(evidenceView))
+		assert.Equal(t, hotstuff.MsgTagEventDriven+code.Value(), tagged)
+	}
+
+	// evidence is bound to the chain which the messages are signed for
+	_, _, _, err := node.evidences[0].Verify(common.Big1)
+	assert.Equal(t, hotstuff.ErrInvalidEvidence, err)
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var once sync.Once
+
+// Start implements core.Engine.Start
+func (c *core) Start(chain consensus.ChainReader) error {
+	once.Do(func() {
+		hotstuff.RegisterMsgTypeConvertHandler(func(data interface{}) hotstuff.MsgType {
+			code := data.(uint64)
+			return MsgType(code)
+		})
+	})
+
+	c.chain = chain
+	c.isRunning = true
+	c.requests = newRequestSet()
+	c.backlogs = newBackLog()
+
+	// the chain head is always certified, start from the next height
+	lastProposal, _ := c.backend.LastProposal()
+	if lastProposal == nil {
+		return errInvalidProposal
+	}
+	c.highQC = proposal2QC(lastProposal, common.Big0)
+	c.lockQC = nil
+	c.lastVoteView = nil
+	c.failures = 0
+	c.committedHeight = lastProposal.Number().Uint64()
+	c.justifies = make(map[common.Hash]*hotstuff.QuorumCert)
+	c.blocks = make(map[common.Hash]hotstuff.Proposal)
+	c.proposals = make(map[common.Hash]hotstuff.Proposal)
+	round, err := c.restoreSafetyRules()
+	if err != nil {
+		c.isRunning = false
+		return err
+	}
+	c.startNewView(round)
+
+	c.subscribeEvents()
+	go c.handleEvents()
+	return nil
+}
+
+// Stop implements core.Engine.Stop
+func (c *core) Stop() error {
+	c.pacemaker.Stop()
+	c.unsubscribeEvents()
+	c.isRunning = false
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// Subscribe both internal and external events
+func (c *core) subscribeEvents() {
+	c.events = c.backend.EventMux().Subscribe(
+		// external events
+		hotstuff.RequestEvent{},
+		hotstuff.MessageEvent{},
+		// internal events
+		backlogEvent{},
+		proposalDelayEvent{},
+	)
+	c.timeoutSub = c.backend.EventMux().Subscribe(
+		timeoutEvent{},
+	)
+	c.finalCommittedSub = c.backend.EventMux().Subscribe(
+		hotstuff.FinalCommittedEvent{},
+	)
+}
+
+// Unsubscribe all events
+func (c *core) unsubscribeEvents() {
+	c.events.Unsubscribe()
+	c.timeoutSub.Unsubscribe()
+	c.finalCommittedSub.Unsubscribe()
+}
+
+func (c *core) handleEvents() {
+	logger := c.logger.New("handleEvents", "view", c.view)
+
+	for {
+		select {
+		case event, ok := <-c.events.Chan():
+			if !ok {
+				logger.Error("Failed to receive msg Event")
+				return
+			}
+			// A real Event arrived, process interesting content
+			switch ev := event.Data.(type) {
+			case hotstuff.RequestEvent:
+				c.handleRequest(&hotstuff.Request{Proposal: ev.Proposal})
+
+			case hotstuff.MessageEvent:
+				c.handleMsg(ev.Payload)
+
+			case backlogEvent:
+				c.handleCheckedMsg(ev.msg, ev.src)
+
+			case proposalDelayEvent:
+				if ev.view.Cmp(c.currentView()) == 0 {
+					c.sendProposal()
+				}
+			}
+
+		case _, ok := <-c.timeoutSub.Chan():
+			if !ok {
+				logger.Error("Failed to receive timeout Event")
+				return
+			}
+			c.handleTimeoutEvent()
+
+		case evt, ok := <-c.finalCommittedSub.Chan():
+			if !ok {
+				logger.Error("Failed to receive finalCommitted Event")
+				return
+			}
+			switch ev := evt.Data.(type) {
+			case hotstuff.FinalCommittedEvent:
+				c.handleFinalCommitted(ev.Header)
+			}
+
+		case ch := <-c.statusCh:
+			ch <- c.status()
+		}
+	}
+}
+
+// sendEvent sends events to mux
+func (c *core) sendEvent(ev interface{}) {
+	c.backend.EventMux().Post(ev)
+}
+
+func (c *core) handleMsg(payload []byte) error {
+	logger := c.logger.New()
+
+	// Decode Message and check its signature
+	msg := new(hotstuff.Message)
+	if err := msg.FromPayload(payload, c.validateFn); err != nil {
+		logger.Error("Failed to decode Message from payload", "err", err)
+		return err
+	}
+
+	// Only accept Message if the address is valid
+	src := c.getValidator(msg.Address)
+	if src == nil {
+		logger.Error("Invalid address in Message", "msg", msg)
+		return errInvalidSigner
+	}
+	c.checkEquivocation(msg)
+
+	// handle checked Message
+	if err := c.handleCheckedMsg(msg, src); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *core) handleCheckedMsg(msg *hotstuff.Message, src hotstuff.Validator) (err error) {
+	switch msg.Code {
+	case MsgTypeProposal:
+		err = c.handleProposal(msg, src)
+	case MsgTypeVote:
+		err = c.handleVote(msg, src)
+	case MsgTypeTimeout:
+		err = c.handleTimeout(msg, src)
+	default:
+		err = errInvalidMessage
+		c.logger.Error("msg type invalid", "unknown type", msg.Code)
+	}
+
+	if err == errFutureMessage {
+		c.storeBacklog(msg, src)
+	}
+	return
+}
+
+// handleTimeoutEvent increase round at the same height, and send the highest quorum cert to the new leader.
+func (c *core) handleTimeoutEvent() {
+	c.logger.Trace("handleTimeout", "view", c.view)
+	round := new(big.Int).Add(c.view.Round, common.Big1)
+	c.failures += 1
+	c.startNewView(round)
+	c.sendTimeout()
+}
+
+// handleFinalCommitted catch up the chain head, the block in chain was verified with committed seals, which is
+// exactly the quorum cert of the block.
+func (c *core) handleFinalCommitted(header *types.Header) error {
+	if header.Number.Cmp(c.view.Height) < 0 {
+		return nil
+	}
+	qc := header2QC(header, common.Big0)
+	if c.advanceHighQC(qc) {
+		c.logger.Trace("handleFinalCommitted", "height", header.Number, "hash", header.Hash())
+	}
+	return nil
+}
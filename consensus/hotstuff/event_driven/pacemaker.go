@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// pacemaker guarantees the liveness of protocol, the timer is reset whenever the core enters a new view,
+// and the timeout after n consecutive failed rounds is decided by the configured strategy (`RequestTimeout + 2^n`
+// seconds by default) to make sure that honest validators will finally stay in the same view long enough.
+type pacemaker struct {
+	strategy  hotstuff.Pacemaker
+	mu        sync.Mutex // protects the timer which is also stopped outside of the event loop
+	timer     *time.Timer
+	start     time.Time // the time entering current view
+	onTimeout func()
+}
+
+func newPacemaker(config *hotstuff.Config, onTimeout func()) *pacemaker {
+	return &pacemaker{
+		strategy:  hotstuff.NewPacemaker(config),
+		onTimeout: onTimeout,
+	}
+}
+
+// Reset stops the timer of last view and regenerate a new timer
+func (p *pacemaker) Reset(failures uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stop()
+	p.start = time.Now()
+	p.timer = time.AfterFunc(p.Timeout(failures), p.onTimeout)
+}
+
+func (p *pacemaker) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stop()
+}
+
+func (p *pacemaker) stop() {
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+}
+
+// Observe reports the latency of current view to the strategy, it should be called only if the view
+// succeeded without timeout.
+func (p *pacemaker) Observe() {
+	if !p.start.IsZero() {
+		p.strategy.Observe(time.Since(p.start))
+	}
+}
+
+// Timeout returns the duration of view after given number of failed rounds
+func (p *pacemaker) Timeout(failures uint64) time.Duration {
+	return p.strategy.Timeout(failures)
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+func (c *core) handleRequest(req *hotstuff.Request) error {
+	logger := c.newLogger()
+
+	if req == nil || req.Proposal == nil {
+		return errInvalidMessage
+	}
+	if req.Proposal.Number().Cmp(c.view.Height) < 0 {
+		logger.Trace("Drop old request", "height", req.Proposal.Number(), "proposal", req.Proposal.Hash())
+		return errOldMessage
+	}
+	c.requests.StoreRequest(req)
+	logger.Trace("handleRequest", "height", req.Proposal.Number(), "proposal", req.Proposal.Hash())
+
+	c.sendProposal()
+	return nil
+}
+
+// sendProposal broadcast the proposal which extends the highest quorum cert. the leader of the round next to
+// highQC's is the validator who assembled the highQC, and leaders of other rounds should wait for timeout
+// messages of a quorum to make sure that they know about the highest quorum cert.
+func (c *core) sendProposal() {
+	logger := c.newLogger()
+
+	if !c.IsProposer() || c.proposed {
+		return
+	}
+	if c.failures > 0 && c.timeouts.Size() < c.Q() {
+		return
+	}
+	req := c.requests.GetRequest(c.highQC.Hash, c.view.Height)
+	if req == nil {
+		logger.Trace("Failed to create proposal", "err", errNoRequest, "highQC", c.highQC.Hash)
+		return
+	}
+
+	msgTyp := MsgTypeProposal
+	msg := &MsgProposal{
+		View:      c.currentView(),
+		Proposal:  req.Proposal,
+		JustifyQC: c.highQC,
+	}
+	payload, err := Encode(msg)
+	if err != nil {
+		logger.Trace("Failed to encode", "msg", msgTyp, "err", err)
+		return
+	}
+
+	// waiting for the proposal's timestamp to keep the block interval, the event loop keeps running meanwhile.
+	if delay := time.Unix(int64(msg.Proposal.Time()), 0).Sub(time.Now()); delay > 0 {
+		if c.delay != nil {
+			c.delay.Stop()
+		}
+		view := msg.View
+		c.delay = time.AfterFunc(delay, func() { c.sendEvent(proposalDelayEvent{view: view}) })
+		logger.Trace("delay to broadcast proposal", "time", delay.Milliseconds())
+		return
+	}
+
+	c.proposed = true
+	c.broadcast(&hotstuff.Message{Code: msgTyp, View: msg.View, Msg: payload})
+	logger.Trace("sendProposal", "proposal view", msg.View, "proposal", msg.Proposal.Hash())
+}
+
+func (c *core) handleProposal(data *hotstuff.Message, src hotstuff.Validator) error {
+	logger := c.newLogger()
+
+	var (
+		msg    *MsgProposal
+		msgTyp = MsgTypeProposal
+	)
+	if err := data.Decode(&msg); err != nil {
+		logger.Trace("Failed to decode", "msg", msgTyp, "err", err)
+		return errFailedDecodeProposal
+	}
+	if msg.View == nil || msg.View.Height == nil || msg.View.Round == nil ||
+		msg.JustifyQC == nil || msg.JustifyQC.View == nil || msg.Proposal == nil {
+		return errInvalidMessage
+	}
+	if !c.roundLeaderSet(msg.View.Height, msg.View.Round, msg.JustifyQC.Hash).IsProposer(src.Address()) {
+		logger.Trace("Failed to check proposer", "msg", msgTyp, "err", errNotFromProposer)
+		return errNotFromProposer
+	}
+	if msg.Proposal.Number().Cmp(msg.View.Height) != 0 {
+		logger.Trace("Failed to check proposal height", "msg", msgTyp, "expect", msg.View.Height, "got", msg.Proposal.Number())
+		return errInvalidProposal
+	}
+	// validator which entered a higher round by timeout rejects the proposal, but it still needs the block
+	// once the others certified it.
+	if number := msg.Proposal.Number().Uint64(); number > c.committedHeight && number <= c.view.Height.Uint64() {
+		c.proposals[msg.Proposal.Hash()] = msg.Proposal
+	}
+	if msg.View.Round.Cmp(c.view.Round) < 0 {
+		return errOldMessage
+	}
+
+	// proposal of the next height carries the quorum cert of current proposal, catch up with it before
+	// handling the proposal.
+	justify := msg.JustifyQC
+	if justify.Height().Cmp(c.highQC.Height()) > 0 {
+		if err := c.verifyQC(justify); err != nil {
+			logger.Trace("Failed to verify justifyQC", "msg", msgTyp, "err", err)
+			return errInvalidQC
+		}
+		c.certifyProposal(justify)
+		c.advanceHighQC(justify)
+	}
+	if hdiff := msg.View.Height.Cmp(c.view.Height); hdiff < 0 {
+		return errOldMessage
+	} else if hdiff > 0 {
+		return errFarAwayFutureMessage
+	}
+
+	// the leader of higher round proved that it knows the highest quorum cert, synchronize the round with it.
+	if msg.View.Round.Cmp(c.view.Round) > 0 {
+		c.startNewView(msg.View.Round)
+	}
+
+	// justify qc certifies the parent of proposal, it may be a different block at the same height with local highQC.
+	if justify.Hash != msg.Proposal.ParentHash() || justify.Height().Cmp(c.highQC.Height()) != 0 {
+		logger.Trace("Failed to check extend", "msg", msgTyp, "justify", justify, "parent", msg.Proposal.ParentHash())
+		return errExtend
+	}
+	if justify.Round().Cmp(msg.View.Round) >= 0 {
+		logger.Trace("Failed to check justify round", "msg", msgTyp, "justify", justify, "view", msg.View)
+		return errInvalidQC
+	}
+	if justify.Hash != c.highQC.Hash {
+		if err := c.verifyQC(justify); err != nil {
+			logger.Trace("Failed to verify justifyQC", "msg", msgTyp, "err", err)
+			return errInvalidQC
+		}
+	}
+	// highQC restored from chain head doesn't know about the round number.
+	if justify.Round().Cmp(c.highQC.Round()) > 0 {
+		c.highQC = justify
+		c.updateLockQCAndCommit(justify)
+	}
+	if err := c.safeNode(msg.View, justify); err != nil {
+		logger.Trace("Failed to check safeNode", "msg", msgTyp, "err", err)
+		return err
+	}
+	if _, err := c.backend.VerifyUnsealedProposal(msg.Proposal); err != nil {
+		logger.Trace("Failed to verify unsealed proposal", "msg", msgTyp, "err", err)
+		return errVerifyUnsealedProposal
+	}
+	if !c.hasParent(msg.Proposal) {
+		logger.Trace("Failed to check parent", "msg", msgTyp, "err", errUnknownParent)
+		return errUnknownParent
+	}
+	if err := c.preExecuteBlock(msg.Proposal); err != nil {
+		logger.Trace("Failed to pre-execute block", "msg", msgTyp, "err", err)
+		return err
+	}
+
+	logger.Trace("handleProposal", "msg", msgTyp, "src", src.Address(), "hash", msg.Proposal.Hash())
+
+	c.proposal = msg.Proposal
+	c.justifies[msg.Proposal.Hash()] = justify
+	c.sendVote()
+	c.processBacklog()
+	return nil
+}
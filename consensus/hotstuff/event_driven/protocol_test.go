@@ -0,0 +1,483 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCluster runs event-driven cores of validators over an in-memory network, messages are delivered
+// asynchronously as the p2p layer does.
+type testCluster struct {
+	genesis *types.Block
+	addrs   []common.Address
+	nodes   []*testNode
+	policy  hotstuff.SelectProposerPolicy
+
+	mu       sync.Mutex
+	violated []string // blocks committed before being final, or conflicting with other validators
+}
+
+// testNode implements hotstuff.Backend and consensus.ChainReader of a validator. It builds the next block
+// on top of the latest certified one as the miner does, and inserts the blocks committed by core.
+type testNode struct {
+	cluster *testCluster
+	address common.Address
+	crashed bool
+	core    *core
+	mux     *event.TypeMux
+
+	mu        sync.RWMutex
+	chain     []*types.Block
+	safety    *hotstuff.SafetyRules
+	safetyErr error
+	evidences []*hotstuff.Evidence
+}
+
+func newTestCluster(t *testing.T, size int, crashed ...int) *testCluster {
+	cluster := &testCluster{genesis: makeBlock(0, common.Hash{})}
+	for i := 0; i < size; i++ {
+		cluster.addrs = append(cluster.addrs, common.BytesToAddress([]byte{byte(i + 1)}))
+	}
+	config := &hotstuff.Config{
+		RequestTimeout:  300,
+		MaxRoundTimeout: 1000,
+		LeaderPolicy:    hotstuff.RoundRobin,
+		HotStuffConfig:  &params.HotStuffConfig{},
+	}
+	for _, addr := range cluster.addrs {
+		node := &testNode{
+			cluster: cluster,
+			address: addr,
+			mux:     new(event.TypeMux),
+			chain:   []*types.Block{cluster.genesis},
+		}
+		node.core = New(node, config, &testSigner{address: addr}).(*core)
+		cluster.nodes = append(cluster.nodes, node)
+	}
+	for _, index := range crashed {
+		cluster.nodes[index].crashed = true
+	}
+	t.Cleanup(cluster.stop)
+	return cluster
+}
+
+func (tc *testCluster) start(t *testing.T) {
+	for _, node := range tc.nodes {
+		if node.crashed {
+			continue
+		}
+		assert.NoError(t, node.core.Start(node))
+		node.request(tc.genesis)
+	}
+}
+
+func (tc *testCluster) stop() {
+	for _, node := range tc.nodes {
+		if node.core.isRunning {
+			node.core.Stop()
+		}
+	}
+}
+
+func (tc *testCluster) node(addr common.Address) *testNode {
+	for _, node := range tc.nodes {
+		if node.address == addr {
+			return node
+		}
+	}
+	return nil
+}
+
+// waitHeight waits until all of live validators inserted blocks up to the height.
+func (tc *testCluster) waitHeight(height uint64, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		reached := true
+		for _, node := range tc.nodes {
+			if !node.crashed && node.head().NumberU64() < height {
+				reached = false
+			}
+		}
+		if reached {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
+
+func (tc *testCluster) violate(format string, args ...interface{}) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.violated = append(tc.violated, fmt.Sprintf(format, args...))
+}
+
+// checkSafety fails the test if any validator committed a block before it's final, or live validators
+// inserted conflicting blocks.
+func (tc *testCluster) checkSafety(t *testing.T) {
+	t.Helper()
+
+	tc.mu.Lock()
+	for _, v := range tc.violated {
+		t.Error(v)
+	}
+	tc.mu.Unlock()
+
+	var reference []*types.Block
+	for _, node := range tc.nodes {
+		if node.crashed {
+			continue
+		}
+		node.mu.RLock()
+		for i := 0; i < len(node.chain) && i < len(reference); i++ {
+			assert.Equal(t, reference[i].Hash(), node.chain[i].Hash(), "conflicting block at height %d", i)
+		}
+		if len(node.chain) > len(reference) {
+			reference = node.chain
+		}
+		node.mu.RUnlock()
+	}
+}
+
+func (n *testNode) head() *types.Block {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.chain[len(n.chain)-1]
+}
+
+// request builds a block on top of parent and hands it to core as the miner does.
+func (n *testNode) request(parent *types.Block) {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Coinbase:   n.address,
+		Difficulty: big.NewInt(0),
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+	}
+	if n.cluster.policy == hotstuff.VRF {
+		// the test signer doesn't evaluate vrf, fill the salt with a random output derived from parent.
+		salt := make([]byte, types.HotstuffExtraVRFProof+types.HotstuffExtraVRFValue)
+		copy(salt[types.HotstuffExtraVRFProof:], crypto.Keccak256(parent.Hash().Bytes()))
+		payload, _ := rlp.EncodeToBytes(&types.HotstuffExtra{Salt: salt})
+		header.Extra = append(make([]byte, types.HotstuffExtraVanity), payload...)
+	}
+	go n.mux.Post(hotstuff.RequestEvent{Proposal: types.NewBlockWithHeader(header)})
+}
+
+func (n *testNode) send(addr common.Address, payload []byte) {
+	if to := n.cluster.node(addr); to != nil && !to.crashed {
+		go to.mux.Post(hotstuff.MessageEvent{Payload: payload})
+	}
+}
+
+func (n *testNode) Address() common.Address {
+	return n.address
+}
+
+func (n *testNode) Validators(height uint64) hotstuff.ValidatorSet {
+	return validator.NewSet(n.cluster.addrs, n.cluster.policy)
+}
+
+func (n *testNode) EventMux() *event.TypeMux {
+	return n.mux
+}
+
+func (n *testNode) Broadcast(valSet hotstuff.ValidatorSet, payload []byte) error {
+	for _, val := range valSet.List() {
+		n.send(val.Address(), payload)
+	}
+	return nil
+}
+
+func (n *testNode) Gossip(valSet hotstuff.ValidatorSet, payload []byte) error {
+	for _, val := range valSet.List() {
+		if val.Address() != n.address {
+			n.send(val.Address(), payload)
+		}
+	}
+	return nil
+}
+
+func (n *testNode) Unicast(valSet hotstuff.ValidatorSet, payload []byte) error {
+	n.send(valSet.GetProposer().Address(), payload)
+	return nil
+}
+
+func (n *testNode) PreCommit(proposal hotstuff.Proposal, committers []common.Address, seals [][]byte) (hotstuff.Proposal, error) {
+	return proposal, nil
+}
+
+func (n *testNode) CollectSeal(number uint64, hash common.Hash, committer common.Address, seal []byte) {
+}
+
+func (n *testNode) ForwardCommit(proposal hotstuff.Proposal, extra []byte) (hotstuff.Proposal, error) {
+	return proposal, nil
+}
+
+func (n *testNode) Certify(proposal hotstuff.Proposal) error {
+	n.request(proposal.(*types.Block))
+	return nil
+}
+
+// Commit is called by core in the event loop, the block should be the grandparent of a three-chain and
+// extends the local chain.
+func (n *testNode) Commit(proposal hotstuff.Proposal, round *big.Int) error {
+	block := proposal.(*types.Block)
+	if highQC := n.core.highQC.HeightU64(); highQC < block.NumberU64()+2 {
+		n.cluster.violate("validator %s committed block %d before three-chain, highQC %d", n.address, block.NumberU64(), highQC)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if head := n.chain[len(n.chain)-1]; block.ParentHash() != head.Hash() {
+		n.cluster.violate("validator %s committed block %d not extending head %d", n.address, block.NumberU64(), head.NumberU64())
+		return fmt.Errorf("unknown ancestor")
+	}
+	n.chain = append(n.chain, block)
+	go n.mux.Post(hotstuff.FinalCommittedEvent{Header: block.Header()})
+	return nil
+}
+
+func (n *testNode) Verify(proposal hotstuff.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (n *testNode) VerifyUnsealedProposal(proposal hotstuff.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (n *testNode) LastProposal() (hotstuff.Proposal, common.Address) {
+	head := n.head()
+	return head, head.Coinbase()
+}
+
+func (n *testNode) HasBadProposal(hash common.Hash) bool {
+	return false
+}
+
+func (n *testNode) ValidateBlock(block *types.Block) error {
+	return nil
+}
+
+func (n *testNode) StoreSafetyRules(rules *hotstuff.SafetyRules) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.safety = rules
+	return nil
+}
+
+func (n *testNode) LoadSafetyRules() (*hotstuff.SafetyRules, error) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.safety, n.safetyErr
+}
+
+func (n *testNode) ReportEvidence(evidence *hotstuff.Evidence) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.evidences = append(n.evidences, evidence)
+}
+
+func (n *testNode) Close() error {
+	return nil
+}
+
+func (n *testNode) Config() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+func (n *testNode) CurrentHeader() *types.Header {
+	return n.head().Header()
+}
+
+func (n *testNode) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if block := n.GetBlock(hash, number); block != nil {
+		return block.Header()
+	}
+	return nil
+}
+
+func (n *testNode) GetHeaderByNumber(number uint64) *types.Header {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if number < uint64(len(n.chain)) {
+		return n.chain[number].Header()
+	}
+	return nil
+}
+
+func (n *testNode) GetHeaderByHash(hash common.Hash) *types.Header {
+	if block := n.GetBlockByHash(hash); block != nil {
+		return block.Header()
+	}
+	return nil
+}
+
+func (n *testNode) GetBlock(hash common.Hash, number uint64) *types.Block {
+	if block := n.GetBlockByHash(hash); block != nil && block.NumberU64() == number {
+		return block
+	}
+	return nil
+}
+
+func (n *testNode) GetBlockByHash(hash common.Hash) *types.Block {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for _, block := range n.chain {
+		if block.Hash() == hash {
+			return block
+		}
+	}
+	return nil
+}
+
+func (n *testNode) PreExecuteBlock(block *types.Block, pending []*types.Header) error {
+	return nil
+}
+
+// testSigner signs messages with the address of validator, the signature is not verifiable.
+type testSigner struct {
+	address common.Address
+}
+
+func (s *testSigner) Address() common.Address                         { return s.address }
+func (s *testSigner) Sign(data []byte) ([]byte, error)                { return s.address.Bytes(), nil }
+func (s *testSigner) SigHash(header *types.Header) common.Hash        { return header.Hash() }
+func (s *testSigner) SignHash(hash common.Hash) ([]byte, error)       { return s.address.Bytes(), nil }
+func (s *testSigner) Recover(h *types.Header) (common.Address, error) { return h.Coinbase, nil }
+func (s *testSigner) SealBeforeCommit(h *types.Header) error          { return nil }
+func (s *testSigner) SealAfterCommit(h *types.Header, committedSeals [][]byte) error {
+	return nil
+}
+func (s *testSigner) BLSPublicKey() []byte { return nil }
+func (s *testSigner) BLSProof() []byte     { return nil }
+func (s *testSigner) AddBLSPublicKey(addr common.Address, pubKey, proof []byte) error {
+	return nil
+}
+func (s *testSigner) SignAggregatableHash(hash common.Hash) ([]byte, error) {
+	return s.address.Bytes(), nil
+}
+func (s *testSigner) VerifyAggregatableHash(signer common.Address, hash common.Hash, sig []byte) error {
+	return nil
+}
+func (s *testSigner) SealAggregatedAfterCommit(h *types.Header, valSet hotstuff.ValidatorSet, committers []common.Address, committedSeals [][]byte) error {
+	return nil
+}
+func (s *testSigner) VerifyHeader(header *types.Header, valSet hotstuff.ValidatorSet, seal bool) error {
+	return nil
+}
+func (s *testSigner) VerifyQC(qc *hotstuff.QuorumCert, valSet hotstuff.ValidatorSet) error {
+	return nil
+}
+func (s *testSigner) CheckQCParticipant(qc *hotstuff.QuorumCert, signer common.Address) error {
+	return nil
+}
+func (s *testSigner) CheckSignature(valSet hotstuff.ValidatorSet, data []byte, signature []byte) (common.Address, error) {
+	return common.BytesToAddress(signature), nil
+}
+func (s *testSigner) VerifyHash(valSet hotstuff.ValidatorSet, hash common.Hash, sig []byte) error {
+	return nil
+}
+func (s *testSigner) VerifyCommittedSeal(valSet hotstuff.ValidatorSet, hash common.Hash, committedSeals [][]byte) error {
+	return nil
+}
+func (s *testSigner) GetSignersFromCommittedSeals(hash common.Hash, seals [][]byte) ([]common.Address, error) {
+	return nil, nil
+}
+func (s *testSigner) GetSignersFromAggregatedSeal(valSet hotstuff.ValidatorSet, hash common.Hash, seal []byte, bitmap []byte) ([]common.Address, error) {
+	return nil, nil
+}
+func (s *testSigner) SealVRF(header *types.Header, parent *types.Header) error   { return nil }
+func (s *testSigner) VerifyVRF(header *types.Header, parent *types.Header) error { return nil }
+
+func TestProtocolHappyPath(t *testing.T) {
+	cluster := newTestCluster(t, 4)
+	cluster.start(t)
+
+	assert.True(t, cluster.waitHeight(10, 20*time.Second), "cluster stuck")
+	cluster.checkSafety(t)
+}
+
+// validator refuses to start if the persisted safety rules can't be read.
+func TestProtocolUnreadableSafetyRules(t *testing.T) {
+	cluster := newTestCluster(t, 4)
+	node := cluster.nodes[0]
+	node.safetyErr = errors.New("corrupted safety rules")
+	assert.Equal(t, node.safetyErr, node.core.Start(node))
+	assert.False(t, node.core.isRunning)
+
+	node.safetyErr = nil
+	assert.NoError(t, node.core.Start(node))
+}
+
+// blocks are final only if three of them are certified in consecutive rounds, the leader of a round before
+// the crashed one can't collect votes, so that there should be enough live leaders in a row.
+func TestProtocolCrashedValidator(t *testing.T) {
+	cluster := newTestCluster(t, 7, 2)
+	cluster.start(t)
+
+	assert.True(t, cluster.waitHeight(6, time.Minute), "cluster stuck")
+	cluster.checkSafety(t)
+}
+
+// the leader of vrf policy is elected with the random output of parent block, validators should agree on it.
+func TestProtocolVRFLeader(t *testing.T) {
+	cluster := newTestCluster(t, 4)
+	cluster.policy = hotstuff.VRF
+	cluster.start(t)
+
+	assert.True(t, cluster.waitHeight(10, 20*time.Second), "cluster stuck")
+	cluster.checkSafety(t)
+
+	node := cluster.nodes[0]
+	c := New(node, node.core.config, &testSigner{address: node.address}).(*core)
+	c.chain = node
+	parent := node.head()
+	height := new(big.Int).Add(parent.Number(), common.Big1)
+	seed := c.vrfSeed(parent.Hash(), parent.NumberU64())
+	assert.NotEmpty(t, seed)
+
+	roundOnly := true
+	for round := uint64(0); round < 16; round++ {
+		expect := validator.NewSet(cluster.addrs, hotstuff.VRF)
+		expect.SetRandomSeed(seed)
+		expect.CalcProposer(common.Address{}, round)
+		leader := c.roundLeaderSet(height, new(big.Int).SetUint64(round), parent.Hash()).GetProposer().Address()
+		assert.Equal(t, expect.GetProposer().Address(), leader)
+		if leader != cluster.addrs[round%uint64(len(cluster.addrs))] {
+			roundOnly = false
+		}
+	}
+	assert.False(t, roundOnly, "leader elected without the random seed")
+}
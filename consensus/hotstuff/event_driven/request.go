@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// requestSet stores the latest request of each parent block, miner may recommit blocks on the same parent
+// and the newer one always replaces the older.
+type requestSet struct {
+	mtx *sync.RWMutex
+
+	pending map[common.Hash]*hotstuff.Request // parent hash to request
+}
+
+func newRequestSet() *requestSet {
+	return &requestSet{
+		mtx:     new(sync.RWMutex),
+		pending: make(map[common.Hash]*hotstuff.Request),
+	}
+}
+
+func (s *requestSet) StoreRequest(req *hotstuff.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending[req.Proposal.ParentHash()] = req
+}
+
+// GetRequest returns the request of height which extends the parent
+func (s *requestSet) GetRequest(parent common.Hash, height *big.Int) *hotstuff.Request {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	req, ok := s.pending[parent]
+	if !ok || req.Proposal.Number().Cmp(height) != 0 {
+		return nil
+	}
+	return req
+}
+
+func (s *requestSet) Has(hash common.Hash) bool {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	for _, req := range s.pending {
+		if req.Proposal.Hash() == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune removes requests lower than height
+func (s *requestSet) Prune(height *big.Int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for parent, req := range s.pending {
+		if req.Proposal.Number().Cmp(height) < 0 {
+			delete(s.pending, parent)
+		}
+	}
+}
+
+func (s *requestSet) Size() int {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return len(s.pending)
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// safeNode checks the voting rules: validator votes at most once in a round, and the justify qc of
+// proposal should not be lower than the locked one.
+func (c *core) safeNode(view *hotstuff.View, justify *hotstuff.QuorumCert) error {
+	if c.lastVoteView != nil && view.Round.Cmp(c.lastVoteView.Round) <= 0 {
+		return errVoted
+	}
+	if c.lockQC != nil && justify.Round().Cmp(c.lockQC.Round()) < 0 {
+		return errSafeNode
+	}
+	return nil
+}
+
+// updateLockQCAndCommit takes the highest quorum cert `qc` which certifies block b2, the justify qc of b2
+// certifies b1 and the justify qc of b1 certifies b0. the qc of b1 will be locked (two-chain), and b0 will be
+// finalized and committed into the chain if b0, b1 and b2 were proposed in consecutive rounds (three-chain).
+func (c *core) updateLockQCAndCommit(qc *hotstuff.QuorumCert) {
+	logger := c.newLogger()
+
+	// b1 <- b2
+	qc1, ok := c.justifies[qc.Hash]
+	if !ok {
+		return
+	}
+	if c.lockQC == nil || qc1.Round().Cmp(c.lockQC.Round()) > 0 {
+		c.lockQC = qc1
+		logger.Trace("Update lockQC", "lockQC", qc1)
+	}
+
+	// b0 <- b1 <- b2
+	qc2, ok := c.justifies[qc1.Hash]
+	if !ok {
+		return
+	}
+	if qc.RoundU64() != qc1.RoundU64()+1 || qc1.RoundU64() != qc2.RoundU64()+1 {
+		return
+	}
+	if height := qc2.HeightU64(); height > c.committedHeight {
+		c.commitBranch(qc2.Hash)
+		c.committedHeight = height
+		logger.Debug("Finalized", "number", height, "hash", qc2.Hash, "round", qc2.Round())
+	}
+
+	// justifies lower than the final block are useless
+	for hash, justify := range c.justifies {
+		if justify.HeightU64() < c.committedHeight {
+			delete(c.justifies, hash)
+		}
+	}
+	for hash, block := range c.blocks {
+		if block.Number().Uint64() <= c.committedHeight {
+			delete(c.blocks, hash)
+		}
+	}
+	for hash, proposal := range c.proposals {
+		if proposal.Number().Uint64() <= c.committedHeight {
+			delete(c.proposals, hash)
+		}
+	}
+}
+
+// commitBranch commits the final block together with its certified ancestors which are not committed yet,
+// from the lowest one. Blocks already in the chain, e.g. the chain head which the core started from, are
+// not kept as certified and skipped.
+func (c *core) commitBranch(hash common.Hash) {
+	var branch []hotstuff.Proposal
+	for block, ok := c.blocks[hash]; ok && block.Number().Uint64() > c.committedHeight; block, ok = c.blocks[block.ParentHash()] {
+		branch = append(branch, block)
+	}
+	for i := len(branch) - 1; i >= 0; i-- {
+		if err := c.backend.Commit(branch[i], c.certifiedRound(branch[i].Hash())); err != nil {
+			c.logger.Warn("Failed to commit block", "number", branch[i].Number(), "hash", branch[i].Hash(), "err", err)
+			return
+		}
+	}
+}
+
+// certifiedRound returns the round in which the certified block was proposed, it is carried by the quorum
+// cert certifying the block, and nil if the quorum cert is unknown.
+func (c *core) certifiedRound(hash common.Hash) *big.Int {
+	for _, justify := range c.justifies {
+		if justify.Hash == hash {
+			return justify.Round()
+		}
+	}
+	return nil
+}
+
+// hasParent returns true if the parent of proposal is certified or inserted, the pre-execution of proposal
+// depends on parent state.
+func (c *core) hasParent(proposal hotstuff.Proposal) bool {
+	if _, ok := c.blocks[proposal.ParentHash()]; ok {
+		return true
+	}
+	return c.chain.GetHeader(proposal.ParentHash(), proposal.Number().Uint64()-1) != nil
+}
+
+// persistVote stores the vote together with locked qc and highQC before the vote being signed.
+func (c *core) persistVote(code MsgType, vote *Vote) error {
+	if err := c.safety.CheckVote(code, vote.View, vote.Digest, c.justifies[vote.Digest]); err != nil {
+		return err
+	}
+	rules := &hotstuff.SafetyRules{
+		LastVoteView:   vote.View,
+		LastVoteCode:   code.Value(),
+		LastVoteDigest: vote.Digest,
+		LockedQC:       c.lockQC,
+		PrepareQC:      c.highQC,
+	}
+	if err := c.backend.StoreSafetyRules(rules); err != nil {
+		return err
+	}
+	c.safety = rules
+	c.lastVoteView = vote.View
+	return nil
+}
+
+// restoreSafetyRules loads the persisted last vote and locked qc, and returns the round next to the last vote.
+// It fails if the rules can not be read, since voting without them may sign conflicting messages.
+func (c *core) restoreSafetyRules() (*big.Int, error) {
+	rules, err := c.backend.LoadSafetyRules()
+	if err != nil {
+		c.logger.Error("Failed to load safety rules", "err", err)
+		return nil, err
+	}
+	c.safety = rules
+	if rules == nil || rules.LastVoteView == nil {
+		return common.Big0, nil
+	}
+
+	c.logger.Info("Restore safety rules", "rules", rules)
+	c.lastVoteView = rules.LastVoteView
+	c.lockQC = rules.LockedQC
+	return new(big.Int).Add(rules.LastVoteView.Round, common.Big1), nil
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCore() *core {
+	return &core{
+		logger:    log.New(),
+		view:      &hotstuff.View{Round: big.NewInt(0), Height: big.NewInt(1)},
+		highQC:    makeQC(0, 0, common.Hash{}),
+		justifies: make(map[common.Hash]*hotstuff.QuorumCert),
+	}
+}
+
+// chain certifies blocks of height 1..n proposed at given rounds, and returns the quorum certs.
+func makeCertifiedChain(c *core, rounds []int64) []*hotstuff.QuorumCert {
+	qcs := []*hotstuff.QuorumCert{makeQC(0, 0, common.Hash{})}
+	for i, round := range rounds {
+		parent := qcs[len(qcs)-1]
+		block := makeBlock(int64(i+1), parent.Hash)
+		c.justifies[block.Hash()] = parent
+		qcs = append(qcs, makeQC(int64(i+1), round, block.Hash()))
+	}
+	return qcs
+}
+
+func TestThreeChainCommit(t *testing.T) {
+	c := newTestCore()
+	qcs := makeCertifiedChain(c, []int64{1, 2, 3, 5, 6, 7})
+
+	// two-chain locks the parent of highQC
+	c.updateLockQCAndCommit(qcs[2])
+	assert.Equal(t, qcs[1].Hash, c.lockQC.Hash)
+	assert.Equal(t, uint64(0), c.committedHeight)
+
+	// b1(round 1) <- b2(round 2) <- b3(round 3)
+	c.updateLockQCAndCommit(qcs[3])
+	assert.Equal(t, qcs[2].Hash, c.lockQC.Hash)
+	assert.Equal(t, uint64(1), c.committedHeight)
+
+	// rounds 2, 3, 5 are not consecutive
+	c.updateLockQCAndCommit(qcs[4])
+	assert.Equal(t, qcs[3].Hash, c.lockQC.Hash)
+	assert.Equal(t, uint64(1), c.committedHeight)
+	c.updateLockQCAndCommit(qcs[5])
+	assert.Equal(t, uint64(1), c.committedHeight)
+
+	// b4(round 5) <- b5(round 6) <- b6(round 7)
+	c.updateLockQCAndCommit(qcs[6])
+	assert.Equal(t, qcs[5].Hash, c.lockQC.Hash)
+	assert.Equal(t, uint64(4), c.committedHeight)
+}
+
+func TestSafeNode(t *testing.T) {
+	c := newTestCore()
+	c.lockQC = makeQC(3, 4, common.HexToHash("0x3"))
+	c.lastVoteView = &hotstuff.View{Round: big.NewInt(6), Height: big.NewInt(5)}
+
+	// vote once in a round
+	view := &hotstuff.View{Round: big.NewInt(6), Height: big.NewInt(5)}
+	assert.Equal(t, errVoted, c.safeNode(view, makeQC(4, 5, common.HexToHash("0x4"))))
+
+	// justify qc lower than locked qc
+	view = &hotstuff.View{Round: big.NewInt(7), Height: big.NewInt(4)}
+	assert.Equal(t, errSafeNode, c.safeNode(view, makeQC(3, 3, common.HexToHash("0x5"))))
+
+	// justify qc not lower than locked qc
+	assert.NoError(t, c.safeNode(view, makeQC(3, 4, common.HexToHash("0x3"))))
+	view = &hotstuff.View{Round: big.NewInt(7), Height: big.NewInt(5)}
+	assert.NoError(t, c.safeNode(view, makeQC(4, 6, common.HexToHash("0x4"))))
+}
+
+func TestSafetyRules(t *testing.T) {
+	var rules *hotstuff.SafetyRules
+	view := &hotstuff.View{Round: big.NewInt(3), Height: big.NewInt(2)}
+	justify := makeQC(1, 2, common.HexToHash("0x02"))
+	assert.NoError(t, rules.CheckVote(MsgTypeVote, view, common.HexToHash("0x01"), justify))
+
+	rules = &hotstuff.SafetyRules{
+		LastVoteView:   view,
+		LastVoteCode:   MsgTypeVote.Value(),
+		LastVoteDigest: common.HexToHash("0x01"),
+		LockedQC:       makeQC(1, 2, common.HexToHash("0x02")),
+	}
+	enc, err := rlp.EncodeToBytes(rules)
+	assert.NoError(t, err)
+	restored := new(hotstuff.SafetyRules)
+	assert.NoError(t, rlp.DecodeBytes(enc, restored))
+	assert.Nil(t, restored.PrepareQC)
+	assert.Equal(t, rules.LockedQC.Hash, restored.LockedQC.Hash)
+
+	// vote again in the same view
+	assert.NoError(t, restored.CheckVote(MsgTypeVote, view, common.HexToHash("0x01"), justify))
+	assert.Equal(t, hotstuff.ErrDoubleVote, restored.CheckVote(MsgTypeVote, view, common.HexToHash("0x03"), justify))
+	// vote in lower and higher view
+	lower := &hotstuff.View{Round: big.NewInt(2), Height: big.NewInt(2)}
+	assert.Equal(t, hotstuff.ErrDoubleVote, restored.CheckVote(MsgTypeVote, lower, common.HexToHash("0x03"), justify))
+	higher := &hotstuff.View{Round: big.NewInt(4), Height: big.NewInt(2)}
+	assert.NoError(t, restored.CheckVote(MsgTypeVote, higher, common.HexToHash("0x03"), justify))
+	// vote for another proposal at the locked height
+	locked := &hotstuff.View{Round: big.NewInt(4), Height: big.NewInt(1)}
+	assert.Equal(t, hotstuff.ErrLockedVote, restored.CheckVote(MsgTypeVote, locked, common.HexToHash("0x03"), makeQC(0, 1, common.HexToHash("0x00"))))
+	// vote for proposal conflicts with the locked one, until it's unlocked by a higher quorum cert
+	conflict := makeQC(1, 1, common.HexToHash("0x05"))
+	assert.Equal(t, hotstuff.ErrLockedVote, restored.CheckVote(MsgTypeVote, higher, common.HexToHash("0x03"), conflict))
+	assert.Equal(t, hotstuff.ErrLockedVote, restored.CheckVote(MsgTypeVote, higher, common.HexToHash("0x03"), nil))
+	unlock := makeQC(1, 3, common.HexToHash("0x05"))
+	assert.NoError(t, restored.CheckVote(MsgTypeVote, higher, common.HexToHash("0x03"), unlock))
+}
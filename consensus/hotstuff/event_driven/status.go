@@ -0,0 +1,80 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// statusTimeout is the max duration waiting for event loop to take a snapshot of current view
+const statusTimeout = time.Second
+
+// Status implements hotstuff.CoreEngine.Status, view state is only accessed in the event loop.
+func (c *core) Status() *hotstuff.CoreStatus {
+	if !c.isRunning {
+		return nil
+	}
+	ch := make(chan *hotstuff.CoreStatus, 1)
+	select {
+	case c.statusCh <- ch:
+		return <-ch
+	case <-time.After(statusTimeout):
+		return nil
+	}
+}
+
+// SubscribeRoundChange implements hotstuff.CoreEngine.SubscribeRoundChange
+func (c *core) SubscribeRoundChange(ch chan<- hotstuff.RoundChangeEvent) event.Subscription {
+	return c.roundFeed.Subscribe(ch)
+}
+
+func (c *core) status() *hotstuff.CoreStatus {
+	if c.view == nil {
+		return nil
+	}
+
+	state := "WaitingProposal"
+	if c.certified {
+		state = "Certified"
+	} else if c.proposal != nil {
+		state = "Voted"
+	}
+	votes := make(map[common.Address]int)
+	for _, msg := range append(c.votes.Values(), c.timeouts.Values()...) {
+		votes[msg.Address] += 1
+	}
+
+	status := &hotstuff.CoreStatus{
+		View:      c.currentView(),
+		State:     state,
+		LockedQC:  c.lockQC,
+		PrepareQC: c.highQC,
+		Requests:  c.requests.Size(),
+		Backlogs:  c.backlogs.Sizes(),
+		Votes:     votes,
+	}
+	if proposer := c.valSet.GetProposer(); proposer != nil {
+		status.Proposer = proposer.Address()
+	}
+	return status
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/message_set"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus(t *testing.T) {
+	addrs := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02"), common.HexToAddress("0x03"), common.HexToAddress("0x04")}
+	c := newTestCore()
+	c.requests = newRequestSet()
+	c.backlogs = newBackLog()
+	c.valSet = validator.NewSet(addrs, hotstuff.RoundRobin)
+	c.valSet.CalcProposer(common.Address{}, 1)
+	c.votes = message_set.NewMessageSet(c.valSet)
+	c.timeouts = message_set.NewMessageSet(c.valSet)
+	c.lockQC = makeQC(0, 0, common.Hash{})
+
+	vote := &hotstuff.Message{Code: MsgTypeVote, View: c.currentView(), Address: addrs[1]}
+	assert.NoError(t, c.votes.Add(vote))
+	timeout := &hotstuff.Message{Code: MsgTypeTimeout, View: c.currentView(), Address: addrs[1]}
+	assert.NoError(t, c.timeouts.Add(timeout))
+	c.backlogs.Push(&hotstuff.Message{Code: MsgTypeVote, View: &hotstuff.View{Round: big.NewInt(5), Height: big.NewInt(3)}, Address: addrs[2]})
+
+	status := c.status()
+	assert.Equal(t, 0, status.View.Cmp(c.view))
+	assert.Equal(t, "WaitingProposal", status.State)
+	assert.Equal(t, c.valSet.GetProposer().Address(), status.Proposer)
+	assert.Equal(t, c.highQC, status.PrepareQC)
+	assert.Equal(t, c.lockQC, status.LockedQC)
+	assert.Equal(t, 2, status.Votes[addrs[1]])
+	assert.Equal(t, 1, status.Backlogs[addrs[2]])
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// sendTimeout send the highest quorum cert to the leader of the new round.
+func (c *core) sendTimeout() {
+	logger := c.newLogger()
+
+	msgTyp := MsgTypeTimeout
+	msg := &MsgTimeout{
+		View:   c.currentView(),
+		HighQC: c.highQC,
+	}
+	payload, err := Encode(msg)
+	if err != nil {
+		logger.Trace("Failed to encode", "msg", msgTyp, "err", err)
+		return
+	}
+	c.unicast(c.valSet, &hotstuff.Message{Code: msgTyp, View: msg.View, Msg: payload})
+	logger.Trace("sendTimeout", "view", msg.View, "highQC", msg.HighQC.Hash)
+}
+
+func (c *core) handleTimeout(data *hotstuff.Message, src hotstuff.Validator) error {
+	logger := c.newLogger()
+
+	var (
+		msg    *MsgTimeout
+		msgTyp = MsgTypeTimeout
+	)
+	if err := data.Decode(&msg); err != nil {
+		logger.Trace("Failed to decode", "msg", msgTyp, "err", err)
+		return errFailedDecodeTimeout
+	}
+	if msg.View == nil || msg.HighQC == nil || msg.HighQC.View == nil {
+		return errInvalidMessage
+	}
+
+	// the sender knows a higher certified block, catch up with it.
+	if msg.HighQC.Height().Cmp(c.highQC.Height()) > 0 {
+		if err := c.verifyQC(msg.HighQC); err != nil {
+			logger.Trace("Failed to verify highQC", "msg", msgTyp, "err", err)
+			return errInvalidQC
+		}
+		c.certifyProposal(msg.HighQC)
+		c.advanceHighQC(msg.HighQC)
+	}
+
+	if err := c.checkView(msg.View); err != nil {
+		logger.Trace("Failed to check view", "msg", msgTyp, "err", err)
+		return err
+	}
+	if !c.IsProposer() {
+		logger.Trace("Failed to check proposer", "msg", msgTyp, "err", errNotToProposer)
+		return errNotToProposer
+	}
+	if err := c.timeouts.Add(data); err != nil {
+		logger.Trace("Failed to add timeout", "msg", msgTyp, "err", err)
+		return errAddTimeout
+	}
+
+	logger.Trace("handleTimeout", "msg", msgTyp, "src", src.Address(), "size", c.timeouts.Size())
+
+	if c.timeouts.Size() >= c.Q() {
+		c.sendProposal()
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+type MsgType uint64
+
+const (
+	MsgTypeProposal MsgType = 1
+	MsgTypeVote     MsgType = 2
+	MsgTypeTimeout  MsgType = 3
+)
+
+func (m MsgType) String() string {
+	switch m {
+	case MsgTypeProposal:
+		return "PROPOSAL"
+	case MsgTypeVote:
+		return "VOTE"
+	case MsgTypeTimeout:
+		return "TIMEOUT"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func (m MsgType) Value() uint64 {
+	return uint64(m)
+}
+
+// MsgProposal is broadcast by the leader of view, the proposal always extends the block certified by `JustifyQC`,
+// which is the generic quorum cert of the pipeline and the only QC carried by a proposal.
+type MsgProposal struct {
+	View      *hotstuff.View
+	Proposal  hotstuff.Proposal
+	JustifyQC *hotstuff.QuorumCert
+}
+
+func (m *MsgProposal) EncodeRLP(w io.Writer) error {
+	block, ok := m.Proposal.(*types.Block)
+	if !ok {
+		return errInvalidProposal
+	}
+	return rlp.Encode(w, []interface{}{m.View, block, m.JustifyQC})
+}
+
+func (m *MsgProposal) DecodeRLP(s *rlp.Stream) error {
+	var proposal struct {
+		View      *hotstuff.View
+		Proposal  *types.Block
+		JustifyQC *hotstuff.QuorumCert
+	}
+
+	if err := s.Decode(&proposal); err != nil {
+		return err
+	}
+	m.View, m.Proposal, m.JustifyQC = proposal.View, proposal.Proposal, proposal.JustifyQC
+	return nil
+}
+
+func (m *MsgProposal) String() string {
+	return fmt.Sprintf("{MsgProposal Height: %d Round: %d Hash: %s}", m.View.Height, m.View.Round, m.Proposal.Hash())
+}
+
+// Vote is sent to the leader of next height, the committed seal is carried in the message.
+type Vote struct {
+	View   *hotstuff.View
+	Digest common.Hash // proposal hash
+}
+
+// EncodeRLP serializes b into the Ethereum RLP format.
+func (b *Vote) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{b.View, b.Digest})
+}
+
+// DecodeRLP implements rlp.Decoder, and load the consensus fields from a RLP stream.
+func (b *Vote) DecodeRLP(s *rlp.Stream) error {
+	var subject struct {
+		View   *hotstuff.View
+		Digest common.Hash
+	}
+
+	if err := s.Decode(&subject); err != nil {
+		return err
+	}
+	b.View, b.Digest = subject.View, subject.Digest
+	return nil
+}
+
+func (b *Vote) String() string {
+	return fmt.Sprintf("{View: %v, Digest: %v}", b.View, b.Digest.String())
+}
+
+// MsgTimeout is sent to the leader of the new view after the pacemaker timer expired, it carries the sender's
+// highest quorum cert so that the new leader is able to extend the highest certified block.
+type MsgTimeout struct {
+	View   *hotstuff.View
+	HighQC *hotstuff.QuorumCert
+}
+
+func (m *MsgTimeout) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.View, m.HighQC})
+}
+
+func (m *MsgTimeout) DecodeRLP(s *rlp.Stream) error {
+	var timeout struct {
+		View   *hotstuff.View
+		HighQC *hotstuff.QuorumCert
+	}
+
+	if err := s.Decode(&timeout); err != nil {
+		return err
+	}
+	m.View, m.HighQC = timeout.View, timeout.HighQC
+	return nil
+}
+
+func (m *MsgTimeout) String() string {
+	return fmt.Sprintf("{MsgTimeout Height: %d Round: %d}", m.View.Height, m.View.Round)
+}
+
+type timeoutEvent struct{}
+type backlogEvent struct {
+	src hotstuff.Validator
+	msg *hotstuff.Message
+}
+
+// proposalDelayEvent fires once the timestamp of the pending proposal in given view is reached.
+type proposalDelayEvent struct {
+	view *hotstuff.View
+}
+
+func Encode(val interface{}) ([]byte, error) {
+	return rlp.EncodeToBytes(val)
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	hotstuff.RegisterMsgTypeConvertHandler(func(data interface{}) hotstuff.MsgType {
+		return MsgType(data.(uint64))
+	})
+}
+
+func makeBlock(number int64, parent common.Hash) *types.Block {
+	header := &types.Header{
+		ParentHash: parent,
+		Difficulty: big.NewInt(0),
+		Number:     big.NewInt(number),
+		GasLimit:   0,
+		GasUsed:    0,
+		Time:       0,
+	}
+	return types.NewBlockWithHeader(header)
+}
+
+func makeQC(height, round int64, hash common.Hash) *hotstuff.QuorumCert {
+	return &hotstuff.QuorumCert{
+		View: &hotstuff.View{
+			Round:  big.NewInt(round),
+			Height: big.NewInt(height),
+		},
+		Hash: hash,
+	}
+}
+
+func TestMsgProposal(t *testing.T) {
+	parent := makeBlock(1, common.Hash{})
+	proposal := &MsgProposal{
+		View:      &hotstuff.View{Round: big.NewInt(3), Height: big.NewInt(2)},
+		Proposal:  makeBlock(2, parent.Hash()),
+		JustifyQC: makeQC(1, 2, parent.Hash()),
+	}
+	payload, err := Encode(proposal)
+	assert.NoError(t, err)
+
+	msg := &hotstuff.Message{
+		Code:    MsgTypeProposal,
+		View:    proposal.View,
+		Msg:     payload,
+		Address: common.HexToAddress("0x1"),
+	}
+	msgPayload, err := msg.Payload()
+	assert.NoError(t, err)
+
+	var decoded *MsgProposal
+	decodedMsg := new(hotstuff.Message)
+	assert.NoError(t, decodedMsg.FromPayload(msgPayload, nil))
+	assert.Equal(t, MsgTypeProposal, decodedMsg.Code)
+	assert.NoError(t, decodedMsg.Decode(&decoded))
+	assert.Equal(t, proposal.Proposal.Hash(), decoded.Proposal.Hash())
+	assert.Equal(t, 0, proposal.View.Cmp(decoded.View))
+	assert.Equal(t, proposal.JustifyQC.Hash, decoded.JustifyQC.Hash)
+	assert.Equal(t, 0, proposal.JustifyQC.View.Cmp(decoded.JustifyQC.View))
+}
+
+func TestVoteAndTimeout(t *testing.T) {
+	view := &hotstuff.View{Round: big.NewInt(5), Height: big.NewInt(4)}
+
+	vote := &Vote{View: view, Digest: common.HexToHash("0x123")}
+	payload, err := Encode(vote)
+	assert.NoError(t, err)
+	var decodedVote *Vote
+	assert.NoError(t, (&hotstuff.Message{Msg: payload}).Decode(&decodedVote))
+	assert.Equal(t, vote.Digest, decodedVote.Digest)
+	assert.Equal(t, 0, view.Cmp(decodedVote.View))
+
+	timeout := &MsgTimeout{View: view, HighQC: makeQC(3, 2, common.HexToHash("0x456"))}
+	payload, err = Encode(timeout)
+	assert.NoError(t, err)
+	var decodedTimeout *MsgTimeout
+	assert.NoError(t, (&hotstuff.Message{Msg: payload}).Decode(&decodedTimeout))
+	assert.Equal(t, timeout.HighQC.Hash, decodedTimeout.HighQC.Hash)
+	assert.Equal(t, 0, view.Cmp(decodedTimeout.View))
+}
@@ -0,0 +1,223 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// checkView checks the Message view, round number is global and increases monotonically, so that messages
+// are compared with round first. messages of future round will be stored in backlog, and messages lower than
+// current round will be dropped.
+func (c *core) checkView(view *hotstuff.View) error {
+	if view == nil || view.Height == nil || view.Round == nil {
+		return errInvalidMessage
+	}
+
+	if hdiff := new(big.Int).Sub(view.Height, c.view.Height); hdiff.Cmp(common.Big1) > 0 {
+		return errFarAwayFutureMessage
+	} else if r := view.Round.Cmp(c.view.Round); r < 0 {
+		return errOldMessage
+	} else if r > 0 {
+		return errFutureMessage
+	} else if hdiff.Sign() != 0 {
+		return errInvalidMessage
+	}
+	return nil
+}
+
+// verifyQC verify quorum certificate with the validator set of the certified block's height
+func (c *core) verifyQC(qc *hotstuff.QuorumCert) error {
+	valSet := c.backend.Validators(qc.HeightU64())
+	return c.signer.VerifyQC(qc, valSet)
+}
+
+// roundLeaderSet returns a validator set of height whose proposer is the leader of round, vrf policy elects
+// the leader with the random output of parent block as well.
+func (c *core) roundLeaderSet(height, round *big.Int, parent common.Hash) hotstuff.ValidatorSet {
+	valSet := c.backend.Validators(height.Uint64())
+	if valSet.Policy() == hotstuff.VRF {
+		valSet.SetRandomSeed(c.vrfSeed(parent, height.Uint64()-1))
+	}
+	valSet.CalcProposer(common.Address{}, round.Uint64())
+	return valSet
+}
+
+// nextLeaderSet returns a validator set whose proposer is the leader of next round, it collects votes
+// of current proposal.
+func (c *core) nextLeaderSet() hotstuff.ValidatorSet {
+	height := new(big.Int).Add(c.view.Height, common.Big1)
+	round := new(big.Int).Add(c.view.Round, common.Big1)
+	return c.roundLeaderSet(height, round, c.proposal.Hash())
+}
+
+// vrfSeed returns the verifiable random output carried in block's extra salt, it will be nil if the block
+// is unknown, genesis or not sealed with vrf.
+func (c *core) vrfSeed(hash common.Hash, number uint64) []byte {
+	var header *types.Header
+	if block, ok := c.proposal.(*types.Block); ok && block.Hash() == hash {
+		header = block.Header()
+	} else if block, ok := c.blocks[hash].(*types.Block); ok {
+		header = block.Header()
+	} else if block, ok := c.proposals[hash].(*types.Block); ok {
+		header = block.Header()
+	} else if c.chain != nil {
+		header = c.chain.GetHeader(hash, number)
+	}
+	if header == nil {
+		return nil
+	}
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil
+	}
+	value, _ := extra.VRF()
+	return value
+}
+
+func (c *core) finalizeMessage(msg *hotstuff.Message) ([]byte, error) {
+	var err error
+
+	// Add sender address
+	msg.Address = c.Address()
+	if msg.View == nil {
+		msg.View = c.currentView()
+	}
+
+	// Add proof of consensus
+	if msg.Code == MsgTypeVote && c.proposal != nil {
+		var seal []byte
+		if c.isAggregateSeal(c.proposal) {
+			seal, err = c.signer.SignAggregatableHash(c.proposal.Hash())
+		} else {
+			seal, err = c.signer.SignHash(c.proposal.Hash())
+		}
+		if err != nil {
+			return nil, err
+		}
+		msg.CommittedSeal = seal
+	}
+
+	// Sign Message
+	data, err := msg.PayloadToSign(c.config.ChainID, hotstuff.MsgTagEventDriven)
+	if err != nil {
+		return nil, err
+	}
+	msg.Signature, err = c.signer.Sign(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to payload
+	payload, err := msg.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// isAggregateSeal returns true if the committed seals of proposal should be aggregated
+func (c *core) isAggregateSeal(proposal hotstuff.Proposal) bool {
+	return c.config.HotStuffConfig.IsAggregateSeal(proposal.Number())
+}
+
+func (c *core) broadcast(msg *hotstuff.Message) {
+	logger := c.logger.New("view", c.view)
+
+	payload, err := c.finalizeMessage(msg)
+	if err != nil {
+		logger.Error("Failed to finalize Message", "msg", msg, "err", err)
+		return
+	}
+	if err := c.backend.Broadcast(c.valSet, payload); err != nil {
+		logger.Error("Failed to broadcast Message", "msg", msg, "err", err)
+	}
+}
+
+// unicast send message to the proposer of valSet
+func (c *core) unicast(valSet hotstuff.ValidatorSet, msg *hotstuff.Message) {
+	logger := c.logger.New("view", c.view)
+
+	payload, err := c.finalizeMessage(msg)
+	if err != nil {
+		logger.Error("Failed to finalize Message", "msg", msg, "err", err)
+		return
+	}
+	if err := c.backend.Unicast(valSet, payload); err != nil {
+		logger.Error("Failed to unicast Message", "msg", msg, "err", err)
+	}
+}
+
+// checkValidatorSignature recover the signer of message which signed with chain id and protocol tag,
+// messages of next height may come from the validators of next epoch.
+func (c *core) checkValidatorSignature(payload []byte, sig []byte) (common.Address, error) {
+	data, err := hotstuff.SigningPayload(c.config.ChainID, hotstuff.MsgTagEventDriven, payload)
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr, err := c.signer.CheckSignature(c.valSet, data, sig)
+	if err == nil {
+		return addr, nil
+	}
+	return c.signer.CheckSignature(c.backend.Validators(c.view.Height.Uint64()+1), data, sig)
+}
+
+func (c *core) getValidator(addr common.Address) hotstuff.Validator {
+	if _, val := c.valSet.GetByAddress(addr); val != nil {
+		return val
+	}
+	_, val := c.backend.Validators(c.view.Height.Uint64() + 1).GetByAddress(addr)
+	return val
+}
+
+func (c *core) preExecuteBlock(proposal hotstuff.Proposal) error {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return errInvalidProposal
+	}
+	return c.backend.ValidateBlock(block)
+}
+
+func (c *core) newLogger() log.Logger {
+	logger := c.logger.New("view", c.view, "highQC", c.highQC.HeightU64())
+	return logger
+}
+
+func proposal2QC(proposal hotstuff.Proposal, round *big.Int) *hotstuff.QuorumCert {
+	block := proposal.(*types.Block)
+	return header2QC(block.Header(), round)
+}
+
+func header2QC(h *types.Header, round *big.Int) *hotstuff.QuorumCert {
+	qc := new(hotstuff.QuorumCert)
+	qc.View = &hotstuff.View{
+		Height: new(big.Int).Set(h.Number),
+		Round:  new(big.Int).Set(round),
+	}
+	qc.Hash = h.Hash()
+	qc.Proposer = h.Coinbase
+	qc.Extra = h.Extra
+	return qc
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package event_driven
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// sendVote send vote with committed seal to the leader of next height, who is the only validator
+// able to assemble the quorum cert.
+func (c *core) sendVote() {
+	logger := c.newLogger()
+
+	msgTyp := MsgTypeVote
+	vote := &Vote{
+		View:   c.currentView(),
+		Digest: c.proposal.Hash(),
+	}
+	if err := c.persistVote(msgTyp, vote); err != nil {
+		logger.Warn("Failed to persist vote", "msg", msgTyp, "err", err)
+		return
+	}
+	payload, err := Encode(vote)
+	if err != nil {
+		logger.Trace("Failed to encode", "msg", msgTyp, "err", err)
+		return
+	}
+	c.unicast(c.nextLeaderSet(), &hotstuff.Message{Code: msgTyp, View: vote.View, Msg: payload})
+	logger.Trace("sendVote", "vote view", vote.View, "vote", vote.Digest)
+}
+
+func (c *core) handleVote(data *hotstuff.Message, src hotstuff.Validator) error {
+	logger := c.newLogger()
+
+	var (
+		vote   *Vote
+		msgTyp = MsgTypeVote
+	)
+	if err := data.Decode(&vote); err != nil {
+		logger.Trace("Failed to decode", "msg", msgTyp, "err", err)
+		return errFailedDecodeVote
+	}
+	if err := c.checkView(vote.View); err != nil {
+		// the vote of highQC arrives after the view changed, keep its seal
+		if err == errOldMessage && c.highQC != nil && vote.Digest == c.highQC.Hash {
+			c.backend.CollectSeal(c.highQC.Height().Uint64(), vote.Digest, src.Address(), data.CommittedSeal)
+		}
+		logger.Trace("Failed to check view", "msg", msgTyp, "err", err)
+		return err
+	}
+	// votes may arrive earlier than the proposal
+	if c.proposal == nil {
+		return errFutureMessage
+	}
+	if vote.Digest != c.proposal.Hash() {
+		logger.Trace("Failed to check hash", "msg", msgTyp, "expect vote", c.proposal.Hash(), "got", vote.Digest)
+		return errInvalidDigest
+	}
+	if !c.nextLeaderSet().IsProposer(c.Address()) {
+		logger.Trace("Failed to check proposer", "msg", msgTyp, "err", errNotToProposer)
+		return errNotToProposer
+	}
+	if c.isAggregateSeal(c.proposal) {
+		if err := c.signer.VerifyAggregatableHash(src.Address(), vote.Digest, data.CommittedSeal); err != nil {
+			logger.Trace("Failed to verify committed seal", "msg", msgTyp, "err", err)
+			return errInvalidCommittedSeal
+		}
+	} else if err := c.signer.VerifyHash(c.valSet, vote.Digest, data.CommittedSeal); err != nil {
+		logger.Trace("Failed to verify committed seal", "msg", msgTyp, "err", err)
+		return errInvalidCommittedSeal
+	}
+	if err := c.votes.Add(data); err != nil {
+		logger.Trace("Failed to add vote", "msg", msgTyp, "err", err)
+		return errAddVote
+	}
+
+	logger.Trace("handleVote", "msg", msgTyp, "src", src.Address(), "hash", vote.Digest)
+
+	if size := c.votes.Size(); size >= c.Q() && !c.certified {
+		committers, seals := c.getMessageSeals(size)
+		certified, err := c.backend.PreCommit(c.proposal, committers, seals)
+		if err != nil {
+			logger.Trace("Failed to assemble committed seal", "err", err)
+			return err
+		}
+		qc := proposal2QC(certified, c.view.Round)
+		logger.Trace("acceptQC", "msg", msgTyp, "hash", certified.Hash(), "msgSize", size)
+
+		if err := c.certify(certified); err != nil {
+			logger.Trace("Failed to certify proposal", "err", err)
+			return err
+		}
+		c.advanceHighQC(qc)
+	} else if c.certified {
+		// the proposal has been sealed with a quorum, keep the seal of late vote
+		c.backend.CollectSeal(c.proposal.Number().Uint64(), vote.Digest, src.Address(), data.CommittedSeal)
+	}
+	return nil
+}
+
+// certifyProposal assembles the proposal certified by qc with the committed seals, and delivers it to backend
+// so that the proposal extends it can be executed. the proposal which was not voted locally is executed first.
+func (c *core) certifyProposal(qc *hotstuff.QuorumCert) {
+	if _, ok := c.blocks[qc.Hash]; ok {
+		return
+	}
+	proposal := c.proposal
+	if proposal == nil || proposal.Hash() != qc.Hash {
+		if proposal = c.proposals[qc.Hash]; proposal == nil || !c.hasParent(proposal) {
+			return
+		}
+		if err := c.preExecuteBlock(proposal); err != nil {
+			c.logger.Trace("Failed to pre-execute block", "err", err)
+			return
+		}
+	}
+	certified, err := c.backend.ForwardCommit(proposal, qc.Extra)
+	if err != nil {
+		c.logger.Trace("Failed to forward commit", "err", err)
+		return
+	}
+	if err := c.certify(certified); err != nil {
+		c.logger.Trace("Failed to certify proposal", "err", err)
+	}
+}
+
+// certify keeps the certified proposal until it is committed by the three-chain rule.
+func (c *core) certify(certified hotstuff.Proposal) error {
+	if err := c.backend.Certify(certified); err != nil {
+		return err
+	}
+	if c.proposal != nil && c.proposal.Hash() == certified.Hash() {
+		c.certified = true
+	}
+	c.blocks[certified.Hash()] = certified
+	return nil
+}
+
+func (c *core) getMessageSeals(n int) ([]common.Address, [][]byte) {
+	committers := make([]common.Address, 0, n)
+	seals := make([][]byte, 0, n)
+	for _, data := range c.votes.Values() {
+		if len(seals) < n {
+			committers = append(committers, data.Address)
+			seals = append(seals, data.CommittedSeal)
+		}
+	}
+	return committers, seals
+}
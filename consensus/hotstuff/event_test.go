@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundChangeFeed(t *testing.T) {
+	var feed RoundChangeFeed
+	slow := make(chan RoundChangeEvent)
+	fast := make(chan RoundChangeEvent, 100)
+	slowSub := feed.Subscribe(slow)
+	fastSub := feed.Subscribe(fast)
+	defer fastSub.Unsubscribe()
+
+	// the subscriber never reading events doesn't block the sender
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			feed.Send(RoundChangeEvent{View: &View{Height: big.NewInt(1), Round: big.NewInt(int64(i))}})
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("sender blocked by slow subscriber")
+	}
+	slowSub.Unsubscribe()
+
+	// events are delivered in order to the subscriber which keeps up with them
+	for i := 0; i < 100; i++ {
+		select {
+		case ev := <-fast:
+			assert.Equal(t, int64(i), ev.View.Round.Int64())
+		case <-time.After(time.Second):
+			t.Fatalf("missing event %d", i)
+		}
+	}
+}
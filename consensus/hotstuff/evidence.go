@@ -0,0 +1,158 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// ErrInvalidEvidence is returned if the evidence can not be decoded or the signature is invalid.
+	ErrInvalidEvidence = errors.New("invalid evidence")
+	// ErrNotEquivocation is returned if the two messages in evidence are not conflict with each other.
+	ErrNotEquivocation = errors.New("messages are not conflict")
+)
+
+// Message tags of the consensus protocols, the message types of basic and event-driven hotstuff overlap
+// with each other, so the tag is signed with message and added to message type in evidence.
+const (
+	MsgTagBasic       uint64 = 0
+	MsgTagEventDriven uint64 = 0x100
+)
+
+// unconflictableMsgs are tagged message types carrying the sender's local quorum cert which may be changed
+// in the same view, different messages of them are not an equivocation. they are new view of basic hotstuff
+// and timeout of event-driven hotstuff.
+var unconflictableMsgs = map[uint64]struct{}{
+	MsgTagBasic + 1:       {},
+	MsgTagEventDriven + 3: {},
+}
+
+// Evidence packages two conflicting signed messages which sent by the same validator with the same
+// message type and view. both of the message are encoded with signature so that anyone is able to
+// verify the evidence without the consensus engine.
+type Evidence struct {
+	Tag    uint64 // tag of the consensus protocol which the messages belong to
+	First  []byte
+	Second []byte
+}
+
+// NewEvidence packages the signed messages of protocol tagged by `tag` into evidence.
+func NewEvidence(tag uint64, first, second *Message) (*Evidence, error) {
+	enc1, err := first.Payload()
+	if err != nil {
+		return nil, err
+	}
+	enc2, err := second.Payload()
+	if err != nil {
+		return nil, err
+	}
+	return &Evidence{Tag: tag, First: enc1, Second: enc2}, nil
+}
+
+// IsEquivocation returns true if the two messages are sent by the same validator with the same
+// message type and view but different content.
+func IsEquivocation(first, second *Message) bool {
+	if first == nil || second == nil || first.View == nil || second.View == nil {
+		return false
+	}
+	return first.Address == second.Address &&
+		first.Code.Value() == second.Code.Value() &&
+		first.View.Cmp(second.View) == 0 &&
+		!bytes.Equal(first.Msg, second.Msg)
+}
+
+func (e *Evidence) Hash() common.Hash {
+	return RLPHash(e)
+}
+
+// Verify checks signatures of the two messages signed in chain `chainID`, and returns the offender, the view
+// and the tagged message type in which the offender sent conflicting messages.
+func (e *Evidence) Verify(chainID *big.Int) (common.Address, *View, uint64, error) {
+	if e.Tag != MsgTagBasic && e.Tag != MsgTagEventDriven {
+		return common.Address{}, nil, 0, ErrInvalidEvidence
+	}
+	first, err := decodeSignedMessage(chainID, e.Tag, e.First)
+	if err != nil {
+		return common.Address{}, nil, 0, err
+	}
+	second, err := decodeSignedMessage(chainID, e.Tag, e.Second)
+	if err != nil {
+		return common.Address{}, nil, 0, err
+	}
+
+	if first.Code >= MsgTagEventDriven || first.Address != second.Address || first.Code != second.Code ||
+		first.View.Cmp(second.View) != 0 || bytes.Equal(first.Msg, second.Msg) {
+		return common.Address{}, nil, 0, ErrNotEquivocation
+	}
+	code := e.Tag + first.Code
+	if _, ok := unconflictableMsgs[code]; ok {
+		return common.Address{}, nil, 0, ErrNotEquivocation
+	}
+	return first.Address, first.View, code, nil
+}
+
+func (e *Evidence) String() string {
+	return fmt.Sprintf("{Evidence Hash: %v}", e.Hash().Hex())
+}
+
+type signedMessage struct {
+	Code          uint64
+	View          *View
+	Msg           []byte
+	Address       common.Address
+	Signature     []byte
+	CommittedSeal []byte
+}
+
+// decodeSignedMessage decodes message without the message type converter which registered by
+// consensus core, and recovers the signer of message.
+func decodeSignedMessage(chainID *big.Int, tag uint64, payload []byte) (*signedMessage, error) {
+	msg := new(signedMessage)
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	if msg.View == nil || msg.View.Height == nil || msg.View.Round == nil {
+		return nil, ErrInvalidEvidence
+	}
+
+	// same as `Message.PayloadToSign`
+	data, err := rlp.EncodeToBytes([]interface{}{msg.Code, msg.View, msg.Msg, msg.Address, []byte{}, []byte{}})
+	if err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	if data, err = SigningPayload(chainID, tag, data); err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	pubkey, err := crypto.SigToPub(crypto.Keccak256(data), msg.Signature)
+	if err != nil {
+		return nil, ErrInvalidEvidence
+	}
+	if crypto.PubkeyToAddress(*pubkey) != msg.Address {
+		return nil, ErrInvalidEvidence
+	}
+	return msg, nil
+}
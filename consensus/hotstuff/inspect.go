@@ -0,0 +1,55 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockSigners is decoded from the extra of a committed block header, it describes who proposed the block
+// and which validators signed it.
+type BlockSigners struct {
+	Number           hexutil.Uint64   `json:"number"`
+	Hash             common.Hash      `json:"hash"`
+	Proposer         common.Address   `json:"proposer"`
+	Committers       []common.Address `json:"committers"`
+	Aggregated       bool             `json:"aggregated"` // committed seals are aggregated into BLS signature
+	EpochStartHeight hexutil.Uint64   `json:"epochStartHeight"`
+	Validators       []common.Address `json:"validators"`
+
+	// Round is not stored in header, it is recorded by the validator which committed the block, and nil if
+	// the block is synchronized from the others.
+	Round *hexutil.Uint64 `json:"round,omitempty"`
+}
+
+// Participation counts the blocks proposed and signed by a validator.
+type Participation struct {
+	Address  common.Address `json:"address"`
+	Blocks   hexutil.Uint64 `json:"blocks"` // number of blocks in which the address is validator
+	Proposed hexutil.Uint64 `json:"proposed"`
+	Signed   hexutil.Uint64 `json:"signed"`
+}
+
+// ParticipationStats is the participation of all validators over a block range.
+type ParticipationStats struct {
+	From       hexutil.Uint64   `json:"from"`
+	To         hexutil.Uint64   `json:"to"`
+	Validators []*Participation `json:"validators"`
+}
@@ -0,0 +1,186 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type PacemakerPolicy uint64
+
+const (
+	// ExponentialPacemaker waits `RequestTimeout + 2^n` seconds after n consecutive failed rounds
+	ExponentialPacemaker PacemakerPolicy = iota
+	// AdaptivePacemaker derives the round timeout from the latencies of recent successful rounds,
+	// and doubles it after each consecutive failed round.
+	AdaptivePacemaker
+)
+
+var pacemakerPolicyNames = map[PacemakerPolicy]string{
+	ExponentialPacemaker: "exponential",
+	AdaptivePacemaker:    "adaptive",
+}
+
+func (p PacemakerPolicy) String() string {
+	if name, ok := pacemakerPolicyNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint64(p))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p PacemakerPolicy) MarshalText() ([]byte, error) {
+	if _, ok := pacemakerPolicyNames[p]; !ok {
+		return nil, fmt.Errorf("unknown pacemaker %d", uint64(p))
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PacemakerPolicy) UnmarshalText(text []byte) error {
+	for policy, name := range pacemakerPolicyNames {
+		if name == string(text) {
+			*p = policy
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown pacemaker %q", text)
+}
+
+const (
+	maxBackoffExponent   = 30                     // avoid duration overflow after too many failed rounds
+	minAdaptiveTimeout   = 500 * time.Millisecond // lower bound of adaptive timeout, protects against noisy samples
+	adaptiveLatencyGain  = 8                      // smoothed latency weight 1/8, same as tcp srtt
+	adaptiveVarianceGain = 4                      // latency deviation weight 1/4, same as tcp rttvar
+	adaptiveVarianceMul  = 4                      // timeout = srtt + 4 * rttvar
+)
+
+// Pacemaker decides how long a validator stays in a round before it gives up and moves to the next one.
+type Pacemaker interface {
+	// Timeout returns the duration of round after given number of consecutive failed rounds
+	Timeout(failures uint64) time.Duration
+
+	// Observe reports how long a successful round took to go through all of its phases
+	Observe(latency time.Duration)
+}
+
+// NewPacemaker creates the pacemaker strategy selected by config.
+func NewPacemaker(config *Config) Pacemaker {
+	initial := time.Duration(config.RequestTimeout) * time.Millisecond
+	max := time.Duration(config.MaxRoundTimeout) * time.Millisecond
+
+	switch config.Pacemaker {
+	case AdaptivePacemaker:
+		return newAdaptivePacemaker(initial, max)
+	default:
+		return newExponentialPacemaker(initial, max)
+	}
+}
+
+// capTimeout limits timeout to max, zero max means no limit.
+func capTimeout(timeout, max time.Duration) time.Duration {
+	if max > 0 && timeout > max {
+		return max
+	}
+	return timeout
+}
+
+type exponentialPacemaker struct {
+	base time.Duration
+	max  time.Duration
+}
+
+func newExponentialPacemaker(base, max time.Duration) *exponentialPacemaker {
+	return &exponentialPacemaker{base: base, max: max}
+}
+
+func (p *exponentialPacemaker) Timeout(failures uint64) time.Duration {
+	timeout := p.base
+	if failures > 0 {
+		if failures > maxBackoffExponent {
+			failures = maxBackoffExponent
+		}
+		timeout += time.Duration(1<<failures) * time.Second
+	}
+	return capTimeout(timeout, p.max)
+}
+
+func (p *exponentialPacemaker) Observe(time.Duration) {}
+
+// adaptivePacemaker estimates the round latency with the smoothed mean and mean deviation of observed
+// samples, the same way tcp computes its retransmission timeout. It starts with `RequestTimeout` before
+// any round succeeded, so that fresh nodes behave exactly like the exponential pacemaker.
+type adaptivePacemaker struct {
+	mu sync.Mutex
+
+	initial time.Duration
+	max     time.Duration
+
+	srtt    time.Duration // smoothed round latency
+	rttvar  time.Duration // round latency mean deviation
+	samples uint64
+}
+
+func newAdaptivePacemaker(initial, max time.Duration) *adaptivePacemaker {
+	return &adaptivePacemaker{initial: initial, max: max}
+}
+
+func (p *adaptivePacemaker) Observe(latency time.Duration) {
+	if latency <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.samples == 0 {
+		p.srtt = latency
+		p.rttvar = latency / 2
+	} else {
+		delta := p.srtt - latency
+		if delta < 0 {
+			delta = -delta
+		}
+		p.rttvar += (delta - p.rttvar) / adaptiveVarianceGain
+		p.srtt += (latency - p.srtt) / adaptiveLatencyGain
+	}
+	p.samples++
+}
+
+func (p *adaptivePacemaker) Timeout(failures uint64) time.Duration {
+	p.mu.Lock()
+	timeout := p.initial
+	if p.samples > 0 {
+		timeout = p.srtt + adaptiveVarianceMul*p.rttvar
+		if timeout < minAdaptiveTimeout {
+			timeout = minAdaptiveTimeout
+		}
+	}
+	p.mu.Unlock()
+
+	for i := uint64(0); i < failures && i < maxBackoffExponent; i++ {
+		if p.max > 0 && timeout >= p.max {
+			break
+		}
+		timeout *= 2
+	}
+	return capTimeout(timeout, p.max)
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExponentialPacemaker(t *testing.T) {
+	p := NewPacemaker(&Config{RequestTimeout: 4000})
+	assert.Equal(t, 4*time.Second, p.Timeout(0))
+	assert.Equal(t, 6*time.Second, p.Timeout(1))
+	assert.Equal(t, 12*time.Second, p.Timeout(3))
+
+	// observed latency never changes exponential timeout
+	p.Observe(100 * time.Millisecond)
+	assert.Equal(t, 4*time.Second, p.Timeout(0))
+
+	// never overflow and respect the cap
+	assert.True(t, p.Timeout(1000) > 0)
+	p = NewPacemaker(&Config{RequestTimeout: 4000, MaxRoundTimeout: 10000})
+	assert.Equal(t, 6*time.Second, p.Timeout(1))
+	assert.Equal(t, 10*time.Second, p.Timeout(5))
+}
+
+func TestAdaptivePacemaker(t *testing.T) {
+	p := NewPacemaker(&Config{RequestTimeout: 4000, Pacemaker: AdaptivePacemaker, MaxRoundTimeout: 20000})
+
+	// no samples yet
+	assert.Equal(t, 4*time.Second, p.Timeout(0))
+	assert.Equal(t, 8*time.Second, p.Timeout(1))
+
+	// stable lan latency shrinks timeout down to the lower bound
+	for i := 0; i < 100; i++ {
+		p.Observe(50 * time.Millisecond)
+	}
+	assert.Equal(t, minAdaptiveTimeout, p.Timeout(0))
+	assert.Equal(t, 2*minAdaptiveTimeout, p.Timeout(1))
+
+	// wan latency grows timeout
+	for i := 0; i < 100; i++ {
+		p.Observe(3 * time.Second)
+	}
+	timeout := p.Timeout(0)
+	assert.True(t, timeout >= 3*time.Second && timeout < 4*time.Second, timeout)
+
+	// failed rounds back off until the cap
+	assert.Equal(t, 2*timeout, p.Timeout(1))
+	assert.Equal(t, 20*time.Second, p.Timeout(10))
+	assert.Equal(t, 20*time.Second, p.Timeout(1000))
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package hotstuff
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrDoubleVote is returned if validator is going to sign a vote conflicts with the persisted one.
+	ErrDoubleVote = errors.New("conflict with the last vote")
+	// ErrLockedVote is returned if validator is going to vote for a proposal conflicts with the locked one,
+	// which is not justified by a higher quorum cert.
+	ErrLockedVote = errors.New("conflict with the locked quorum cert")
+)
+
+// SafetyRules records the last vote and quorum certs of local validator, it should be persisted before
+// signing any vote so that a restarted validator never votes twice in the same view.
+type SafetyRules struct {
+	LastVoteView   *View
+	LastVoteCode   uint64      // message type of the last vote
+	LastVoteDigest common.Hash // proposal hash of the last vote
+	LockedQC       *QuorumCert `rlp:"nil"`
+	PrepareQC      *QuorumCert `rlp:"nil"`
+}
+
+// CheckVote checks the vote against the last one, a vote is safe if its view is higher than the last one,
+// or it votes for the same proposal with a later phase in the same view. and the proposal should be the
+// locked one, or extends it, or justified by a quorum cert of higher view which unlocks the validator.
+func (r *SafetyRules) CheckVote(code MsgType, view *View, digest common.Hash, justify *QuorumCert) error {
+	if r == nil || r.LastVoteView == nil {
+		return nil
+	}
+	if locked := r.LockedQC; locked != nil && locked.View != nil && digest != locked.Hash {
+		if justify == nil || justify.View == nil || (justify.Hash != locked.Hash && justify.View.Cmp(locked.View) <= 0) {
+			return ErrLockedVote
+		}
+	}
+	if c := view.Cmp(r.LastVoteView); c > 0 {
+		return nil
+	} else if c < 0 {
+		return ErrDoubleVote
+	}
+	if digest != r.LastVoteDigest || code.Value() < r.LastVoteCode {
+		return ErrDoubleVote
+	}
+	return nil
+}
+
+func (r *SafetyRules) String() string {
+	return fmt.Sprintf("{LastVote View: %v, Code: %d, Digest: %v, LockedQC: %v, PrepareQC: %v}",
+		r.LastVoteView, r.LastVoteCode, r.LastVoteDigest.Hex(), r.LockedQC, r.PrepareQC)
+}
@@ -47,6 +47,27 @@ type Signer interface {
 	// SealAfterCommit writes the extra-data field of a block header with given committed seals.
 	SealAfterCommit(h *types.Header, committedSeals [][]byte) error
 
+	// BLSPublicKey returns the public key of BLS key pair which used to sign aggregatable hash
+	BLSPublicKey() []byte
+
+	// BLSProof returns the proof of possession of BLS secret key, which is the signature of it's public key
+	BLSProof() []byte
+
+	// AddBLSPublicKey registers the BLS public key of validator published in node manager epoch, it
+	// returns an error if the proof of possession is invalid.
+	AddBLSPublicKey(addr common.Address, pubKey, proof []byte) error
+
+	// SignAggregatableHash returns an BLS signature of proposal hash which can be aggregated into
+	// one committed seal.
+	SignAggregatableHash(hash common.Hash) ([]byte, error)
+
+	// VerifyAggregatableHash verify the BLS signature of proposal hash signed by validator `signer`.
+	VerifyAggregatableHash(signer common.Address, hash common.Hash, sig []byte) error
+
+	// SealAggregatedAfterCommit aggregates the BLS committed seals into one signature, and writes
+	// it with the participants bitmap into extra-data field of a block header.
+	SealAggregatedAfterCommit(h *types.Header, valSet ValidatorSet, committers []common.Address, committedSeals [][]byte) error
+
 	// VerifyHeader verify proposer signature and committed seals
 	VerifyHeader(header *types.Header, valSet ValidatorSet, seal bool) error
 
@@ -62,4 +83,17 @@ type Signer interface {
 	VerifyHash(valSet ValidatorSet, hash common.Hash, sig []byte) error
 
 	VerifyCommittedSeal(valSet ValidatorSet, hash common.Hash, committedSeals [][]byte) error
+
+	// GetSignersFromCommittedSeals recover committers from committed seals
+	GetSignersFromCommittedSeals(hash common.Hash, seals [][]byte) ([]common.Address, error)
+
+	// GetSignersFromAggregatedSeal decode committers from participant bitmap and verify the aggregated seal
+	GetSignersFromAggregatedSeal(valSet ValidatorSet, hash common.Hash, seal []byte, bitmap []byte) ([]common.Address, error)
+
+	// SealVRF evaluates the verifiable random function over the parent seal and fill the output
+	// and proof into header's extra salt, it should be called before `SealBeforeCommit`.
+	SealVRF(header *types.Header, parent *types.Header) error
+
+	// VerifyVRF verify the verifiable random output and proof which filled in header's extra salt.
+	VerifyVRF(header *types.Header, parent *types.Header) error
 }
@@ -0,0 +1,393 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package signer
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// blsFieldModulus is the base field modulus of curve bls12-381
+	blsFieldModulus, _ = new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+	// blsHashDomain and blsProofDomain are the domain separation tags of the proof of possession scheme
+	// in the IETF BLS signature draft, so that a proof can never be replayed as a committed seal.
+	blsHashDomain  = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	blsProofDomain = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+const (
+	blsFieldSize     = 48 // size of field element in bytes
+	blsHashFieldSize = 64 // size of the uniform bytes hashed to a field element, ceil((381 + 128) / 8)
+	blsSecretSize    = 32 // size of secret key in bytes
+	BLSPublicKeySize = 96 // uncompressed G1 point in bytes
+	BLSSignatureSize = 192
+)
+
+// GenerateBLSKey creates a random BLS secret key, it is independent of the validator's ecdsa key.
+func GenerateBLSKey() (*big.Int, error) {
+	q := bls12381.NewG1().Q()
+	for {
+		key, err := rand.Int(rand.Reader, q)
+		if err != nil {
+			return nil, err
+		}
+		if key.Sign() > 0 {
+			return key, nil
+		}
+	}
+}
+
+// LoadBLSKey loads a hex encoded BLS secret key from the given file.
+func LoadBLSKey(file string) (*big.Int, error) {
+	enc, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hexutil.Decode(strings.TrimSpace(string(enc)))
+	if err != nil || len(raw) != blsSecretSize {
+		return nil, fmt.Errorf("invalid BLS key file %s", file)
+	}
+	key := new(big.Int).SetBytes(raw)
+	if key.Sign() == 0 || key.Cmp(bls12381.NewG1().Q()) >= 0 {
+		return nil, fmt.Errorf("invalid BLS key file %s", file)
+	}
+	return key, nil
+}
+
+// SaveBLSKey saves the BLS secret key into the given file with restrictive permissions.
+func SaveBLSKey(file string, key *big.Int) error {
+	enc := hexutil.Encode(key.FillBytes(make([]byte, blsSecretSize)))
+	return ioutil.WriteFile(file, []byte(enc), 0600)
+}
+
+// LoadOrGenerateBLSKey loads the BLS secret key from file, a new key is generated and saved if
+// the file doesn't exist.
+func LoadOrGenerateBLSKey(file string) (*big.Int, error) {
+	if _, err := os.Stat(file); os.IsNotExist(err) {
+		key, err := GenerateBLSKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+			return nil, err
+		}
+		return key, SaveBLSKey(file, key)
+	}
+	return LoadBLSKey(file)
+}
+
+// BLSPublicKey returns the uncompressed G1 public key of signer, validators should publish it in
+// node manager together with the proof of possession, validators of the epoch before the aggregated
+// committed seal fork may publish it in `HotStuffConfig.BLSPublicKeys` as well.
+func (s *SignerImpl) BLSPublicKey() []byte {
+	if s.blsKey == nil {
+		return nil
+	}
+	g1 := bls12381.NewG1()
+	pk := g1.MulScalar(g1.New(), g1.One(), s.blsKey)
+	return g1.ToBytes(pk)
+}
+
+// BLSProof returns the proof of possession of the BLS secret key, which is the signature of the
+// public key itself, it is published together with the public key. Aggregating
+// signatures of the same message is only safe with keys proven to be possessed, otherwise a rogue
+// key derived from the others' public keys may forge the aggregated committed seal alone.
+func (s *SignerImpl) BLSProof() []byte {
+	sig, err := s.signBLS(blsProofDomain, s.BLSPublicKey())
+	if err != nil {
+		return nil
+	}
+	return sig
+}
+
+// SignAggregatableHash implements hotstuff.Signer.SignAggregatableHash
+func (s *SignerImpl) SignAggregatableHash(hash common.Hash) ([]byte, error) {
+	return s.signBLS(blsHashDomain, s.wrapCommittedSeal(hash))
+}
+
+func (s *SignerImpl) signBLS(domain, data []byte) ([]byte, error) {
+	if s.blsKey == nil {
+		return nil, errInvalidSignature
+	}
+	g2 := bls12381.NewG2()
+	msg, err := hashToG2(domain, data)
+	if err != nil {
+		return nil, err
+	}
+	sig := g2.MulScalar(g2.New(), msg, s.blsKey)
+	return g2.ToBytes(sig), nil
+}
+
+// VerifyAggregatableHash implements hotstuff.Signer.VerifyAggregatableHash
+func (s *SignerImpl) VerifyAggregatableHash(signer common.Address, hash common.Hash, sig []byte) error {
+	pk, err := s.getBLSPublicKey(signer)
+	if err != nil {
+		return err
+	}
+	return verifyBLS(pk, blsHashDomain, s.wrapCommittedSeal(hash), sig)
+}
+
+// AddBLSPublicKey implements hotstuff.Signer.AddBLSPublicKey
+func (s *SignerImpl) AddBLSPublicKey(addr common.Address, pubKey, proof []byte) error {
+	pk, err := decodeBLSPublicKey(pubKey, proof)
+	if err != nil {
+		return err
+	}
+	s.blsRegistryMu.Lock()
+	s.blsRegistry[addr] = pk
+	s.blsRegistryMu.Unlock()
+	return nil
+}
+
+// VerifyBLSProof checks the BLS public key and it's proof of possession.
+func VerifyBLSProof(pubKey, proof []byte) error {
+	_, err := decodeBLSPublicKey(pubKey, proof)
+	return err
+}
+
+// SealAggregatedAfterCommit implements hotstuff.Signer.SealAggregatedAfterCommit
+func (s *SignerImpl) SealAggregatedAfterCommit(h *types.Header, valSet hotstuff.ValidatorSet, committers []common.Address, committedSeals [][]byte) error {
+	if len(committedSeals) == 0 || len(committers) != len(committedSeals) {
+		return errInvalidCommittedSeals
+	}
+
+	g2 := bls12381.NewG2()
+	bitmap := make([]byte, (valSet.Size()+7)/8)
+	aggregated := g2.Zero()
+	for i, seal := range committedSeals {
+		idx, val := valSet.GetByAddress(committers[i])
+		if val == nil {
+			return errUnauthorizedAddress
+		}
+		if bitmap[idx/8]&(1<<uint(idx%8)) != 0 {
+			return errInvalidCommittedSeals
+		}
+		sig, err := decodeBLSSignature(seal)
+		if err != nil {
+			return err
+		}
+		g2.Add(aggregated, aggregated, sig)
+		bitmap[idx/8] |= 1 << uint(idx%8)
+	}
+
+	extra, err := types.ExtractHotstuffExtra(h)
+	if err != nil {
+		return err
+	}
+	extra.CommittedSeal = [][]byte{}
+	extra.AggregatedSeal = g2.ToBytes(aggregated)
+	extra.ParticipantBitmap = bitmap
+
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	h.Extra = append(h.Extra[:types.HotstuffExtraVanity], payload...)
+	return nil
+}
+
+// GetSignersFromAggregatedSeal resolve the committers from participants bitmap and verify the
+// aggregated signature with the sum of their BLS public keys.
+func (s *SignerImpl) GetSignersFromAggregatedSeal(valSet hotstuff.ValidatorSet, hash common.Hash, seal []byte, bitmap []byte) ([]common.Address, error) {
+	if len(bitmap) != (valSet.Size()+7)/8 {
+		return nil, errInvalidCommittedSeals
+	}
+
+	g1 := bls12381.NewG1()
+	aggregated := g1.Zero()
+	committers := make([]common.Address, 0)
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		val := valSet.GetByIndex(uint64(i))
+		if val == nil {
+			return nil, errInvalidCommittedSeals
+		}
+		pk, err := s.getBLSPublicKey(val.Address())
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(aggregated, aggregated, pk)
+		committers = append(committers, val.Address())
+	}
+
+	if err := verifyBLS(aggregated, blsHashDomain, s.wrapCommittedSeal(hash), seal); err != nil {
+		return nil, errInvalidCommittedSeals
+	}
+	return committers, nil
+}
+
+// verifyBLS checks e(pk, H(m)) == e(g1, sig)
+func verifyBLS(pk *bls12381.PointG1, domain, data []byte, sig []byte) error {
+	point, err := decodeBLSSignature(sig)
+	if err != nil {
+		return err
+	}
+	msg, err := hashToG2(domain, data)
+	if err != nil {
+		return err
+	}
+
+	g1 := bls12381.NewG1()
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(pk, msg)
+	engine.AddPairInv(g1.One(), point)
+	if !engine.Check() {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// getBLSPublicKey returns the BLS public key published in node manager epochs, or the one in the static
+// config, which is only verified with the proof once.
+func (s *SignerImpl) getBLSPublicKey(addr common.Address) (*bls12381.PointG1, error) {
+	s.blsRegistryMu.RLock()
+	pk, ok := s.blsRegistry[addr]
+	s.blsRegistryMu.RUnlock()
+	if ok {
+		return pk, nil
+	}
+	if s.blsPubKeys != nil {
+		if pk, ok := s.blsPubKeys.Get(addr); ok {
+			return pk.(*bls12381.PointG1), nil
+		}
+	}
+	if s.config == nil {
+		return nil, errUnknownBLSPublicKey
+	}
+	enc, ok := s.config.BLSPublicKeys[addr]
+	if !ok {
+		return nil, errUnknownBLSPublicKey
+	}
+	pk, err := decodeBLSPublicKey(enc, s.config.BLSProofs[addr])
+	if err != nil {
+		return nil, err
+	}
+	if s.blsPubKeys != nil {
+		s.blsPubKeys.Add(addr, pk)
+	}
+	return pk, nil
+}
+
+// decodeBLSPublicKey decodes the BLS public key, which is accepted only with a valid proof of possession.
+func decodeBLSPublicKey(enc, proof []byte) (*bls12381.PointG1, error) {
+	if len(enc) != BLSPublicKeySize {
+		return nil, errUnknownBLSPublicKey
+	}
+	g1 := bls12381.NewG1()
+	pk, err := g1.FromBytes(enc)
+	if err != nil || g1.IsZero(pk) || !g1.InCorrectSubgroup(pk) {
+		return nil, errUnknownBLSPublicKey
+	}
+	if err := verifyBLS(pk, blsProofDomain, enc, proof); err != nil {
+		return nil, errInvalidBLSProof
+	}
+	return pk, nil
+}
+
+func decodeBLSSignature(sig []byte) (*bls12381.PointG2, error) {
+	if len(sig) != BLSSignatureSize {
+		return nil, errInvalidSignature
+	}
+	g2 := bls12381.NewG2()
+	point, err := g2.FromBytes(sig)
+	if err != nil || !g2.InCorrectSubgroup(point) {
+		return nil, errInvalidSignature
+	}
+	return point, nil
+}
+
+// hashToG2 implements hash_to_curve of the suite BLS12381G2_XMD:SHA-256_SSWU_RO_ defined in RFC 9380,
+// the message is hashed to two elements of fp2 which are mapped to curve and added up. MapToCurve
+// clears the cofactor of each point, which equals to clearing the cofactor of their sum.
+func hashToG2(dst, msg []byte) (*bls12381.PointG2, error) {
+	uniform, err := expandMessageXMD(dst, msg, 4*blsHashFieldSize)
+	if err != nil {
+		return nil, err
+	}
+	g2 := bls12381.NewG2()
+	point := g2.Zero()
+	for i := 0; i < 2; i++ {
+		// fp2 element is encoded as c1 || c0
+		fe := make([]byte, 2*blsFieldSize)
+		for j := 0; j < 2; j++ {
+			offset := (2*i + j) * blsHashFieldSize
+			e := new(big.Int).SetBytes(uniform[offset : offset+blsHashFieldSize])
+			e.Mod(e, blsFieldModulus).FillBytes(fe[(1-j)*blsFieldSize : (2-j)*blsFieldSize])
+		}
+		q, err := g2.MapToCurve(fe)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(point, point, q)
+	}
+	return g2.Affine(point), nil
+}
+
+// expandMessageXMD implements expand_message_xmd with SHA-256 defined in RFC 9380.
+func expandMessageXMD(dst, msg []byte, length int) ([]byte, error) {
+	ell := (length + sha256.Size - 1) / sha256.Size
+	if ell > 255 || length > 65535 || len(dst) > 255 {
+		return nil, fmt.Errorf("invalid expand message length %d or dst length %d", length, len(dst))
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+	uniform := append(make([]byte, 0, ell*sha256.Size), bi...)
+	for i := 2; i <= ell; i++ {
+		xor := make([]byte, sha256.Size)
+		for j := range xor {
+			xor[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(xor)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		uniform = append(uniform, bi...)
+	}
+	return uniform[:length], nil
+}
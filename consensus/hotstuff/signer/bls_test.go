@@ -0,0 +1,187 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatedCommittedSeal(t *testing.T) {
+	vset, keys := newTestValidatorSet(4)
+	_, signers := newTestBLSSigners(t, keys)
+
+	proposer := signers[0]
+	h := &types.Header{Number: big.NewInt(1), MixDigest: types.HotstuffDigest}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(h, nil))
+	h.Coinbase = proposer.Address()
+	assert.NoError(t, proposer.SealBeforeCommit(h))
+	hash := h.Hash()
+
+	// 3 of 4 validators sign the header hash
+	committers := make([]common.Address, 0)
+	seals := make([][]byte, 0)
+	for _, s := range signers[1:] {
+		seal, err := s.SignAggregatableHash(hash)
+		assert.NoError(t, err)
+		assert.NoError(t, proposer.VerifyAggregatableHash(s.Address(), hash, seal))
+		committers = append(committers, s.Address())
+		seals = append(seals, seal)
+	}
+	assert.Error(t, proposer.VerifyAggregatableHash(signers[0].Address(), hash, seals[0]))
+
+	assert.NoError(t, proposer.SealAggregatedAfterCommit(h, vset, committers, seals))
+	assert.Equal(t, hash, h.Hash())
+	extra, err := types.ExtractHotstuffExtra(h)
+	assert.NoError(t, err)
+	assert.True(t, extra.IsAggregated())
+	assert.Equal(t, 0, len(extra.CommittedSeal))
+	assert.NoError(t, proposer.VerifyHeader(h, vset, true))
+
+	// non-quorum committers
+	assert.NoError(t, proposer.SealAggregatedAfterCommit(h, vset, committers[:2], seals[:2]))
+	assert.Error(t, proposer.VerifyHeader(h, vset, true))
+
+	// forged signature
+	forged, err := signers[0].SignAggregatableHash(hash)
+	assert.NoError(t, err)
+	assert.NoError(t, proposer.SealAggregatedAfterCommit(h, vset, committers, append(seals[:2], forged)))
+	assert.Error(t, proposer.VerifyHeader(h, vset, true))
+}
+
+func TestBLSProofOfPossession(t *testing.T) {
+	_, keys := newTestValidatorSet(3)
+	config, signers := newTestBLSSigners(t, keys)
+	victim, attacker := signers[0], signers[1]
+	hash := common.HexToHash("0x01")
+
+	// the rogue key pk' = pk_attacker - pk_victim makes the aggregated key equal to the attacker's
+	// own one, so that the attacker alone could forge a seal "signed" by both of them.
+	g1 := bls12381.NewG1()
+	pkAttacker, _ := g1.FromBytes(attacker.BLSPublicKey())
+	pkVictim, _ := g1.FromBytes(victim.BLSPublicKey())
+	rogue := g1.Sub(g1.New(), pkAttacker, pkVictim)
+	config.BLSPublicKeys[attacker.Address()] = g1.ToBytes(rogue)
+	attacker.blsPubKeys.Purge()
+
+	seal, err := attacker.SignAggregatableHash(hash)
+	assert.NoError(t, err)
+	assert.Equal(t, errInvalidBLSProof, victim.VerifyAggregatableHash(attacker.Address(), hash, seal))
+
+	// nor is a valid public key accepted without proof
+	config.BLSPublicKeys[attacker.Address()] = attacker.BLSPublicKey()
+	delete(config.BLSProofs, attacker.Address())
+	assert.Equal(t, errInvalidBLSProof, victim.VerifyAggregatableHash(attacker.Address(), hash, seal))
+
+	// the proof can not be replayed as a committed seal of the public key
+	config.BLSProofs[attacker.Address()] = attacker.BLSProof()
+	assert.NoError(t, victim.VerifyAggregatableHash(attacker.Address(), hash, seal))
+	assert.Error(t, verifyBLS(pkAttacker, blsHashDomain, attacker.BLSPublicKey(), attacker.BLSProof()))
+}
+
+func TestAddBLSPublicKey(t *testing.T) {
+	_, keys := newTestValidatorSet(2)
+	config, signers := newTestBLSSigners(t, keys)
+	verifier, validator := signers[0], signers[1]
+	hash := common.HexToHash("0x01")
+	seal, err := validator.SignAggregatableHash(hash)
+	assert.NoError(t, err)
+
+	// keys published in node manager take place of the static config
+	delete(config.BLSPublicKeys, validator.Address())
+	verifier.blsPubKeys.Purge()
+	assert.Equal(t, errUnknownBLSPublicKey, verifier.VerifyAggregatableHash(validator.Address(), hash, seal))
+	assert.Equal(t, errInvalidBLSProof, verifier.AddBLSPublicKey(validator.Address(), validator.BLSPublicKey(), verifier.BLSProof()))
+	assert.Equal(t, errUnknownBLSPublicKey, verifier.VerifyAggregatableHash(validator.Address(), hash, seal))
+	assert.NoError(t, verifier.AddBLSPublicKey(validator.Address(), validator.BLSPublicKey(), validator.BLSProof()))
+	assert.NoError(t, verifier.VerifyAggregatableHash(validator.Address(), hash, seal))
+
+	assert.NoError(t, VerifyBLSProof(validator.BLSPublicKey(), validator.BLSProof()))
+	assert.Error(t, VerifyBLSProof(validator.BLSPublicKey()[1:], validator.BLSProof()))
+}
+
+// TestHashToG2 checks the hash to curve against the test vectors in RFC 9380.
+func TestHashToG2(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+	for msg, expect := range map[string]string{
+		"":    "0x68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235",
+		"abc": "0xd8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615",
+	} {
+		uniform, err := expandMessageXMD(dst, []byte(msg), 0x20)
+		assert.NoError(t, err)
+		assert.Equal(t, expect, hexutil.Encode(uniform))
+	}
+
+	point, err := hashToG2([]byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"), []byte(""))
+	assert.NoError(t, err)
+	expect := "0x" +
+		"05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d" + // x.c1
+		"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a" + // x.c0
+		"12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6" + // y.c1
+		"0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92" // y.c0
+	assert.Equal(t, expect, hexutil.Encode(bls12381.NewG2().ToBytes(point)))
+}
+
+func TestBLSKeyFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "blskey")
+	key, err := LoadOrGenerateBLSKey(file)
+	assert.NoError(t, err)
+	loaded, err := LoadOrGenerateBLSKey(file)
+	assert.NoError(t, err)
+	assert.Equal(t, key, loaded)
+
+	other, err := GenerateBLSKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	ecdsaKey, _ := generatePrivateKey()
+	assert.Nil(t, NewSigner(ecdsaKey, nil).BLSPublicKey())
+	assert.Equal(t, BLSPublicKeySize, len(NewBLSSigner(ecdsaKey, key, nil).BLSPublicKey()))
+
+	assert.NoError(t, ioutil.WriteFile(file, []byte("0x1234"), 0600))
+	_, err = LoadBLSKey(file)
+	assert.Error(t, err)
+}
+
+func newTestBLSSigners(t *testing.T, keys []*ecdsa.PrivateKey) (*params.HotStuffConfig, []*SignerImpl) {
+	config := &params.HotStuffConfig{
+		AggregateSealBlock: big.NewInt(1),
+		BLSPublicKeys:      make(map[common.Address]hexutil.Bytes),
+		BLSProofs:          make(map[common.Address]hexutil.Bytes),
+	}
+	signers := make([]*SignerImpl, len(keys))
+	for i, key := range keys {
+		blsKey, err := GenerateBLSKey()
+		assert.NoError(t, err)
+		signers[i] = NewBLSSigner(key, blsKey, config).(*SignerImpl)
+		config.BLSPublicKeys[signers[i].Address()] = signers[i].BLSPublicKey()
+		config.BLSProofs[signers[i].Address()] = signers[i].BLSProof()
+	}
+	return config, signers
+}
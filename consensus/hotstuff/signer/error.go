@@ -41,4 +41,13 @@ var (
 
 	// errInvalidSigner is returned if the msg is unsigned
 	errInvalidSigner = errors.New("message not signed by the sender")
+
+	// errUnknownBLSPublicKey is returned if the validator's BLS public key is not configured.
+	errUnknownBLSPublicKey = errors.New("unknown BLS public key")
+
+	// errInvalidBLSProof is returned if the validator's BLS public key comes without a valid proof of possession.
+	errInvalidBLSProof = errors.New("invalid BLS proof of possession")
+
+	// errInvalidVRF is returned if the vrf output or proof in extra salt is invalid.
+	errInvalidVRF = errors.New("invalid vrf")
 )
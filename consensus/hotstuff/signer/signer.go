@@ -21,11 +21,15 @@ package signer
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	lru "github.com/hashicorp/golang-lru"
 	"golang.org/x/crypto/sha3"
@@ -33,21 +37,40 @@ import (
 
 const (
 	inmemorySignatures = 4096 // Number of recent block signatures to keep in memory
+	inmemoryBLSPubKeys = 1024 // Number of validators' BLS public keys to keep in memory
 )
 
 type SignerImpl struct {
 	address    common.Address
 	privateKey *ecdsa.PrivateKey
 	signatures *lru.ARCCache // Signatures of recent blocks to speed up mining
+
+	config     *params.HotStuffConfig
+	blsKey     *big.Int      // BLS secret key, independent of the ecdsa private key
+	blsPubKeys *lru.ARCCache // Decoded BLS public keys of validators in the static config
+
+	blsRegistry   map[common.Address]*bls12381.PointG1 // BLS public keys published in node manager epochs
+	blsRegistryMu sync.RWMutex
+}
+
+// NewSigner creates a signer without BLS key, which is unable to sign aggregatable hash.
+func NewSigner(privateKey *ecdsa.PrivateKey, config *params.HotStuffConfig) hotstuff.Signer {
+	return NewBLSSigner(privateKey, nil, config)
 }
 
-func NewSigner(privateKey *ecdsa.PrivateKey) hotstuff.Signer {
+// NewBLSSigner creates a signer with the BLS secret key used for aggregated committed seals.
+func NewBLSSigner(privateKey *ecdsa.PrivateKey, blsKey *big.Int, config *params.HotStuffConfig) hotstuff.Signer {
 	signatures, _ := lru.NewARC(inmemorySignatures)
+	blsPubKeys, _ := lru.NewARC(inmemoryBLSPubKeys)
 	address := crypto.PubkeyToAddress(privateKey.PublicKey)
 	return &SignerImpl{
-		address:    address,
-		privateKey: privateKey,
-		signatures: signatures,
+		address:     address,
+		privateKey:  privateKey,
+		signatures:  signatures,
+		config:      config,
+		blsKey:      blsKey,
+		blsPubKeys:  blsPubKeys,
+		blsRegistry: make(map[common.Address]*bls12381.PointG1),
 	}
 }
 
@@ -188,6 +211,16 @@ func (s *SignerImpl) VerifyHeader(header *types.Header, valSet hotstuff.Validato
 		if err != nil {
 			return errInvalidExtraDataFormat
 		}
+		if s.config.IsAggregateSeal(header.Number) != extra.IsAggregated() {
+			return errInvalidCommittedSeals
+		}
+		if extra.IsAggregated() {
+			committers, err := s.GetSignersFromAggregatedSeal(valSet, header.Hash(), extra.AggregatedSeal, extra.ParticipantBitmap)
+			if err != nil {
+				return err
+			}
+			return valSet.CheckQuorum(committers)
+		}
 
 		// The length of Committed seals should be larger than 0
 		if len(extra.CommittedSeal) == 0 {
@@ -227,6 +260,13 @@ func (s *SignerImpl) VerifyQC(qc *hotstuff.QuorumCert, valSet hotstuff.Validator
 	}
 
 	// check committed seals
+	if extra.IsAggregated() {
+		committers, err := s.GetSignersFromAggregatedSeal(valSet, qc.Hash, extra.AggregatedSeal, extra.ParticipantBitmap)
+		if err != nil {
+			return err
+		}
+		return valSet.CheckQuorum(committers)
+	}
 	committers, err := s.GetSignersFromCommittedSeals(qc.Hash, extra.CommittedSeal)
 	if err != nil {
 		return err
@@ -256,6 +296,9 @@ func (s *SignerImpl) CheckQCParticipant(qc *hotstuff.QuorumCert, signer common.A
 	}
 
 	// check committed seals
+	if extra.IsAggregated() {
+		return fmt.Errorf("address %s is not proposer, aggregated committers should be checked with validator set", signer.Hex())
+	}
 	committers, err := s.GetSignersFromCommittedSeals(qc.Hash, extra.CommittedSeal)
 	if err != nil {
 		return err
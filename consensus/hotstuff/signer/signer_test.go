@@ -21,7 +21,6 @@ package signer
 import (
 	"bytes"
 	"crypto/ecdsa"
-	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
 	"sort"
 	"strings"
 	"testing"
@@ -61,7 +60,7 @@ func TestCheckValidatorSignature(t *testing.T) {
 		assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 		// CheckValidatorSignature should succeed
-		signer := NewSigner(k, 3)
+		signer := NewSigner(k, nil)
 		addr, err := signer.CheckSignature(vset, data, sig)
 		assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
@@ -78,7 +77,7 @@ func TestCheckValidatorSignature(t *testing.T) {
 	assert.NoError(t, err, "error mismatch: have %v, want nil", err)
 
 	// CheckValidatorSignature should return ErrUnauthorizedAddress
-	signer := NewSigner(key, byte(core.MsgTypePrepareVote))
+	signer := NewSigner(key, nil)
 	addr, err := signer.CheckSignature(vset, data, sig)
 	assert.Equal(t, err, errUnauthorizedAddress, "error mismatch: have %v, want %v", err, errUnauthorizedAddress)
 
@@ -88,7 +87,7 @@ func TestCheckValidatorSignature(t *testing.T) {
 
 func TestFillExtraAfterCommit(t *testing.T) {
 	vanity := bytes.Repeat([]byte{0x00}, types.HotstuffExtraVanity)
-	istRawData := hexutil.MustDecode("0xf858f8549444add0ec310f115a0e603b2d7db9f067778eaf8a94294fc7e8f22b3bcdcf955dd7ff3ba2ed833f8212946beaaed781d2d2ab6350f5c4566a2c6eaac407a6948be76812f765c24641ec63dc2852b378aba2b44080c0")
+	istRawData := hexutil.MustDecode("0xf859f8549444add0ec310f115a0e603b2d7db9f067778eaf8a94294fc7e8f22b3bcdcf955dd7ff3ba2ed833f8212946beaaed781d2d2ab6350f5c4566a2c6eaac407a6948be76812f765c24641ec63dc2852b378aba2b44080c080")
 	expectedCommittedSeal := append([]byte{1, 2, 3}, bytes.Repeat([]byte{0x00}, types.HotstuffExtraSeal-3)...)
 	expectedIstExtra := &types.HotstuffExtra{
 		Validators: []common.Address{
@@ -99,6 +98,7 @@ func TestFillExtraAfterCommit(t *testing.T) {
 		},
 		Seal:          []byte{},
 		CommittedSeal: [][]byte{expectedCommittedSeal},
+		Salt:          []byte{},
 	}
 	h := &types.Header{
 		Extra: append(vanity, istRawData...),
@@ -162,5 +162,5 @@ func generatePrivateKey() (*ecdsa.PrivateKey, error) {
 
 func newTestSigner() hotstuff.Signer {
 	key, _ := generatePrivateKey()
-	return NewSigner(key, 3)
+	return NewSigner(key, nil)
 }
@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package signer
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ontio/ontology-crypto/ec"
+	"github.com/ontio/ontology-crypto/vrf"
+)
+
+// SealVRF evaluates the verifiable random function with proposer's private key, the output and
+// proof are filled into header extra salt as `proof || output`.
+func (s *SignerImpl) SealVRF(header *types.Header, parent *types.Header) error {
+	msg, err := vrfMessage(header, parent)
+	if err != nil {
+		return err
+	}
+
+	sk := &ec.PrivateKey{
+		Algorithm: ec.ECDSA,
+		PrivateKey: &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: btcec.S256(),
+				X:     s.privateKey.X,
+				Y:     s.privateKey.Y,
+			},
+			D: s.privateKey.D,
+		},
+	}
+	value, proof, err := vrf.Vrf(sk, msg)
+	if err != nil {
+		return err
+	}
+	if len(value) != types.HotstuffExtraVRFValue || len(proof) != types.HotstuffExtraVRFProof {
+		return errInvalidVRF
+	}
+
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	extra.Salt = append(proof, value...)
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return nil
+}
+
+// VerifyVRF recover the proposer's public key from header seal and verify the random output.
+func (s *SignerImpl) VerifyVRF(header *types.Header, parent *types.Header) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return errInvalidExtraDataFormat
+	}
+	value, proof := extra.VRF()
+	if value == nil || proof == nil {
+		return errInvalidVRF
+	}
+
+	pubkey, err := getSignaturePublicKey(s.SigHash(header).Bytes(), extra.Seal)
+	if err != nil {
+		return err
+	}
+	pk := &ec.PublicKey{
+		Algorithm: ec.ECDSA,
+		PublicKey: &ecdsa.PublicKey{
+			Curve: btcec.S256(),
+			X:     pubkey.X,
+			Y:     pubkey.Y,
+		},
+	}
+
+	msg, err := vrfMessage(header, parent)
+	if err != nil {
+		return err
+	}
+	if ok, err := vrf.Verify(pk, msg, value, proof); err != nil || !ok {
+		return errInvalidVRF
+	}
+	return nil
+}
+
+// vrfMessage returns the vrf input which composed of parent seal and header height, the parent
+// hash is used instead if the parent is not sealed, e.g: genesis block.
+func vrfMessage(header *types.Header, parent *types.Header) ([]byte, error) {
+	if parent == nil || header.ParentHash != parent.Hash() {
+		return nil, errInvalidVRF
+	}
+
+	seed := parent.Hash().Bytes()
+	if extra, err := types.ExtractHotstuffExtra(parent); err == nil && len(extra.Seal) > 0 {
+		seed = extra.Seal
+	}
+	return append(common.CopyBytes(seed), header.Number.Bytes()...), nil
+}
+
+// getSignaturePublicKey gets the public key from the signature
+func getSignaturePublicKey(data []byte, sig []byte) (*ecdsa.PublicKey, error) {
+	hashData := crypto.Keccak256(data)
+	return crypto.SigToPub(hashData, sig)
+}
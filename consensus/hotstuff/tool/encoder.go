@@ -23,6 +23,8 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
@@ -148,6 +150,36 @@ func NodeKey2PublicInfo(key string) (string, error) {
 	return hexutil.Encode(enc), nil
 }
 
+// PublicKey2NodeInfo returns the node id of a compressed or uncompressed public key
+func PublicKey2NodeInfo(key string) (string, error) {
+	if !strings.Contains(key, "0x") {
+		key = "0x" + key
+	}
+
+	enc, err := hexutil.Decode(key)
+	if err != nil {
+		return "", err
+	}
+
+	var pubKey *ecdsa.PublicKey
+	if len(enc) == 33 {
+		pubKey, err = crypto.DecompressPubkey(enc)
+	} else {
+		pubKey, err = crypto.UnmarshalPubkey(enc)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	id := PubkeyID(pubKey)
+	return id.String(), nil
+}
+
 func NodeStaticInfoTemp(src string) string {
-	return fmt.Sprintf("enode://%s@127.0.0.1:30300?discport=0", src)
+	return NodeStaticInfo(src, "127.0.0.1", 30300)
+}
+
+// NodeStaticInfo returns the enode url of node listening on host:port, discovery is disabled in maas network.
+func NodeStaticInfo(src string, host string, port int) string {
+	return fmt.Sprintf("enode://%s@%s?discport=0", src, net.JoinHostPort(host, strconv.Itoa(port)))
 }
@@ -210,3 +210,23 @@ func generateNodes(n int) []*Node {
 
 	return nodes
 }
+
+func TestNodeStaticInfo(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	nodeKey := hexutil.Encode(crypto.FromECDSA(key))
+	nodeInf, err := NodeKey2NodeInfo(nodeKey)
+	assert.NoError(t, err)
+
+	pubInf, err := NodeKey2PublicInfo(nodeKey)
+	assert.NoError(t, err)
+	got, err := PublicKey2NodeInfo(pubInf)
+	assert.NoError(t, err)
+	assert.Equal(t, nodeInf, got)
+	got, err = PublicKey2NodeInfo(hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey)))
+	assert.NoError(t, err)
+	assert.Equal(t, nodeInf, got)
+
+	assert.Equal(t, "enode://"+nodeInf+"@127.0.0.1:30300?discport=0", NodeStaticInfoTemp(nodeInf))
+	assert.Equal(t, "enode://"+nodeInf+"@node1.maas:30301?discport=0", NodeStaticInfo(nodeInf, "node1.maas", 30301))
+	assert.Equal(t, "enode://"+nodeInf+"@[fe80::1]:30300?discport=0", NodeStaticInfo(nodeInf, "fe80::1", 30300))
+}
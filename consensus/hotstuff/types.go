@@ -274,6 +274,24 @@ func (m *Message) PayloadNoSig() ([]byte, error) {
 	})
 }
 
+// PayloadToSign returns the data signed by the sender, which binds the message to the chain and the
+// consensus protocol tagged by `tag`, so that the signature can't be replayed in other chains.
+func (m *Message) PayloadToSign(chainID *big.Int, tag uint64) ([]byte, error) {
+	payload, err := m.PayloadNoSig()
+	if err != nil {
+		return nil, err
+	}
+	return SigningPayload(chainID, tag, payload)
+}
+
+// SigningPayload wraps the message payload without signature with chain id and protocol tag.
+func SigningPayload(chainID *big.Int, tag uint64, payloadNoSig []byte) ([]byte, error) {
+	if chainID == nil {
+		chainID = common.Big0
+	}
+	return rlp.EncodeToBytes([]interface{}{chainID, tag, payloadNoSig})
+}
+
 func (m *Message) Decode(val interface{}) error {
 	return rlp.DecodeBytes(m.Msg, val)
 }
@@ -87,6 +87,10 @@ type ValidatorSet interface {
 	Policy() SelectProposerPolicy
 	// Cmp compare with another validator set, return false if not the same
 	Cmp(src ValidatorSet) bool
+	// Set the verifiable random output used by VRF policy
+	SetRandomSeed(seed []byte)
+	// Get the verifiable random output used by VRF policy
+	RandomSeed() []byte
 }
 
 // ----------------------------------------------------------------------------
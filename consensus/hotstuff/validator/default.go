@@ -21,12 +21,14 @@ package validator
 import (
 	"errors"
 	"math"
+	"math/big"
 	"reflect"
 	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var ErrInvalidParticipant = errors.New("invalid participants")
@@ -52,6 +54,8 @@ type defaultSet struct {
 	proposer    hotstuff.Validator
 	validatorMu sync.RWMutex
 	selector    hotstuff.ProposalSelector
+
+	seed []byte // verifiable random output of last proposal, used by vrf selector
 }
 
 func newDefaultSet(addrs []common.Address, policy hotstuff.SelectProposerPolicy) *defaultSet {
@@ -185,9 +189,20 @@ func stickySelector(valSet hotstuff.ValidatorSet, proposer common.Address, round
 	return valSet.GetByIndex(pick)
 }
 
-// TODO: implement VRF
+// vrfSelector picks the proposer with the hash of last proposal's verifiable random output and
+// the round number, nobody is able to predict the next proposer before the last proposal sealed.
+// it falls back to round robin if the last proposal carries no random output, e.g: genesis block.
 func vrfSelector(valSet hotstuff.ValidatorSet, proposer common.Address, round uint64) hotstuff.Validator {
-	return nil
+	if valSet.Size() == 0 {
+		return nil
+	}
+	seed := valSet.RandomSeed()
+	if len(seed) == 0 {
+		return roundRobinSelector(valSet, proposer, round)
+	}
+	hash := crypto.Keccak256(seed, new(big.Int).SetUint64(round).Bytes())
+	pick := new(big.Int).Mod(new(big.Int).SetBytes(hash), big.NewInt(int64(valSet.Size())))
+	return valSet.GetByIndex(pick.Uint64())
 }
 
 func (valSet *defaultSet) AddValidator(address common.Address) bool {
@@ -226,7 +241,9 @@ func (valSet *defaultSet) Copy() hotstuff.ValidatorSet {
 	for _, v := range valSet.validators {
 		addresses = append(addresses, v.Address())
 	}
-	return NewSet(addresses, valSet.policy)
+	cpy := newDefaultSet(addresses, valSet.policy)
+	cpy.seed = common.CopyBytes(valSet.seed)
+	return cpy
 }
 
 func (valSet *defaultSet) ParticipantsNumber(list []common.Address) int {
@@ -275,3 +292,15 @@ func (valSet *defaultSet) Cmp(src hotstuff.ValidatorSet) bool {
 	}
 	return true
 }
+
+func (valSet *defaultSet) SetRandomSeed(seed []byte) {
+	valSet.validatorMu.Lock()
+	defer valSet.validatorMu.Unlock()
+	valSet.seed = common.CopyBytes(seed)
+}
+
+func (valSet *defaultSet) RandomSeed() []byte {
+	valSet.validatorMu.RLock()
+	defer valSet.validatorMu.RUnlock()
+	return valSet.seed
+}
@@ -231,6 +231,35 @@ func testStickyProposer(t *testing.T) {
 	}
 }
 
+func TestVRFProposer(t *testing.T) {
+	var addrs []common.Address
+	for i := 0; i < 7; i++ {
+		key, _ := crypto.GenerateKey()
+		addrs = append(addrs, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	valSet := newDefaultSet(addrs, hotstuff.VRF)
+
+	// fall back to round robin without random seed
+	valSet.CalcProposer(common.Address{}, uint64(3))
+	assert.Equal(t, valSet.GetByIndex(3), valSet.GetProposer())
+
+	// the same seed and round always pick the same proposer
+	seed := crypto.Keccak256([]byte("vrf output"))
+	valSet.SetRandomSeed(seed)
+	cpy := valSet.Copy()
+	assert.Equal(t, seed, cpy.RandomSeed())
+
+	picked := make(map[common.Address]bool)
+	for round := uint64(0); round < 64; round++ {
+		valSet.CalcProposer(addrs[0], round)
+		cpy.CalcProposer(addrs[1], round)
+		assert.NotNil(t, valSet.GetProposer())
+		assert.Equal(t, valSet.GetProposer(), cpy.GetProposer())
+		picked[valSet.GetProposer().Address()] = true
+	}
+	assert.True(t, len(picked) > 1)
+}
+
 func TestFAndQ(t *testing.T) {
 	vs := newDefaultSet([]common.Address{
 		common.HexToAddress("0x1"),
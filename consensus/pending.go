@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package consensus
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// PendingChain extends the local chain with headers of blocks which are not inserted yet, e.g. blocks
+// certified by validators but not final. Blocks built on top of them are executed against this view, so
+// that ancestor lookups (such as the BLOCKHASH opcode) get the same result as after insertion.
+type PendingChain struct {
+	ChainHeaderReader
+
+	engine  Engine
+	headers map[common.Hash]*types.Header
+}
+
+// NewPendingChain creates a chain view which serves the given headers on top of the local chain.
+func NewPendingChain(chain ChainHeaderReader, engine Engine, headers []*types.Header) *PendingChain {
+	c := &PendingChain{
+		ChainHeaderReader: chain,
+		engine:            engine,
+		headers:           make(map[common.Hash]*types.Header, len(headers)),
+	}
+	for _, header := range headers {
+		c.headers[header.Hash()] = header
+	}
+	return c
+}
+
+// Engine retrieves the consensus engine of chain.
+func (c *PendingChain) Engine() Engine {
+	return c.engine
+}
+
+// GetHeader retrieves a block header by hash and number, pending headers take precedence.
+func (c *PendingChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header, ok := c.headers[hash]; ok && header.Number.Uint64() == number {
+		return header
+	}
+	return c.ChainHeaderReader.GetHeader(hash, number)
+}
+
+// GetHeaderByHash retrieves a block header by hash, pending headers take precedence.
+func (c *PendingChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	if header, ok := c.headers[hash]; ok {
+		return header
+	}
+	return c.ChainHeaderReader.GetHeaderByHash(hash)
+}
@@ -19,10 +19,14 @@ package boot
 
 import (
 	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/native_registry"
 	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/staking"
 )
 
 func InitialNativeContracts() {
 	node_manager.InitNodeManager()
 	maas_config.InitMaasConfig()
+	native_registry.InitNativeRegistry()
+	staking.InitStaking()
 }
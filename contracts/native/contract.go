@@ -95,11 +95,14 @@ func (s *NativeContract) Invoke() ([]byte, error) {
 	}
 	methodID := hexutil.Encode(ctx.Payload[:4])
 
-	// register methods
-	registerHandler, ok := Contracts[ctx.ContractAddress]
-	if !ok {
+	// register methods of the implementation active at current block
+	if s.ref.ContractDisabled(ctx.ContractAddress) {
 		return nil, fmt.Errorf("failed to find contract: [%x]", ctx.ContractAddress)
 	}
+	registerHandler, err := resolveContract(s.db, ctx.ContractAddress, s.ref.BlockHeight(), s.ref.Versioned())
+	if err != nil {
+		return nil, err
+	}
 	registerHandler(s)
 
 	// get method handler
@@ -113,6 +116,9 @@ func (s *NativeContract) Invoke() ([]byte, error) {
 	if !ok {
 		return nil, fmt.Errorf("failed to find method: [%s]", methodID)
 	}
+	if s.ref.GasMetered() {
+		return s.invokeMetered(handler)
+	}
 	gasLeft := s.ref.gasLeft
 	if gasLeft < needGas {
 		return nil, fmt.Errorf("gasLeft not enough, need %d, got %d", needGas, gasLeft)
@@ -129,6 +135,20 @@ func (s *NativeContract) Invoke() ([]byte, error) {
 	return ret, err
 }
 
+// invokeMetered execute transaction and charge gas progressively, failed calls pay the gas consumed
+// until failure.
+func (s *NativeContract) invokeMetered(handler MethodHandler) ([]byte, error) {
+	payload := s.ref.CurrentContext().Payload
+	if !s.ref.UseGas(NativeCallGas + uint64(len(payload))*InputByteGas) {
+		return nil, ErrOutOfGas
+	}
+	ret, err := handler(s)
+	if s.ref.OutOfGas() {
+		return nil, ErrOutOfGas
+	}
+	return ret, err
+}
+
 func (s *NativeContract) AddNotify(abi *abiPkg.ABI, topics []string, data ...interface{}) error {
 	var topicIDs []common.Hash
 
@@ -163,6 +183,9 @@ func (s *NativeContract) AddNotify(abi *abiPkg.ABI, topics []string, data ...int
 	if err != nil {
 		return fmt.Errorf("AddNotify, PackEvents error: %v", err)
 	}
+	if !s.ref.UseGas(LogGas + uint64(len(topicIDs))*LogTopicGas + uint64(len(packedData))*LogDataGas) {
+		return ErrOutOfGas
+	}
 	emitter := utils.NewEventEmitter(s.ref.CurrentContext().ContractAddress, s.ContractRef().BlockHeight().Uint64(), s.StateDB())
 	emitter.Event(topicIDs, packedData)
 
@@ -19,12 +19,14 @@
 package native
 
 import (
+	"errors"
 	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/stretchr/testify/assert"
@@ -52,3 +54,166 @@ func TestAddNotify(t *testing.T) {
 
 	assert.NoError(t, ctx.AddNotify(&ab, []string{topic}, sender, txId, proxy))
 }
+
+func TestInvokeGasMeter(t *testing.T) {
+	// function put(bytes value) returns (bool); event Put(bytes value);
+	abiJsonStr := `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"value","type":"bytes"}],"name":"Put","type":"event"},{"inputs":[{"internalType":"bytes","name":"value","type":"bytes"}],"name":"put","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+	ab, _ := abi.JSON(strings.NewReader(abiJsonStr))
+	contract := common.HexToAddress("0x0100000000000000000000000000000000000001")
+	key := utils.ConcatKey(contract, []byte("value"))
+	Contracts[contract] = func(s *NativeContract) {
+		s.Prepare(&ab, map[string]uint64{"put": 30000})
+		s.Register("put", func(s *NativeContract) ([]byte, error) {
+			var input struct{ Value []byte }
+			if err := utils.UnpackMethod(&ab, "put", &input, s.ContractRef().CurrentContext().Payload); err != nil {
+				return utils.ByteFailed, err
+			}
+			s.GetCacheDB().Put(key, input.Value)
+			if err := s.AddNotify(&ab, []string{"Put"}, input.Value); err != nil {
+				return utils.ByteFailed, err
+			}
+			return utils.ByteSuccess, nil
+		})
+	}
+	defer delete(Contracts, contract)
+
+	call := func(value []byte, gas uint64, metered bool) (uint64, error) {
+		sdb := utils.NewTestStateDB()
+		ref := NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, gas, nil)
+		if metered {
+			ref.EnableGasMeter()
+		}
+		payload, _ := utils.PackMethod(&ab, "put", value)
+		_, left, err := ref.NativeCall(common.Address{}, contract, payload)
+		return gas - left, err
+	}
+
+	// fixed gas table regardless of value size
+	used, err := call(make([]byte, 10), 100000, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(30000), used)
+
+	// metered by storage slots, input and event data
+	small, err := call(make([]byte, 10), 1000000, true)
+	assert.NoError(t, err)
+	large, err := call(make([]byte, 310), 1000000, true)
+	assert.NoError(t, err)
+	assert.Less(t, small, uint64(30000))
+	// 9 more slots written, 288 more bytes of padded value in input and event data
+	assert.Equal(t, 9*StorageWriteGas+288*(InputByteGas+LogDataGas), large-small)
+
+	// gas estimated by the metered usage is enough, and out of gas with less
+	_, err = call(make([]byte, 310), large, true)
+	assert.NoError(t, err)
+	used, err = call(make([]byte, 310), large-1, true)
+	assert.Equal(t, ErrOutOfGas, err)
+	assert.Equal(t, large-1, used)
+}
+
+func TestNativeCallRevert(t *testing.T) {
+	// function put(bytes value) returns (bool); event Put(bytes value);
+	abiJsonStr := `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"value","type":"bytes"}],"name":"Put","type":"event"},{"inputs":[{"internalType":"bytes","name":"value","type":"bytes"}],"name":"put","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+	ab, _ := abi.JSON(strings.NewReader(abiJsonStr))
+	outer := common.HexToAddress("0x0100000000000000000000000000000000000002")
+	inner := common.HexToAddress("0x0100000000000000000000000000000000000003")
+	errFailed := errors.New("put failed")
+
+	// both contracts store the value and emit event, inner one fails after that
+	register := func(contract common.Address) RegisterService {
+		return func(s *NativeContract) {
+			s.Prepare(&ab, map[string]uint64{"put": 0})
+			s.Register("put", func(s *NativeContract) ([]byte, error) {
+				ref := s.ContractRef()
+				var input struct{ Value []byte }
+				if err := utils.UnpackMethod(&ab, "put", &input, ref.CurrentContext().Payload); err != nil {
+					return utils.ByteFailed, err
+				}
+				s.GetCacheDB().Put(utils.ConcatKey(contract, []byte("value")), input.Value)
+				if err := s.AddNotify(&ab, []string{"Put"}, input.Value); err != nil {
+					return utils.ByteFailed, err
+				}
+				if contract == inner {
+					return utils.ByteFailed, errFailed
+				}
+				// the failure of inner call is ignored by outer one
+				_, _, err := ref.NativeCall(outer, inner, ref.CurrentContext().Payload)
+				assert.Equal(t, errFailed, err)
+				return utils.ByteSuccess, nil
+			})
+		}
+	}
+	Contracts[outer] = register(outer)
+	Contracts[inner] = register(inner)
+	defer delete(Contracts, outer)
+	defer delete(Contracts, inner)
+
+	// writes of the failed frame are kept before the fork
+	for _, revert := range []bool{false, true} {
+		sdb := utils.NewTestStateDB()
+		ref := NewContractRef(sdb, common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+		if revert {
+			ref.EnableRevert()
+		}
+		payload, _ := utils.PackMethod(&ab, "put", []byte("value"))
+		_, _, err := ref.NativeCall(common.Address{}, outer, payload)
+		assert.NoError(t, err)
+
+		cache := (*state.CacheDB)(sdb)
+		value, _ := cache.Get(utils.ConcatKey(outer, []byte("value")))
+		assert.Equal(t, []byte("value"), value)
+		value, _ = cache.Get(utils.ConcatKey(inner, []byte("value")))
+		if revert {
+			assert.Empty(t, value)
+			assert.Len(t, sdb.Logs(), 1)
+		} else {
+			assert.Equal(t, []byte("value"), value)
+			assert.Len(t, sdb.Logs(), 2)
+		}
+	}
+}
+
+func TestNativeCallValueAndDisabled(t *testing.T) {
+	// function put(bytes value) returns (bool); event Put(bytes value);
+	abiJsonStr := `[{"anonymous":false,"inputs":[{"indexed":false,"internalType":"bytes","name":"value","type":"bytes"}],"name":"Put","type":"event"},{"inputs":[{"internalType":"bytes","name":"value","type":"bytes"}],"name":"put","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"}]`
+	ab, _ := abi.JSON(strings.NewReader(abiJsonStr))
+	outer := common.HexToAddress("0x0100000000000000000000000000000000000004")
+	inner := common.HexToAddress("0x0100000000000000000000000000000000000005")
+
+	// outer contract receives the value of call, and calls inner one without value
+	values := make(map[common.Address]*big.Int)
+	register := func(contract common.Address) RegisterService {
+		return func(s *NativeContract) {
+			s.Prepare(&ab, map[string]uint64{"put": 0})
+			s.Register("put", func(s *NativeContract) ([]byte, error) {
+				ref := s.ContractRef()
+				values[contract] = ref.Value()
+				if contract == outer {
+					if _, _, err := ref.NativeCall(outer, inner, ref.CurrentContext().Payload); err != nil {
+						return utils.ByteFailed, err
+					}
+				}
+				return utils.ByteSuccess, nil
+			})
+		}
+	}
+	Contracts[outer] = register(outer)
+	Contracts[inner] = register(inner)
+	defer delete(Contracts, outer)
+	defer delete(Contracts, inner)
+
+	payload, _ := utils.PackMethod(&ab, "put", []byte("value"))
+	ref := NewContractRef(utils.NewTestStateDB(), common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.SetValue(big.NewInt(100))
+	_, _, err := ref.NativeCall(common.Address{}, outer, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), values[outer])
+	assert.Equal(t, common.Big0, values[inner])
+
+	// disabled contract is not found, even if it's called by another native contract
+	ref = NewContractRef(utils.NewTestStateDB(), common.Address{}, common.Address{}, big.NewInt(1), common.Hash{}, 0, nil)
+	ref.DisableContract(inner)
+	_, _, err = ref.NativeCall(common.Address{}, outer, payload)
+	assert.Error(t, err)
+	_, _, err = ref.NativeCall(common.Address{}, inner, payload)
+	assert.Error(t, err)
+}
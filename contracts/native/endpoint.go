@@ -37,6 +37,18 @@ type ContractRef struct {
 	caller      common.Address
 	evmHandler  EVMHandler
 	gasLeft     uint64
+
+	gasMetered bool // charge gas by storage, input and events instead of the fixed gas table
+	outOfGas   bool
+
+	revertFailed bool // revert state changes of the failed call frames
+
+	value     *big.Int                    // value transferred to the entry contract by the evm call
+	election  bool                        // epoch members elected from staking validators or registered candidates
+	versioned bool                        // native calls dispatched to the versions approved in native registry
+	blsKeys   bool                        // validators publish BLS public keys for the aggregated committed seals
+	disabled  map[common.Address]struct{} // native contracts not launched at current block
+	chainID   *big.Int                    // chain id which the consensus messages are signed with
 }
 
 func NewContractRef(
@@ -73,8 +85,17 @@ func (s *ContractRef) NativeCall(
 	})
 	defer s.PopContext()
 
+	if s.gasMetered {
+		cache := (*state.CacheDB)(s.stateDB)
+		prev := cache.SetMeter(s)
+		defer cache.SetMeter(prev)
+	}
+
 	contract := NewNativeContract(s.stateDB, s)
 	ret, err = contract.Invoke()
+	if err != nil && s.revertFailed {
+		s.RevertContext()
+	}
 	gasLeft = s.gasLeft
 	return
 }
@@ -112,6 +133,123 @@ func (s *ContractRef) GasLeft() uint64 {
 	return s.gasLeft
 }
 
+// EnableGasMeter charges the native calls progressively by actual work, storage operations through
+// CacheDB, input bytes and emitted events, instead of the fixed gas table of methods.
+func (s *ContractRef) EnableGasMeter() {
+	s.gasMetered = true
+}
+
+func (s *ContractRef) GasMetered() bool {
+	return s.gasMetered
+}
+
+// EnableRevert reverts storage writes and logs of the native call frame which returns error, they were
+// kept in stateDB before the native revert fork.
+func (s *ContractRef) EnableRevert() {
+	s.revertFailed = true
+}
+
+// SetValue sets the value transferred to the entry native contract, which has been moved to the contract
+// balance by evm before the native call.
+func (s *ContractRef) SetValue(value *big.Int) {
+	s.value = value
+}
+
+// Value implement solidity grammar `msg.value`, native contracts calling each other transfer no value.
+func (s *ContractRef) Value() *big.Int {
+	if s.value == nil || len(s.contexts) > 1 {
+		return common.Big0
+	}
+	return s.value
+}
+
+// SetChainID sets the id of chain, which is signed in consensus messages packaged in evidence.
+func (s *ContractRef) SetChainID(chainID *big.Int) {
+	s.chainID = chainID
+}
+
+func (s *ContractRef) ChainID() *big.Int {
+	return s.chainID
+}
+
+// EnableElection elects the epoch members from staking validators or registered candidates, instead of
+// accepting any peers proposed by the current members.
+func (s *ContractRef) EnableElection() {
+	s.election = true
+}
+
+func (s *ContractRef) Election() bool {
+	return s.election
+}
+
+// EnableVersioning dispatches the native calls to the implementation version active in native registry,
+// instead of the default one registered at compile time.
+func (s *ContractRef) EnableVersioning() {
+	s.versioned = true
+}
+
+func (s *ContractRef) Versioned() bool {
+	return s.versioned
+}
+
+// EnableBLSKeys allows validators to publish the BLS public keys in node manager, which are carried by
+// the epoch members for the aggregated committed seals.
+func (s *ContractRef) EnableBLSKeys() {
+	s.blsKeys = true
+}
+
+func (s *ContractRef) BLSKeys() bool {
+	return s.blsKeys
+}
+
+// DisableContract makes the native contracts unavailable as if they were not registered, e.g. the
+// contracts taking a backup address before their fork.
+func (s *ContractRef) DisableContract(addrs ...common.Address) {
+	if s.disabled == nil {
+		s.disabled = make(map[common.Address]struct{})
+	}
+	for _, addr := range addrs {
+		s.disabled[addr] = struct{}{}
+	}
+}
+
+func (s *ContractRef) ContractDisabled(addr common.Address) bool {
+	_, ok := s.disabled[addr]
+	return ok
+}
+
+// UseGas charges gas if metered, it returns false and exhausts all gas left if gas is not enough.
+func (s *ContractRef) UseGas(gas uint64) bool {
+	if !s.gasMetered {
+		return true
+	}
+	if s.outOfGas {
+		return false
+	}
+	if s.gasLeft < gas {
+		s.gasLeft = 0
+		s.outOfGas = true
+		return false
+	}
+	s.gasLeft -= gas
+	return true
+}
+
+// OutOfGas returns whether the metered gas has run out.
+func (s *ContractRef) OutOfGas() bool {
+	return s.outOfGas
+}
+
+// ChargeRead implement state.CacheMeter
+func (s *ContractRef) ChargeRead(slots uint64) {
+	s.UseGas(slots * StorageReadGas)
+}
+
+// ChargeWrite implement state.CacheMeter
+func (s *ContractRef) ChargeWrite(slots uint64) {
+	s.UseGas(slots * StorageWriteGas)
+}
+
 const (
 	MAX_EXECUTE_CONTEXT = 128
 )
@@ -120,13 +258,27 @@ type Context struct {
 	Caller          common.Address
 	ContractAddress common.Address
 	Payload         []byte
+
+	snapshot int // stateDB revision taken when the context pushed
 }
 
-// PushContext push current context to smart contract
+// PushContext push current context to smart contract, and take a snapshot of stateDB for the frame
 func (s *ContractRef) PushContext(context *Context) {
+	if s.revertFailed && s.stateDB != nil {
+		context.snapshot = s.stateDB.Snapshot()
+	}
 	s.contexts = append(s.contexts, context)
 }
 
+// RevertContext revert storage writes and logs of the current context frame
+func (s *ContractRef) RevertContext() {
+	ctx := s.CurrentContext()
+	if ctx == nil || s.stateDB == nil {
+		return
+	}
+	s.stateDB.RevertToSnapshot(ctx.snapshot)
+}
+
 // CurrentContext return smart contract current context
 func (s *ContractRef) CurrentContext() *Context {
 	if len(s.contexts) < 1 {
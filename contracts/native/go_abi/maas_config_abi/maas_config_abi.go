@@ -27,28 +27,58 @@ var (
 )
 
 var (
+	MethodApprove = "approve"
+
 	MethodBlockAccount = "blockAccount"
 
+	MethodCancel = "cancel"
+
 	MethodChangeOwner = "changeOwner"
 
 	MethodEnableGasManage = "enableGasManage"
 
+	MethodEnableNodeWhite = "enableNodeWhite"
+
+	MethodExecute = "execute"
+
+	MethodPropose = "propose"
+
 	MethodSetAdmins = "setAdmins"
 
 	MethodSetGasManager = "setGasManager"
 
 	MethodSetGasUsers = "setGasUsers"
 
+	MethodSetGovernance = "setGovernance"
+
+	MethodSetNodeWhitelist = "setNodeWhitelist"
+
 	MethodGetAdminList = "getAdminList"
 
+	MethodGetAdminListPage = "getAdminListPage"
+
 	MethodGetBlacklist = "getBlacklist"
 
+	MethodGetBlacklistPage = "getBlacklistPage"
+
 	MethodGetGasManagerList = "getGasManagerList"
 
+	MethodGetGasManagerListPage = "getGasManagerListPage"
+
 	MethodGetGasUserList = "getGasUserList"
 
+	MethodGetGasUserListPage = "getGasUserListPage"
+
+	MethodGetGovernance = "getGovernance"
+
+	MethodGetNodeWhitelist = "getNodeWhitelist"
+
+	MethodGetNodeWhitelistPage = "getNodeWhitelistPage"
+
 	MethodGetOwner = "getOwner"
 
+	MethodGetProposal = "getProposal"
+
 	MethodIsAdmin = "isAdmin"
 
 	MethodIsBlocked = "isBlocked"
@@ -59,6 +89,10 @@ var (
 
 	MethodIsGasUser = "isGasUser"
 
+	MethodIsInNodeWhitelist = "isInNodeWhitelist"
+
+	MethodIsNodeWhiteEnabled = "isNodeWhiteEnabled"
+
 	MethodName = "name"
 
 	EventBlockAccount = "BlockAccount"
@@ -67,15 +101,31 @@ var (
 
 	EventEnableGasManage = "EnableGasManage"
 
+	EventEnableNodeWhite = "EnableNodeWhite"
+
+	EventProposalApproved = "ProposalApproved"
+
+	EventProposalCancelled = "ProposalCancelled"
+
+	EventProposalCreated = "ProposalCreated"
+
+	EventProposalExecuted = "ProposalExecuted"
+
+	EventProposalQueued = "ProposalQueued"
+
 	EventSetAdmins = "SetAdmins"
 
 	EventSetGasManager = "SetGasManager"
 
 	EventSetGasUsers = "SetGasUsers"
+
+	EventSetGovernance = "SetGovernance"
+
+	EventSetNodeWhitelist = "SetNodeWhitelist"
 )
 
 // MaasConfigABI is the input ABI used to generate the binding from.
-const MaasConfigABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"doBlock\",\"type\":\"bool\"}],\"name\":\"BlockAccount\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"ChangeOwner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"EnableGasManage\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"SetAdmins\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isManager\",\"type\":\"bool\"}],\"name\":\"SetGasManager\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"SetGasUsers\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"doBlock\",\"type\":\"bool\"}],\"name\":\"blockAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"changeOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"enableGasManage\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAdminList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlacklist\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGasManagerList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGasUserList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isBlocked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isGasManageEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isGasManager\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isGasUser\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"setAdmins\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isManager\",\"type\":\"bool\"}],\"name\":\"setGasManager\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"setGasUsers\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const MaasConfigABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"doBlock\",\"type\":\"bool\"}],\"name\":\"BlockAccount\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"oldOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"ChangeOwner\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"EnableGasManage\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"EnableNodeWhite\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approver\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"approvals\",\"type\":\"uint256\"}],\"name\":\"ProposalApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"canceller\",\"type\":\"address\"}],\"name\":\"ProposalCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"proposer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"}],\"name\":\"ProposalCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"ProposalExecuted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"eta\",\"type\":\"uint256\"}],\"name\":\"ProposalQueued\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"SetAdmins\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"isManager\",\"type\":\"bool\"}],\"name\":\"SetGasManager\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"SetGasUsers\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"name\":\"SetGovernance\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"SetNodeWhitelist\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"doBlock\",\"type\":\"bool\"}],\"name\":\"blockAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"cancel\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"changeOwner\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"enableGasManage\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bool\",\"name\":\"doEnable\",\"type\":\"bool\"}],\"name\":\"enableNodeWhite\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"execute\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getAdminList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"}],\"name\":\"getAdminListPage\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"list\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlacklist\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"}],\"name\":\"getBlacklistPage\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"list\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGasManagerList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"}],\"name\":\"getGasManagerListPage\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"list\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGasUserList\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"}],\"name\":\"getGasUserListPage\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"list\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getGovernance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNodeWhitelist\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"start\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"}],\"name\":\"getNodeWhitelistPage\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"list\",\"type\":\"address[]\"},{\"internalType\":\"uint256\",\"name\":\"total\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"getProposal\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isBlocked\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isGasManageEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isGasManager\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isGasUser\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"isInNodeWhitelist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isNodeWhiteEnabled\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"setAdmins\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"isManager\",\"type\":\"bool\"}],\"name\":\"setGasManager\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"setGasUsers\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delay\",\"type\":\"uint256\"}],\"name\":\"setGovernance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"addrs\",\"type\":\"address[]\"},{\"internalType\":\"bool\",\"name\":\"addOrRemove\",\"type\":\"bool\"}],\"name\":\"setNodeWhitelist\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // MaasConfig is an auto generated Go binding around an Ethereum contract.
 type MaasConfig struct {
@@ -250,6 +300,51 @@ func (_MaasConfig *MaasConfigCallerSession) GetAdminList() (string, error) {
 	return _MaasConfig.Contract.GetAdminList(&_MaasConfig.CallOpts)
 }
 
+// GetAdminListPage is a free data retrieval call binding the contract method 0x8f6dc231.
+//
+// Solidity: function getAdminListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCaller) GetAdminListPage(opts *bind.CallOpts, start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getAdminListPage", start, limit)
+
+	outstruct := new(struct {
+		List  []common.Address
+		Total *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.List = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetAdminListPage is a free data retrieval call binding the contract method 0x8f6dc231.
+//
+// Solidity: function getAdminListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigSession) GetAdminListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetAdminListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetAdminListPage is a free data retrieval call binding the contract method 0x8f6dc231.
+//
+// Solidity: function getAdminListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCallerSession) GetAdminListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetAdminListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
 // GetBlacklist is a free data retrieval call binding the contract method 0x338d6c30.
 //
 // Solidity: function getBlacklist() view returns(string)
@@ -281,6 +376,51 @@ func (_MaasConfig *MaasConfigCallerSession) GetBlacklist() (string, error) {
 	return _MaasConfig.Contract.GetBlacklist(&_MaasConfig.CallOpts)
 }
 
+// GetBlacklistPage is a free data retrieval call binding the contract method 0x49fdd1b9.
+//
+// Solidity: function getBlacklistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCaller) GetBlacklistPage(opts *bind.CallOpts, start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getBlacklistPage", start, limit)
+
+	outstruct := new(struct {
+		List  []common.Address
+		Total *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.List = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetBlacklistPage is a free data retrieval call binding the contract method 0x49fdd1b9.
+//
+// Solidity: function getBlacklistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigSession) GetBlacklistPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetBlacklistPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetBlacklistPage is a free data retrieval call binding the contract method 0x49fdd1b9.
+//
+// Solidity: function getBlacklistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCallerSession) GetBlacklistPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetBlacklistPage(&_MaasConfig.CallOpts, start, limit)
+}
+
 // GetGasManagerList is a free data retrieval call binding the contract method 0xffa8ad5e.
 //
 // Solidity: function getGasManagerList() view returns(string)
@@ -312,6 +452,51 @@ func (_MaasConfig *MaasConfigCallerSession) GetGasManagerList() (string, error)
 	return _MaasConfig.Contract.GetGasManagerList(&_MaasConfig.CallOpts)
 }
 
+// GetGasManagerListPage is a free data retrieval call binding the contract method 0x78f280cb.
+//
+// Solidity: function getGasManagerListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCaller) GetGasManagerListPage(opts *bind.CallOpts, start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getGasManagerListPage", start, limit)
+
+	outstruct := new(struct {
+		List  []common.Address
+		Total *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.List = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetGasManagerListPage is a free data retrieval call binding the contract method 0x78f280cb.
+//
+// Solidity: function getGasManagerListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigSession) GetGasManagerListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGasManagerListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetGasManagerListPage is a free data retrieval call binding the contract method 0x78f280cb.
+//
+// Solidity: function getGasManagerListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCallerSession) GetGasManagerListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGasManagerListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
 // GetGasUserList is a free data retrieval call binding the contract method 0xd46af6ae.
 //
 // Solidity: function getGasUserList() view returns(string)
@@ -343,6 +528,172 @@ func (_MaasConfig *MaasConfigCallerSession) GetGasUserList() (string, error) {
 	return _MaasConfig.Contract.GetGasUserList(&_MaasConfig.CallOpts)
 }
 
+// GetGasUserListPage is a free data retrieval call binding the contract method 0x0a09b768.
+//
+// Solidity: function getGasUserListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCaller) GetGasUserListPage(opts *bind.CallOpts, start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getGasUserListPage", start, limit)
+
+	outstruct := new(struct {
+		List  []common.Address
+		Total *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.List = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetGasUserListPage is a free data retrieval call binding the contract method 0x0a09b768.
+//
+// Solidity: function getGasUserListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigSession) GetGasUserListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGasUserListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetGasUserListPage is a free data retrieval call binding the contract method 0x0a09b768.
+//
+// Solidity: function getGasUserListPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCallerSession) GetGasUserListPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGasUserListPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigCaller) GetGovernance(opts *bind.CallOpts) (struct {
+	Threshold *big.Int
+	Delay     *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getGovernance")
+
+	outstruct := new(struct {
+		Threshold *big.Int
+		Delay     *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Threshold = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Delay = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigSession) GetGovernance() (struct {
+	Threshold *big.Int
+	Delay     *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGovernance(&_MaasConfig.CallOpts)
+}
+
+// GetGovernance is a free data retrieval call binding the contract method 0x289b3c0d.
+//
+// Solidity: function getGovernance() view returns(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigCallerSession) GetGovernance() (struct {
+	Threshold *big.Int
+	Delay     *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetGovernance(&_MaasConfig.CallOpts)
+}
+
+// GetNodeWhitelist is a free data retrieval call binding the contract method 0xcb62a163.
+//
+// Solidity: function getNodeWhitelist() view returns(string)
+func (_MaasConfig *MaasConfigCaller) GetNodeWhitelist(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getNodeWhitelist")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetNodeWhitelist is a free data retrieval call binding the contract method 0xcb62a163.
+//
+// Solidity: function getNodeWhitelist() view returns(string)
+func (_MaasConfig *MaasConfigSession) GetNodeWhitelist() (string, error) {
+	return _MaasConfig.Contract.GetNodeWhitelist(&_MaasConfig.CallOpts)
+}
+
+// GetNodeWhitelist is a free data retrieval call binding the contract method 0xcb62a163.
+//
+// Solidity: function getNodeWhitelist() view returns(string)
+func (_MaasConfig *MaasConfigCallerSession) GetNodeWhitelist() (string, error) {
+	return _MaasConfig.Contract.GetNodeWhitelist(&_MaasConfig.CallOpts)
+}
+
+// GetNodeWhitelistPage is a free data retrieval call binding the contract method 0x65071211.
+//
+// Solidity: function getNodeWhitelistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCaller) GetNodeWhitelistPage(opts *bind.CallOpts, start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getNodeWhitelistPage", start, limit)
+
+	outstruct := new(struct {
+		List  []common.Address
+		Total *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.List = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Total = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetNodeWhitelistPage is a free data retrieval call binding the contract method 0x65071211.
+//
+// Solidity: function getNodeWhitelistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigSession) GetNodeWhitelistPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetNodeWhitelistPage(&_MaasConfig.CallOpts, start, limit)
+}
+
+// GetNodeWhitelistPage is a free data retrieval call binding the contract method 0x65071211.
+//
+// Solidity: function getNodeWhitelistPage(uint256 start, uint256 limit) view returns(address[] list, uint256 total)
+func (_MaasConfig *MaasConfigCallerSession) GetNodeWhitelistPage(start *big.Int, limit *big.Int) (struct {
+	List  []common.Address
+	Total *big.Int
+}, error) {
+	return _MaasConfig.Contract.GetNodeWhitelistPage(&_MaasConfig.CallOpts, start, limit)
+}
+
 // GetOwner is a free data retrieval call binding the contract method 0x893d20e8.
 //
 // Solidity: function getOwner() view returns(address)
@@ -374,6 +725,37 @@ func (_MaasConfig *MaasConfigCallerSession) GetOwner() (common.Address, error) {
 	return _MaasConfig.Contract.GetOwner(&_MaasConfig.CallOpts)
 }
 
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 id) view returns(string)
+func (_MaasConfig *MaasConfigCaller) GetProposal(opts *bind.CallOpts, id *big.Int) (string, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "getProposal", id)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 id) view returns(string)
+func (_MaasConfig *MaasConfigSession) GetProposal(id *big.Int) (string, error) {
+	return _MaasConfig.Contract.GetProposal(&_MaasConfig.CallOpts, id)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 id) view returns(string)
+func (_MaasConfig *MaasConfigCallerSession) GetProposal(id *big.Int) (string, error) {
+	return _MaasConfig.Contract.GetProposal(&_MaasConfig.CallOpts, id)
+}
+
 // IsAdmin is a free data retrieval call binding the contract method 0x24d7806c.
 //
 // Solidity: function isAdmin(address addr) view returns(bool)
@@ -529,6 +911,68 @@ func (_MaasConfig *MaasConfigCallerSession) IsGasUser(addr common.Address) (bool
 	return _MaasConfig.Contract.IsGasUser(&_MaasConfig.CallOpts, addr)
 }
 
+// IsInNodeWhitelist is a free data retrieval call binding the contract method 0x31e3d92b.
+//
+// Solidity: function isInNodeWhitelist(address addr) view returns(bool)
+func (_MaasConfig *MaasConfigCaller) IsInNodeWhitelist(opts *bind.CallOpts, addr common.Address) (bool, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "isInNodeWhitelist", addr)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInNodeWhitelist is a free data retrieval call binding the contract method 0x31e3d92b.
+//
+// Solidity: function isInNodeWhitelist(address addr) view returns(bool)
+func (_MaasConfig *MaasConfigSession) IsInNodeWhitelist(addr common.Address) (bool, error) {
+	return _MaasConfig.Contract.IsInNodeWhitelist(&_MaasConfig.CallOpts, addr)
+}
+
+// IsInNodeWhitelist is a free data retrieval call binding the contract method 0x31e3d92b.
+//
+// Solidity: function isInNodeWhitelist(address addr) view returns(bool)
+func (_MaasConfig *MaasConfigCallerSession) IsInNodeWhitelist(addr common.Address) (bool, error) {
+	return _MaasConfig.Contract.IsInNodeWhitelist(&_MaasConfig.CallOpts, addr)
+}
+
+// IsNodeWhiteEnabled is a free data retrieval call binding the contract method 0xba4e9e75.
+//
+// Solidity: function isNodeWhiteEnabled() view returns(bool)
+func (_MaasConfig *MaasConfigCaller) IsNodeWhiteEnabled(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MaasConfig.contract.Call(opts, &out, "isNodeWhiteEnabled")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsNodeWhiteEnabled is a free data retrieval call binding the contract method 0xba4e9e75.
+//
+// Solidity: function isNodeWhiteEnabled() view returns(bool)
+func (_MaasConfig *MaasConfigSession) IsNodeWhiteEnabled() (bool, error) {
+	return _MaasConfig.Contract.IsNodeWhiteEnabled(&_MaasConfig.CallOpts)
+}
+
+// IsNodeWhiteEnabled is a free data retrieval call binding the contract method 0xba4e9e75.
+//
+// Solidity: function isNodeWhiteEnabled() view returns(bool)
+func (_MaasConfig *MaasConfigCallerSession) IsNodeWhiteEnabled() (bool, error) {
+	return _MaasConfig.Contract.IsNodeWhiteEnabled(&_MaasConfig.CallOpts)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
@@ -560,6 +1004,27 @@ func (_MaasConfig *MaasConfigCallerSession) Name() (string, error) {
 	return _MaasConfig.Contract.Name(&_MaasConfig.CallOpts)
 }
 
+// Approve is a paid mutator transaction binding the contract method 0xb759f954.
+//
+// Solidity: function approve(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) Approve(opts *bind.TransactOpts, id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "approve", id)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xb759f954.
+//
+// Solidity: function approve(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigSession) Approve(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Approve(&_MaasConfig.TransactOpts, id)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0xb759f954.
+//
+// Solidity: function approve(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) Approve(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Approve(&_MaasConfig.TransactOpts, id)
+}
+
 // BlockAccount is a paid mutator transaction binding the contract method 0x52c163bb.
 //
 // Solidity: function blockAccount(address addr, bool doBlock) returns(bool)
@@ -581,6 +1046,27 @@ func (_MaasConfig *MaasConfigTransactorSession) BlockAccount(addr common.Address
 	return _MaasConfig.Contract.BlockAccount(&_MaasConfig.TransactOpts, addr, doBlock)
 }
 
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) Cancel(opts *bind.TransactOpts, id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "cancel", id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigSession) Cancel(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Cancel(&_MaasConfig.TransactOpts, id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) Cancel(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Cancel(&_MaasConfig.TransactOpts, id)
+}
+
 // ChangeOwner is a paid mutator transaction binding the contract method 0xa6f9dae1.
 //
 // Solidity: function changeOwner(address addr) returns(bool)
@@ -623,6 +1109,69 @@ func (_MaasConfig *MaasConfigTransactorSession) EnableGasManage(doEnable bool) (
 	return _MaasConfig.Contract.EnableGasManage(&_MaasConfig.TransactOpts, doEnable)
 }
 
+// EnableNodeWhite is a paid mutator transaction binding the contract method 0x1dc4f476.
+//
+// Solidity: function enableNodeWhite(bool doEnable) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) EnableNodeWhite(opts *bind.TransactOpts, doEnable bool) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "enableNodeWhite", doEnable)
+}
+
+// EnableNodeWhite is a paid mutator transaction binding the contract method 0x1dc4f476.
+//
+// Solidity: function enableNodeWhite(bool doEnable) returns(bool)
+func (_MaasConfig *MaasConfigSession) EnableNodeWhite(doEnable bool) (*types.Transaction, error) {
+	return _MaasConfig.Contract.EnableNodeWhite(&_MaasConfig.TransactOpts, doEnable)
+}
+
+// EnableNodeWhite is a paid mutator transaction binding the contract method 0x1dc4f476.
+//
+// Solidity: function enableNodeWhite(bool doEnable) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) EnableNodeWhite(doEnable bool) (*types.Transaction, error) {
+	return _MaasConfig.Contract.EnableNodeWhite(&_MaasConfig.TransactOpts, doEnable)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) Execute(opts *bind.TransactOpts, id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "execute", id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigSession) Execute(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Execute(&_MaasConfig.TransactOpts, id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 id) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) Execute(id *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Execute(&_MaasConfig.TransactOpts, id)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x37558af5.
+//
+// Solidity: function propose(bytes payload) returns(uint256 id)
+func (_MaasConfig *MaasConfigTransactor) Propose(opts *bind.TransactOpts, payload []byte) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "propose", payload)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x37558af5.
+//
+// Solidity: function propose(bytes payload) returns(uint256 id)
+func (_MaasConfig *MaasConfigSession) Propose(payload []byte) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Propose(&_MaasConfig.TransactOpts, payload)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x37558af5.
+//
+// Solidity: function propose(bytes payload) returns(uint256 id)
+func (_MaasConfig *MaasConfigTransactorSession) Propose(payload []byte) (*types.Transaction, error) {
+	return _MaasConfig.Contract.Propose(&_MaasConfig.TransactOpts, payload)
+}
+
 // SetAdmins is a paid mutator transaction binding the contract method 0x030e2c88.
 //
 // Solidity: function setAdmins(address[] addrs, bool addOrRemove) returns(bool)
@@ -686,17 +1235,59 @@ func (_MaasConfig *MaasConfigTransactorSession) SetGasUsers(addrs []common.Addre
 	return _MaasConfig.Contract.SetGasUsers(&_MaasConfig.TransactOpts, addrs, addOrRemove)
 }
 
-// MaasConfigBlockAccountIterator is returned from FilterBlockAccount and is used to iterate over the raw logs and unpacked data for BlockAccount events raised by the MaasConfig contract.
-type MaasConfigBlockAccountIterator struct {
-	Event *MaasConfigBlockAccount // Event containing the contract specifics and raw log
+// SetGovernance is a paid mutator transaction binding the contract method 0x64b6f1c2.
+//
+// Solidity: function setGovernance(uint256 threshold, uint256 delay) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) SetGovernance(opts *bind.TransactOpts, threshold *big.Int, delay *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "setGovernance", threshold, delay)
+}
 
-	contract *bind.BoundContract // Generic contract to use for unpacking event data
-	event    string              // Event name to use for unpacking event data
+// SetGovernance is a paid mutator transaction binding the contract method 0x64b6f1c2.
+//
+// Solidity: function setGovernance(uint256 threshold, uint256 delay) returns(bool)
+func (_MaasConfig *MaasConfigSession) SetGovernance(threshold *big.Int, delay *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.SetGovernance(&_MaasConfig.TransactOpts, threshold, delay)
+}
 
-	logs chan types.Log        // Log channel receiving the found contract events
-	sub  ethereum.Subscription // Subscription for errors, completion and termination
-	done bool                  // Whether the subscription completed delivering logs
-	fail error                 // Occurred error to stop iteration
+// SetGovernance is a paid mutator transaction binding the contract method 0x64b6f1c2.
+//
+// Solidity: function setGovernance(uint256 threshold, uint256 delay) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) SetGovernance(threshold *big.Int, delay *big.Int) (*types.Transaction, error) {
+	return _MaasConfig.Contract.SetGovernance(&_MaasConfig.TransactOpts, threshold, delay)
+}
+
+// SetNodeWhitelist is a paid mutator transaction binding the contract method 0xe9993089.
+//
+// Solidity: function setNodeWhitelist(address[] addrs, bool addOrRemove) returns(bool)
+func (_MaasConfig *MaasConfigTransactor) SetNodeWhitelist(opts *bind.TransactOpts, addrs []common.Address, addOrRemove bool) (*types.Transaction, error) {
+	return _MaasConfig.contract.Transact(opts, "setNodeWhitelist", addrs, addOrRemove)
+}
+
+// SetNodeWhitelist is a paid mutator transaction binding the contract method 0xe9993089.
+//
+// Solidity: function setNodeWhitelist(address[] addrs, bool addOrRemove) returns(bool)
+func (_MaasConfig *MaasConfigSession) SetNodeWhitelist(addrs []common.Address, addOrRemove bool) (*types.Transaction, error) {
+	return _MaasConfig.Contract.SetNodeWhitelist(&_MaasConfig.TransactOpts, addrs, addOrRemove)
+}
+
+// SetNodeWhitelist is a paid mutator transaction binding the contract method 0xe9993089.
+//
+// Solidity: function setNodeWhitelist(address[] addrs, bool addOrRemove) returns(bool)
+func (_MaasConfig *MaasConfigTransactorSession) SetNodeWhitelist(addrs []common.Address, addOrRemove bool) (*types.Transaction, error) {
+	return _MaasConfig.Contract.SetNodeWhitelist(&_MaasConfig.TransactOpts, addrs, addOrRemove)
+}
+
+// MaasConfigBlockAccountIterator is returned from FilterBlockAccount and is used to iterate over the raw logs and unpacked data for BlockAccount events raised by the MaasConfig contract.
+type MaasConfigBlockAccountIterator struct {
+	Event *MaasConfigBlockAccount // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
 }
 
 // Next advances the iterator to the subsequent event, returning whether there
@@ -1118,9 +1709,9 @@ func (_MaasConfig *MaasConfigFilterer) ParseEnableGasManage(log types.Log) (*Maa
 	return event, nil
 }
 
-// MaasConfigSetAdminsIterator is returned from FilterSetAdmins and is used to iterate over the raw logs and unpacked data for SetAdmins events raised by the MaasConfig contract.
-type MaasConfigSetAdminsIterator struct {
-	Event *MaasConfigSetAdmins // Event containing the contract specifics and raw log
+// MaasConfigEnableNodeWhiteIterator is returned from FilterEnableNodeWhite and is used to iterate over the raw logs and unpacked data for EnableNodeWhite events raised by the MaasConfig contract.
+type MaasConfigEnableNodeWhiteIterator struct {
+	Event *MaasConfigEnableNodeWhite // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1134,7 +1725,7 @@ type MaasConfigSetAdminsIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *MaasConfigSetAdminsIterator) Next() bool {
+func (it *MaasConfigEnableNodeWhiteIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1143,7 +1734,7 @@ func (it *MaasConfigSetAdminsIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(MaasConfigSetAdmins)
+			it.Event = new(MaasConfigEnableNodeWhite)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1158,7 +1749,7 @@ func (it *MaasConfigSetAdminsIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(MaasConfigSetAdmins)
+		it.Event = new(MaasConfigEnableNodeWhite)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1174,42 +1765,41 @@ func (it *MaasConfigSetAdminsIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *MaasConfigSetAdminsIterator) Error() error {
+func (it *MaasConfigEnableNodeWhiteIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *MaasConfigSetAdminsIterator) Close() error {
+func (it *MaasConfigEnableNodeWhiteIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// MaasConfigSetAdmins represents a SetAdmins event raised by the MaasConfig contract.
-type MaasConfigSetAdmins struct {
-	Addrs       []common.Address
-	AddOrRemove bool
-	Raw         types.Log // Blockchain specific contextual infos
+// MaasConfigEnableNodeWhite represents a EnableNodeWhite event raised by the MaasConfig contract.
+type MaasConfigEnableNodeWhite struct {
+	DoEnable bool
+	Raw      types.Log // Blockchain specific contextual infos
 }
 
-// FilterSetAdmins is a free log retrieval operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+// FilterEnableNodeWhite is a free log retrieval operation binding the contract event 0x8ad912d00d9bb358589af50d96f1aa99f69f5397d22aa98de8133c3428b4780a.
 //
-// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) FilterSetAdmins(opts *bind.FilterOpts) (*MaasConfigSetAdminsIterator, error) {
+// Solidity: event EnableNodeWhite(bool doEnable)
+func (_MaasConfig *MaasConfigFilterer) FilterEnableNodeWhite(opts *bind.FilterOpts) (*MaasConfigEnableNodeWhiteIterator, error) {
 
-	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetAdmins")
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "EnableNodeWhite")
 	if err != nil {
 		return nil, err
 	}
-	return &MaasConfigSetAdminsIterator{contract: _MaasConfig.contract, event: "SetAdmins", logs: logs, sub: sub}, nil
+	return &MaasConfigEnableNodeWhiteIterator{contract: _MaasConfig.contract, event: "EnableNodeWhite", logs: logs, sub: sub}, nil
 }
 
-// WatchSetAdmins is a free log subscription operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+// WatchEnableNodeWhite is a free log subscription operation binding the contract event 0x8ad912d00d9bb358589af50d96f1aa99f69f5397d22aa98de8133c3428b4780a.
 //
-// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) WatchSetAdmins(opts *bind.WatchOpts, sink chan<- *MaasConfigSetAdmins) (event.Subscription, error) {
+// Solidity: event EnableNodeWhite(bool doEnable)
+func (_MaasConfig *MaasConfigFilterer) WatchEnableNodeWhite(opts *bind.WatchOpts, sink chan<- *MaasConfigEnableNodeWhite) (event.Subscription, error) {
 
-	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetAdmins")
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "EnableNodeWhite")
 	if err != nil {
 		return nil, err
 	}
@@ -1219,8 +1809,8 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetAdmins(opts *bind.WatchOpts, sink
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(MaasConfigSetAdmins)
-				if err := _MaasConfig.contract.UnpackLog(event, "SetAdmins", log); err != nil {
+				event := new(MaasConfigEnableNodeWhite)
+				if err := _MaasConfig.contract.UnpackLog(event, "EnableNodeWhite", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1241,21 +1831,21 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetAdmins(opts *bind.WatchOpts, sink
 	}), nil
 }
 
-// ParseSetAdmins is a log parse operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+// ParseEnableNodeWhite is a log parse operation binding the contract event 0x8ad912d00d9bb358589af50d96f1aa99f69f5397d22aa98de8133c3428b4780a.
 //
-// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) ParseSetAdmins(log types.Log) (*MaasConfigSetAdmins, error) {
-	event := new(MaasConfigSetAdmins)
-	if err := _MaasConfig.contract.UnpackLog(event, "SetAdmins", log); err != nil {
+// Solidity: event EnableNodeWhite(bool doEnable)
+func (_MaasConfig *MaasConfigFilterer) ParseEnableNodeWhite(log types.Log) (*MaasConfigEnableNodeWhite, error) {
+	event := new(MaasConfigEnableNodeWhite)
+	if err := _MaasConfig.contract.UnpackLog(event, "EnableNodeWhite", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// MaasConfigSetGasManagerIterator is returned from FilterSetGasManager and is used to iterate over the raw logs and unpacked data for SetGasManager events raised by the MaasConfig contract.
-type MaasConfigSetGasManagerIterator struct {
-	Event *MaasConfigSetGasManager // Event containing the contract specifics and raw log
+// MaasConfigProposalApprovedIterator is returned from FilterProposalApproved and is used to iterate over the raw logs and unpacked data for ProposalApproved events raised by the MaasConfig contract.
+type MaasConfigProposalApprovedIterator struct {
+	Event *MaasConfigProposalApproved // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1269,7 +1859,7 @@ type MaasConfigSetGasManagerIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *MaasConfigSetGasManagerIterator) Next() bool {
+func (it *MaasConfigProposalApprovedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1278,7 +1868,7 @@ func (it *MaasConfigSetGasManagerIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(MaasConfigSetGasManager)
+			it.Event = new(MaasConfigProposalApproved)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1293,7 +1883,7 @@ func (it *MaasConfigSetGasManagerIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(MaasConfigSetGasManager)
+		it.Event = new(MaasConfigProposalApproved)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1309,52 +1899,61 @@ func (it *MaasConfigSetGasManagerIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *MaasConfigSetGasManagerIterator) Error() error {
+func (it *MaasConfigProposalApprovedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *MaasConfigSetGasManagerIterator) Close() error {
+func (it *MaasConfigProposalApprovedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// MaasConfigSetGasManager represents a SetGasManager event raised by the MaasConfig contract.
-type MaasConfigSetGasManager struct {
-	Addr      common.Address
-	IsManager bool
+// MaasConfigProposalApproved represents a ProposalApproved event raised by the MaasConfig contract.
+type MaasConfigProposalApproved struct {
+	Id        *big.Int
+	Approver  common.Address
+	Approvals *big.Int
 	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterSetGasManager is a free log retrieval operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+// FilterProposalApproved is a free log retrieval operation binding the contract event 0x2f0f51d2f12c71357e60d575d47e93e081dbaf32072245ab1e01540f0aadbf1a.
 //
-// Solidity: event SetGasManager(address indexed addr, bool isManager)
-func (_MaasConfig *MaasConfigFilterer) FilterSetGasManager(opts *bind.FilterOpts, addr []common.Address) (*MaasConfigSetGasManagerIterator, error) {
+// Solidity: event ProposalApproved(uint256 indexed id, address indexed approver, uint256 approvals)
+func (_MaasConfig *MaasConfigFilterer) FilterProposalApproved(opts *bind.FilterOpts, id []*big.Int, approver []common.Address) (*MaasConfigProposalApprovedIterator, error) {
 
-	var addrRule []interface{}
-	for _, addrItem := range addr {
-		addrRule = append(addrRule, addrItem)
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var approverRule []interface{}
+	for _, approverItem := range approver {
+		approverRule = append(approverRule, approverItem)
 	}
 
-	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetGasManager", addrRule)
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "ProposalApproved", idRule, approverRule)
 	if err != nil {
 		return nil, err
 	}
-	return &MaasConfigSetGasManagerIterator{contract: _MaasConfig.contract, event: "SetGasManager", logs: logs, sub: sub}, nil
+	return &MaasConfigProposalApprovedIterator{contract: _MaasConfig.contract, event: "ProposalApproved", logs: logs, sub: sub}, nil
 }
 
-// WatchSetGasManager is a free log subscription operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+// WatchProposalApproved is a free log subscription operation binding the contract event 0x2f0f51d2f12c71357e60d575d47e93e081dbaf32072245ab1e01540f0aadbf1a.
 //
-// Solidity: event SetGasManager(address indexed addr, bool isManager)
-func (_MaasConfig *MaasConfigFilterer) WatchSetGasManager(opts *bind.WatchOpts, sink chan<- *MaasConfigSetGasManager, addr []common.Address) (event.Subscription, error) {
+// Solidity: event ProposalApproved(uint256 indexed id, address indexed approver, uint256 approvals)
+func (_MaasConfig *MaasConfigFilterer) WatchProposalApproved(opts *bind.WatchOpts, sink chan<- *MaasConfigProposalApproved, id []*big.Int, approver []common.Address) (event.Subscription, error) {
 
-	var addrRule []interface{}
-	for _, addrItem := range addr {
-		addrRule = append(addrRule, addrItem)
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var approverRule []interface{}
+	for _, approverItem := range approver {
+		approverRule = append(approverRule, approverItem)
 	}
 
-	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetGasManager", addrRule)
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "ProposalApproved", idRule, approverRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1364,8 +1963,8 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetGasManager(opts *bind.WatchOpts,
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(MaasConfigSetGasManager)
-				if err := _MaasConfig.contract.UnpackLog(event, "SetGasManager", log); err != nil {
+				event := new(MaasConfigProposalApproved)
+				if err := _MaasConfig.contract.UnpackLog(event, "ProposalApproved", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1386,21 +1985,21 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetGasManager(opts *bind.WatchOpts,
 	}), nil
 }
 
-// ParseSetGasManager is a log parse operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+// ParseProposalApproved is a log parse operation binding the contract event 0x2f0f51d2f12c71357e60d575d47e93e081dbaf32072245ab1e01540f0aadbf1a.
 //
-// Solidity: event SetGasManager(address indexed addr, bool isManager)
-func (_MaasConfig *MaasConfigFilterer) ParseSetGasManager(log types.Log) (*MaasConfigSetGasManager, error) {
-	event := new(MaasConfigSetGasManager)
-	if err := _MaasConfig.contract.UnpackLog(event, "SetGasManager", log); err != nil {
+// Solidity: event ProposalApproved(uint256 indexed id, address indexed approver, uint256 approvals)
+func (_MaasConfig *MaasConfigFilterer) ParseProposalApproved(log types.Log) (*MaasConfigProposalApproved, error) {
+	event := new(MaasConfigProposalApproved)
+	if err := _MaasConfig.contract.UnpackLog(event, "ProposalApproved", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// MaasConfigSetGasUsersIterator is returned from FilterSetGasUsers and is used to iterate over the raw logs and unpacked data for SetGasUsers events raised by the MaasConfig contract.
-type MaasConfigSetGasUsersIterator struct {
-	Event *MaasConfigSetGasUsers // Event containing the contract specifics and raw log
+// MaasConfigProposalCancelledIterator is returned from FilterProposalCancelled and is used to iterate over the raw logs and unpacked data for ProposalCancelled events raised by the MaasConfig contract.
+type MaasConfigProposalCancelledIterator struct {
+	Event *MaasConfigProposalCancelled // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1414,7 +2013,7 @@ type MaasConfigSetGasUsersIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *MaasConfigSetGasUsersIterator) Next() bool {
+func (it *MaasConfigProposalCancelledIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1423,7 +2022,7 @@ func (it *MaasConfigSetGasUsersIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(MaasConfigSetGasUsers)
+			it.Event = new(MaasConfigProposalCancelled)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1438,7 +2037,7 @@ func (it *MaasConfigSetGasUsersIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(MaasConfigSetGasUsers)
+		it.Event = new(MaasConfigProposalCancelled)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1454,42 +2053,60 @@ func (it *MaasConfigSetGasUsersIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *MaasConfigSetGasUsersIterator) Error() error {
+func (it *MaasConfigProposalCancelledIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *MaasConfigSetGasUsersIterator) Close() error {
+func (it *MaasConfigProposalCancelledIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// MaasConfigSetGasUsers represents a SetGasUsers event raised by the MaasConfig contract.
-type MaasConfigSetGasUsers struct {
-	Addrs       []common.Address
-	AddOrRemove bool
-	Raw         types.Log // Blockchain specific contextual infos
+// MaasConfigProposalCancelled represents a ProposalCancelled event raised by the MaasConfig contract.
+type MaasConfigProposalCancelled struct {
+	Id        *big.Int
+	Canceller common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterSetGasUsers is a free log retrieval operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+// FilterProposalCancelled is a free log retrieval operation binding the contract event 0x74c34a008ce735d9fcf0bd03a9b238d212ad4c441c020661f4ffbb6442645b85.
 //
-// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) FilterSetGasUsers(opts *bind.FilterOpts) (*MaasConfigSetGasUsersIterator, error) {
+// Solidity: event ProposalCancelled(uint256 indexed id, address indexed canceller)
+func (_MaasConfig *MaasConfigFilterer) FilterProposalCancelled(opts *bind.FilterOpts, id []*big.Int, canceller []common.Address) (*MaasConfigProposalCancelledIterator, error) {
 
-	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetGasUsers")
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var cancellerRule []interface{}
+	for _, cancellerItem := range canceller {
+		cancellerRule = append(cancellerRule, cancellerItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "ProposalCancelled", idRule, cancellerRule)
 	if err != nil {
 		return nil, err
 	}
-	return &MaasConfigSetGasUsersIterator{contract: _MaasConfig.contract, event: "SetGasUsers", logs: logs, sub: sub}, nil
+	return &MaasConfigProposalCancelledIterator{contract: _MaasConfig.contract, event: "ProposalCancelled", logs: logs, sub: sub}, nil
 }
 
-// WatchSetGasUsers is a free log subscription operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+// WatchProposalCancelled is a free log subscription operation binding the contract event 0x74c34a008ce735d9fcf0bd03a9b238d212ad4c441c020661f4ffbb6442645b85.
 //
-// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) WatchSetGasUsers(opts *bind.WatchOpts, sink chan<- *MaasConfigSetGasUsers) (event.Subscription, error) {
+// Solidity: event ProposalCancelled(uint256 indexed id, address indexed canceller)
+func (_MaasConfig *MaasConfigFilterer) WatchProposalCancelled(opts *bind.WatchOpts, sink chan<- *MaasConfigProposalCancelled, id []*big.Int, canceller []common.Address) (event.Subscription, error) {
 
-	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetGasUsers")
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var cancellerRule []interface{}
+	for _, cancellerItem := range canceller {
+		cancellerRule = append(cancellerRule, cancellerItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "ProposalCancelled", idRule, cancellerRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1499,8 +2116,8 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetGasUsers(opts *bind.WatchOpts, si
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(MaasConfigSetGasUsers)
-				if err := _MaasConfig.contract.UnpackLog(event, "SetGasUsers", log); err != nil {
+				event := new(MaasConfigProposalCancelled)
+				if err := _MaasConfig.contract.UnpackLog(event, "ProposalCancelled", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1521,12 +2138,1140 @@ func (_MaasConfig *MaasConfigFilterer) WatchSetGasUsers(opts *bind.WatchOpts, si
 	}), nil
 }
 
-// ParseSetGasUsers is a log parse operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+// ParseProposalCancelled is a log parse operation binding the contract event 0x74c34a008ce735d9fcf0bd03a9b238d212ad4c441c020661f4ffbb6442645b85.
 //
-// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
-func (_MaasConfig *MaasConfigFilterer) ParseSetGasUsers(log types.Log) (*MaasConfigSetGasUsers, error) {
-	event := new(MaasConfigSetGasUsers)
-	if err := _MaasConfig.contract.UnpackLog(event, "SetGasUsers", log); err != nil {
+// Solidity: event ProposalCancelled(uint256 indexed id, address indexed canceller)
+func (_MaasConfig *MaasConfigFilterer) ParseProposalCancelled(log types.Log) (*MaasConfigProposalCancelled, error) {
+	event := new(MaasConfigProposalCancelled)
+	if err := _MaasConfig.contract.UnpackLog(event, "ProposalCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigProposalCreatedIterator is returned from FilterProposalCreated and is used to iterate over the raw logs and unpacked data for ProposalCreated events raised by the MaasConfig contract.
+type MaasConfigProposalCreatedIterator struct {
+	Event *MaasConfigProposalCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigProposalCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigProposalCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigProposalCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigProposalCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigProposalCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigProposalCreated represents a ProposalCreated event raised by the MaasConfig contract.
+type MaasConfigProposalCreated struct {
+	Id       *big.Int
+	Proposer common.Address
+	Payload  []byte
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalCreated is a free log retrieval operation binding the contract event 0x2de0b4ad2878fff8e0328d13d59576e6595e750e974aec7069bd4e182d2d5aaf.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, bytes payload)
+func (_MaasConfig *MaasConfigFilterer) FilterProposalCreated(opts *bind.FilterOpts, id []*big.Int, proposer []common.Address) (*MaasConfigProposalCreatedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var proposerRule []interface{}
+	for _, proposerItem := range proposer {
+		proposerRule = append(proposerRule, proposerItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "ProposalCreated", idRule, proposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigProposalCreatedIterator{contract: _MaasConfig.contract, event: "ProposalCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalCreated is a free log subscription operation binding the contract event 0x2de0b4ad2878fff8e0328d13d59576e6595e750e974aec7069bd4e182d2d5aaf.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, bytes payload)
+func (_MaasConfig *MaasConfigFilterer) WatchProposalCreated(opts *bind.WatchOpts, sink chan<- *MaasConfigProposalCreated, id []*big.Int, proposer []common.Address) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var proposerRule []interface{}
+	for _, proposerItem := range proposer {
+		proposerRule = append(proposerRule, proposerItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "ProposalCreated", idRule, proposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigProposalCreated)
+				if err := _MaasConfig.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalCreated is a log parse operation binding the contract event 0x2de0b4ad2878fff8e0328d13d59576e6595e750e974aec7069bd4e182d2d5aaf.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, bytes payload)
+func (_MaasConfig *MaasConfigFilterer) ParseProposalCreated(log types.Log) (*MaasConfigProposalCreated, error) {
+	event := new(MaasConfigProposalCreated)
+	if err := _MaasConfig.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigProposalExecutedIterator is returned from FilterProposalExecuted and is used to iterate over the raw logs and unpacked data for ProposalExecuted events raised by the MaasConfig contract.
+type MaasConfigProposalExecutedIterator struct {
+	Event *MaasConfigProposalExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigProposalExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigProposalExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigProposalExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigProposalExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigProposalExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigProposalExecuted represents a ProposalExecuted event raised by the MaasConfig contract.
+type MaasConfigProposalExecuted struct {
+	Id  *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalExecuted is a free log retrieval operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_MaasConfig *MaasConfigFilterer) FilterProposalExecuted(opts *bind.FilterOpts, id []*big.Int) (*MaasConfigProposalExecutedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "ProposalExecuted", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigProposalExecutedIterator{contract: _MaasConfig.contract, event: "ProposalExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalExecuted is a free log subscription operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_MaasConfig *MaasConfigFilterer) WatchProposalExecuted(opts *bind.WatchOpts, sink chan<- *MaasConfigProposalExecuted, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "ProposalExecuted", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigProposalExecuted)
+				if err := _MaasConfig.contract.UnpackLog(event, "ProposalExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalExecuted is a log parse operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_MaasConfig *MaasConfigFilterer) ParseProposalExecuted(log types.Log) (*MaasConfigProposalExecuted, error) {
+	event := new(MaasConfigProposalExecuted)
+	if err := _MaasConfig.contract.UnpackLog(event, "ProposalExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigProposalQueuedIterator is returned from FilterProposalQueued and is used to iterate over the raw logs and unpacked data for ProposalQueued events raised by the MaasConfig contract.
+type MaasConfigProposalQueuedIterator struct {
+	Event *MaasConfigProposalQueued // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigProposalQueuedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigProposalQueued)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigProposalQueued)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigProposalQueuedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigProposalQueuedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigProposalQueued represents a ProposalQueued event raised by the MaasConfig contract.
+type MaasConfigProposalQueued struct {
+	Id  *big.Int
+	Eta *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalQueued is a free log retrieval operation binding the contract event 0x9a2e42fd6722813d69113e7d0079d3d940171428df7373df9c7f7617cfda2892.
+//
+// Solidity: event ProposalQueued(uint256 indexed id, uint256 eta)
+func (_MaasConfig *MaasConfigFilterer) FilterProposalQueued(opts *bind.FilterOpts, id []*big.Int) (*MaasConfigProposalQueuedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "ProposalQueued", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigProposalQueuedIterator{contract: _MaasConfig.contract, event: "ProposalQueued", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalQueued is a free log subscription operation binding the contract event 0x9a2e42fd6722813d69113e7d0079d3d940171428df7373df9c7f7617cfda2892.
+//
+// Solidity: event ProposalQueued(uint256 indexed id, uint256 eta)
+func (_MaasConfig *MaasConfigFilterer) WatchProposalQueued(opts *bind.WatchOpts, sink chan<- *MaasConfigProposalQueued, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "ProposalQueued", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigProposalQueued)
+				if err := _MaasConfig.contract.UnpackLog(event, "ProposalQueued", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalQueued is a log parse operation binding the contract event 0x9a2e42fd6722813d69113e7d0079d3d940171428df7373df9c7f7617cfda2892.
+//
+// Solidity: event ProposalQueued(uint256 indexed id, uint256 eta)
+func (_MaasConfig *MaasConfigFilterer) ParseProposalQueued(log types.Log) (*MaasConfigProposalQueued, error) {
+	event := new(MaasConfigProposalQueued)
+	if err := _MaasConfig.contract.UnpackLog(event, "ProposalQueued", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigSetAdminsIterator is returned from FilterSetAdmins and is used to iterate over the raw logs and unpacked data for SetAdmins events raised by the MaasConfig contract.
+type MaasConfigSetAdminsIterator struct {
+	Event *MaasConfigSetAdmins // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigSetAdminsIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigSetAdmins)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigSetAdmins)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigSetAdminsIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigSetAdminsIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigSetAdmins represents a SetAdmins event raised by the MaasConfig contract.
+type MaasConfigSetAdmins struct {
+	Addrs       []common.Address
+	AddOrRemove bool
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetAdmins is a free log retrieval operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+//
+// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) FilterSetAdmins(opts *bind.FilterOpts) (*MaasConfigSetAdminsIterator, error) {
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetAdmins")
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigSetAdminsIterator{contract: _MaasConfig.contract, event: "SetAdmins", logs: logs, sub: sub}, nil
+}
+
+// WatchSetAdmins is a free log subscription operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+//
+// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) WatchSetAdmins(opts *bind.WatchOpts, sink chan<- *MaasConfigSetAdmins) (event.Subscription, error) {
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetAdmins")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigSetAdmins)
+				if err := _MaasConfig.contract.UnpackLog(event, "SetAdmins", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetAdmins is a log parse operation binding the contract event 0x32150139712a20940bb7e316d890207124a84bd89e7a101f92d4280f5bfcfd7b.
+//
+// Solidity: event SetAdmins(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) ParseSetAdmins(log types.Log) (*MaasConfigSetAdmins, error) {
+	event := new(MaasConfigSetAdmins)
+	if err := _MaasConfig.contract.UnpackLog(event, "SetAdmins", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigSetGasManagerIterator is returned from FilterSetGasManager and is used to iterate over the raw logs and unpacked data for SetGasManager events raised by the MaasConfig contract.
+type MaasConfigSetGasManagerIterator struct {
+	Event *MaasConfigSetGasManager // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigSetGasManagerIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigSetGasManager)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigSetGasManager)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigSetGasManagerIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigSetGasManagerIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigSetGasManager represents a SetGasManager event raised by the MaasConfig contract.
+type MaasConfigSetGasManager struct {
+	Addr      common.Address
+	IsManager bool
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetGasManager is a free log retrieval operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+//
+// Solidity: event SetGasManager(address indexed addr, bool isManager)
+func (_MaasConfig *MaasConfigFilterer) FilterSetGasManager(opts *bind.FilterOpts, addr []common.Address) (*MaasConfigSetGasManagerIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetGasManager", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigSetGasManagerIterator{contract: _MaasConfig.contract, event: "SetGasManager", logs: logs, sub: sub}, nil
+}
+
+// WatchSetGasManager is a free log subscription operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+//
+// Solidity: event SetGasManager(address indexed addr, bool isManager)
+func (_MaasConfig *MaasConfigFilterer) WatchSetGasManager(opts *bind.WatchOpts, sink chan<- *MaasConfigSetGasManager, addr []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetGasManager", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigSetGasManager)
+				if err := _MaasConfig.contract.UnpackLog(event, "SetGasManager", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetGasManager is a log parse operation binding the contract event 0xeaac726c152213e277ad13b3d78c4faa36fdec67f6a49d8bc1d581fb014a4c59.
+//
+// Solidity: event SetGasManager(address indexed addr, bool isManager)
+func (_MaasConfig *MaasConfigFilterer) ParseSetGasManager(log types.Log) (*MaasConfigSetGasManager, error) {
+	event := new(MaasConfigSetGasManager)
+	if err := _MaasConfig.contract.UnpackLog(event, "SetGasManager", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigSetGasUsersIterator is returned from FilterSetGasUsers and is used to iterate over the raw logs and unpacked data for SetGasUsers events raised by the MaasConfig contract.
+type MaasConfigSetGasUsersIterator struct {
+	Event *MaasConfigSetGasUsers // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigSetGasUsersIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigSetGasUsers)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigSetGasUsers)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigSetGasUsersIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigSetGasUsersIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigSetGasUsers represents a SetGasUsers event raised by the MaasConfig contract.
+type MaasConfigSetGasUsers struct {
+	Addrs       []common.Address
+	AddOrRemove bool
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetGasUsers is a free log retrieval operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+//
+// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) FilterSetGasUsers(opts *bind.FilterOpts) (*MaasConfigSetGasUsersIterator, error) {
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetGasUsers")
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigSetGasUsersIterator{contract: _MaasConfig.contract, event: "SetGasUsers", logs: logs, sub: sub}, nil
+}
+
+// WatchSetGasUsers is a free log subscription operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+//
+// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) WatchSetGasUsers(opts *bind.WatchOpts, sink chan<- *MaasConfigSetGasUsers) (event.Subscription, error) {
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetGasUsers")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigSetGasUsers)
+				if err := _MaasConfig.contract.UnpackLog(event, "SetGasUsers", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetGasUsers is a log parse operation binding the contract event 0xae3ffcd4711b2ae3218d54fe92ec1487fcd8b6db2c6033db7651da3c57e7cc45.
+//
+// Solidity: event SetGasUsers(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) ParseSetGasUsers(log types.Log) (*MaasConfigSetGasUsers, error) {
+	event := new(MaasConfigSetGasUsers)
+	if err := _MaasConfig.contract.UnpackLog(event, "SetGasUsers", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigSetGovernanceIterator is returned from FilterSetGovernance and is used to iterate over the raw logs and unpacked data for SetGovernance events raised by the MaasConfig contract.
+type MaasConfigSetGovernanceIterator struct {
+	Event *MaasConfigSetGovernance // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigSetGovernanceIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigSetGovernance)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigSetGovernance)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigSetGovernanceIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigSetGovernanceIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigSetGovernance represents a SetGovernance event raised by the MaasConfig contract.
+type MaasConfigSetGovernance struct {
+	Threshold *big.Int
+	Delay     *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetGovernance is a free log retrieval operation binding the contract event 0x61eadb01e487d1105f5cad64d1bc80283a2714668c86448e7f77d9e5813c0865.
+//
+// Solidity: event SetGovernance(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigFilterer) FilterSetGovernance(opts *bind.FilterOpts) (*MaasConfigSetGovernanceIterator, error) {
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetGovernance")
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigSetGovernanceIterator{contract: _MaasConfig.contract, event: "SetGovernance", logs: logs, sub: sub}, nil
+}
+
+// WatchSetGovernance is a free log subscription operation binding the contract event 0x61eadb01e487d1105f5cad64d1bc80283a2714668c86448e7f77d9e5813c0865.
+//
+// Solidity: event SetGovernance(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigFilterer) WatchSetGovernance(opts *bind.WatchOpts, sink chan<- *MaasConfigSetGovernance) (event.Subscription, error) {
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetGovernance")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigSetGovernance)
+				if err := _MaasConfig.contract.UnpackLog(event, "SetGovernance", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetGovernance is a log parse operation binding the contract event 0x61eadb01e487d1105f5cad64d1bc80283a2714668c86448e7f77d9e5813c0865.
+//
+// Solidity: event SetGovernance(uint256 threshold, uint256 delay)
+func (_MaasConfig *MaasConfigFilterer) ParseSetGovernance(log types.Log) (*MaasConfigSetGovernance, error) {
+	event := new(MaasConfigSetGovernance)
+	if err := _MaasConfig.contract.UnpackLog(event, "SetGovernance", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// MaasConfigSetNodeWhitelistIterator is returned from FilterSetNodeWhitelist and is used to iterate over the raw logs and unpacked data for SetNodeWhitelist events raised by the MaasConfig contract.
+type MaasConfigSetNodeWhitelistIterator struct {
+	Event *MaasConfigSetNodeWhitelist // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MaasConfigSetNodeWhitelistIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MaasConfigSetNodeWhitelist)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MaasConfigSetNodeWhitelist)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MaasConfigSetNodeWhitelistIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MaasConfigSetNodeWhitelistIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MaasConfigSetNodeWhitelist represents a SetNodeWhitelist event raised by the MaasConfig contract.
+type MaasConfigSetNodeWhitelist struct {
+	Addrs       []common.Address
+	AddOrRemove bool
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetNodeWhitelist is a free log retrieval operation binding the contract event 0xf8c7c8a192e91aa67518b7e25bac5c93ac4a8b1dff0a924ad65471dad14d0258.
+//
+// Solidity: event SetNodeWhitelist(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) FilterSetNodeWhitelist(opts *bind.FilterOpts) (*MaasConfigSetNodeWhitelistIterator, error) {
+
+	logs, sub, err := _MaasConfig.contract.FilterLogs(opts, "SetNodeWhitelist")
+	if err != nil {
+		return nil, err
+	}
+	return &MaasConfigSetNodeWhitelistIterator{contract: _MaasConfig.contract, event: "SetNodeWhitelist", logs: logs, sub: sub}, nil
+}
+
+// WatchSetNodeWhitelist is a free log subscription operation binding the contract event 0xf8c7c8a192e91aa67518b7e25bac5c93ac4a8b1dff0a924ad65471dad14d0258.
+//
+// Solidity: event SetNodeWhitelist(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) WatchSetNodeWhitelist(opts *bind.WatchOpts, sink chan<- *MaasConfigSetNodeWhitelist) (event.Subscription, error) {
+
+	logs, sub, err := _MaasConfig.contract.WatchLogs(opts, "SetNodeWhitelist")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MaasConfigSetNodeWhitelist)
+				if err := _MaasConfig.contract.UnpackLog(event, "SetNodeWhitelist", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetNodeWhitelist is a log parse operation binding the contract event 0xf8c7c8a192e91aa67518b7e25bac5c93ac4a8b1dff0a924ad65471dad14d0258.
+//
+// Solidity: event SetNodeWhitelist(address[] addrs, bool addOrRemove)
+func (_MaasConfig *MaasConfigFilterer) ParseSetNodeWhitelist(log types.Log) (*MaasConfigSetNodeWhitelist, error) {
+	event := new(MaasConfigSetNodeWhitelist)
+	if err := _MaasConfig.contract.UnpackLog(event, "SetNodeWhitelist", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -0,0 +1,444 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package native_registry_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodApproveUpgrade = "approveUpgrade"
+
+	MethodGetActivationsJson = "getActivationsJson"
+
+	MethodGetActiveVersion = "getActiveVersion"
+
+	MethodName = "name"
+
+	EventUpgradeApproved = "UpgradeApproved"
+)
+
+// NativeRegistryABI is the input ABI used to generate the binding from.
+const NativeRegistryABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"contractAddr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"activeHeight\",\"type\":\"uint64\"}],\"name\":\"UpgradeApproved\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddr\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"},{\"internalType\":\"uint64\",\"name\":\"activeHeight\",\"type\":\"uint64\"}],\"name\":\"approveUpgrade\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddr\",\"type\":\"address\"}],\"name\":\"getActivationsJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"contractAddr\",\"type\":\"address\"}],\"name\":\"getActiveVersion\",\"outputs\":[{\"internalType\":\"uint64\",\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
+
+// NativeRegistry is an auto generated Go binding around an Ethereum contract.
+type NativeRegistry struct {
+	NativeRegistryCaller     // Read-only binding to the contract
+	NativeRegistryTransactor // Write-only binding to the contract
+	NativeRegistryFilterer   // Log filterer for contract events
+}
+
+// NativeRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NativeRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NativeRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NativeRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NativeRegistrySession struct {
+	Contract     *NativeRegistry   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// NativeRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NativeRegistryCallerSession struct {
+	Contract *NativeRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// NativeRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NativeRegistryTransactorSession struct {
+	Contract     *NativeRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// NativeRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NativeRegistryRaw struct {
+	Contract *NativeRegistry // Generic contract binding to access the raw methods on
+}
+
+// NativeRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NativeRegistryCallerRaw struct {
+	Contract *NativeRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NativeRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NativeRegistryTransactorRaw struct {
+	Contract *NativeRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNativeRegistry creates a new instance of NativeRegistry, bound to a specific deployed contract.
+func NewNativeRegistry(address common.Address, backend bind.ContractBackend) (*NativeRegistry, error) {
+	contract, err := bindNativeRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeRegistry{NativeRegistryCaller: NativeRegistryCaller{contract: contract}, NativeRegistryTransactor: NativeRegistryTransactor{contract: contract}, NativeRegistryFilterer: NativeRegistryFilterer{contract: contract}}, nil
+}
+
+// NewNativeRegistryCaller creates a new read-only instance of NativeRegistry, bound to a specific deployed contract.
+func NewNativeRegistryCaller(address common.Address, caller bind.ContractCaller) (*NativeRegistryCaller, error) {
+	contract, err := bindNativeRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeRegistryCaller{contract: contract}, nil
+}
+
+// NewNativeRegistryTransactor creates a new write-only instance of NativeRegistry, bound to a specific deployed contract.
+func NewNativeRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*NativeRegistryTransactor, error) {
+	contract, err := bindNativeRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeRegistryTransactor{contract: contract}, nil
+}
+
+// NewNativeRegistryFilterer creates a new log filterer instance of NativeRegistry, bound to a specific deployed contract.
+func NewNativeRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*NativeRegistryFilterer, error) {
+	contract, err := bindNativeRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeRegistryFilterer{contract: contract}, nil
+}
+
+// bindNativeRegistry binds a generic wrapper to an already deployed contract.
+func bindNativeRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(NativeRegistryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeRegistry *NativeRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeRegistry.Contract.NativeRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeRegistry *NativeRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.NativeRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeRegistry *NativeRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.NativeRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeRegistry *NativeRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeRegistry *NativeRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeRegistry *NativeRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetActivationsJson is a free data retrieval call binding the contract method 0xd46f7d6f.
+//
+// Solidity: function getActivationsJson(address contractAddr) view returns(string)
+func (_NativeRegistry *NativeRegistryCaller) GetActivationsJson(opts *bind.CallOpts, contractAddr common.Address) (string, error) {
+	var out []interface{}
+	err := _NativeRegistry.contract.Call(opts, &out, "getActivationsJson", contractAddr)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetActivationsJson is a free data retrieval call binding the contract method 0xd46f7d6f.
+//
+// Solidity: function getActivationsJson(address contractAddr) view returns(string)
+func (_NativeRegistry *NativeRegistrySession) GetActivationsJson(contractAddr common.Address) (string, error) {
+	return _NativeRegistry.Contract.GetActivationsJson(&_NativeRegistry.CallOpts, contractAddr)
+}
+
+// GetActivationsJson is a free data retrieval call binding the contract method 0xd46f7d6f.
+//
+// Solidity: function getActivationsJson(address contractAddr) view returns(string)
+func (_NativeRegistry *NativeRegistryCallerSession) GetActivationsJson(contractAddr common.Address) (string, error) {
+	return _NativeRegistry.Contract.GetActivationsJson(&_NativeRegistry.CallOpts, contractAddr)
+}
+
+// GetActiveVersion is a free data retrieval call binding the contract method 0xf7c84dda.
+//
+// Solidity: function getActiveVersion(address contractAddr) view returns(uint64)
+func (_NativeRegistry *NativeRegistryCaller) GetActiveVersion(opts *bind.CallOpts, contractAddr common.Address) (uint64, error) {
+	var out []interface{}
+	err := _NativeRegistry.contract.Call(opts, &out, "getActiveVersion", contractAddr)
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// GetActiveVersion is a free data retrieval call binding the contract method 0xf7c84dda.
+//
+// Solidity: function getActiveVersion(address contractAddr) view returns(uint64)
+func (_NativeRegistry *NativeRegistrySession) GetActiveVersion(contractAddr common.Address) (uint64, error) {
+	return _NativeRegistry.Contract.GetActiveVersion(&_NativeRegistry.CallOpts, contractAddr)
+}
+
+// GetActiveVersion is a free data retrieval call binding the contract method 0xf7c84dda.
+//
+// Solidity: function getActiveVersion(address contractAddr) view returns(uint64)
+func (_NativeRegistry *NativeRegistryCallerSession) GetActiveVersion(contractAddr common.Address) (uint64, error) {
+	return _NativeRegistry.Contract.GetActiveVersion(&_NativeRegistry.CallOpts, contractAddr)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_NativeRegistry *NativeRegistryCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _NativeRegistry.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_NativeRegistry *NativeRegistrySession) Name() (string, error) {
+	return _NativeRegistry.Contract.Name(&_NativeRegistry.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_NativeRegistry *NativeRegistryCallerSession) Name() (string, error) {
+	return _NativeRegistry.Contract.Name(&_NativeRegistry.CallOpts)
+}
+
+// ApproveUpgrade is a paid mutator transaction binding the contract method 0x793c51b7.
+//
+// Solidity: function approveUpgrade(address contractAddr, uint64 version, uint64 activeHeight) returns(bool)
+func (_NativeRegistry *NativeRegistryTransactor) ApproveUpgrade(opts *bind.TransactOpts, contractAddr common.Address, version uint64, activeHeight uint64) (*types.Transaction, error) {
+	return _NativeRegistry.contract.Transact(opts, "approveUpgrade", contractAddr, version, activeHeight)
+}
+
+// ApproveUpgrade is a paid mutator transaction binding the contract method 0x793c51b7.
+//
+// Solidity: function approveUpgrade(address contractAddr, uint64 version, uint64 activeHeight) returns(bool)
+func (_NativeRegistry *NativeRegistrySession) ApproveUpgrade(contractAddr common.Address, version uint64, activeHeight uint64) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.ApproveUpgrade(&_NativeRegistry.TransactOpts, contractAddr, version, activeHeight)
+}
+
+// ApproveUpgrade is a paid mutator transaction binding the contract method 0x793c51b7.
+//
+// Solidity: function approveUpgrade(address contractAddr, uint64 version, uint64 activeHeight) returns(bool)
+func (_NativeRegistry *NativeRegistryTransactorSession) ApproveUpgrade(contractAddr common.Address, version uint64, activeHeight uint64) (*types.Transaction, error) {
+	return _NativeRegistry.Contract.ApproveUpgrade(&_NativeRegistry.TransactOpts, contractAddr, version, activeHeight)
+}
+
+// NativeRegistryUpgradeApprovedIterator is returned from FilterUpgradeApproved and is used to iterate over the raw logs and unpacked data for UpgradeApproved events raised by the NativeRegistry contract.
+type NativeRegistryUpgradeApprovedIterator struct {
+	Event *NativeRegistryUpgradeApproved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeRegistryUpgradeApprovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeRegistryUpgradeApproved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeRegistryUpgradeApproved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeRegistryUpgradeApprovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeRegistryUpgradeApprovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeRegistryUpgradeApproved represents a UpgradeApproved event raised by the NativeRegistry contract.
+type NativeRegistryUpgradeApproved struct {
+	ContractAddr common.Address
+	Version      uint64
+	ActiveHeight uint64
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgradeApproved is a free log retrieval operation binding the contract event 0xfb8129a52e7e2d541d23013c88159c1930a8120432568e11362440f842318fc9.
+//
+// Solidity: event UpgradeApproved(address indexed contractAddr, uint64 version, uint64 activeHeight)
+func (_NativeRegistry *NativeRegistryFilterer) FilterUpgradeApproved(opts *bind.FilterOpts, contractAddr []common.Address) (*NativeRegistryUpgradeApprovedIterator, error) {
+
+	var contractAddrRule []interface{}
+	for _, contractAddrItem := range contractAddr {
+		contractAddrRule = append(contractAddrRule, contractAddrItem)
+	}
+
+	logs, sub, err := _NativeRegistry.contract.FilterLogs(opts, "UpgradeApproved", contractAddrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeRegistryUpgradeApprovedIterator{contract: _NativeRegistry.contract, event: "UpgradeApproved", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgradeApproved is a free log subscription operation binding the contract event 0xfb8129a52e7e2d541d23013c88159c1930a8120432568e11362440f842318fc9.
+//
+// Solidity: event UpgradeApproved(address indexed contractAddr, uint64 version, uint64 activeHeight)
+func (_NativeRegistry *NativeRegistryFilterer) WatchUpgradeApproved(opts *bind.WatchOpts, sink chan<- *NativeRegistryUpgradeApproved, contractAddr []common.Address) (event.Subscription, error) {
+
+	var contractAddrRule []interface{}
+	for _, contractAddrItem := range contractAddr {
+		contractAddrRule = append(contractAddrRule, contractAddrItem)
+	}
+
+	logs, sub, err := _NativeRegistry.contract.WatchLogs(opts, "UpgradeApproved", contractAddrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeRegistryUpgradeApproved)
+				if err := _NativeRegistry.contract.UnpackLog(event, "UpgradeApproved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgradeApproved is a log parse operation binding the contract event 0xfb8129a52e7e2d541d23013c88159c1930a8120432568e11362440f842318fc9.
+//
+// Solidity: event UpgradeApproved(address indexed contractAddr, uint64 version, uint64 activeHeight)
+func (_NativeRegistry *NativeRegistryFilterer) ParseUpgradeApproved(log types.Log) (*NativeRegistryUpgradeApproved, error) {
+	event := new(NativeRegistryUpgradeApproved)
+	if err := _NativeRegistry.contract.UnpackLog(event, "UpgradeApproved", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
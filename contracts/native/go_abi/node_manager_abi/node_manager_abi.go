@@ -29,10 +29,26 @@ var (
 var (
 	MethodPropose = "propose"
 
+	MethodRegisterCandidate = "registerCandidate"
+
+	MethodReportEquivocation = "reportEquivocation"
+
+	MethodSetBLSPublicKey = "setBLSPublicKey"
+
+	MethodUnjail = "unjail"
+
+	MethodUnregisterCandidate = "unregisterCandidate"
+
+	MethodUpdateCandidate = "updateCandidate"
+
 	MethodVote = "vote"
 
 	MethodEpoch = "epoch"
 
+	MethodGetCandidateJson = "getCandidateJson"
+
+	MethodGetCandidateListJson = "getCandidateListJson"
+
 	MethodGetChangingEpoch = "getChangingEpoch"
 
 	MethodGetChangingEpochJson = "getChangingEpochJson"
@@ -43,21 +59,35 @@ var (
 
 	MethodGetEpochListJson = "getEpochListJson"
 
+	MethodGetEquivocations = "getEquivocations"
+
 	MethodName = "name"
 
 	MethodProof = "proof"
 
+	EventBLSPublicKeySet = "BLSPublicKeySet"
+
+	EventCandidateRegistered = "CandidateRegistered"
+
+	EventCandidateUnregistered = "CandidateUnregistered"
+
+	EventCandidateUpdated = "CandidateUpdated"
+
 	EventConsensusSigned = "ConsensusSigned"
 
 	EventEpochChanged = "EpochChanged"
 
+	EventEquivocationReported = "EquivocationReported"
+
 	EventProposed = "Proposed"
 
+	EventUnjailed = "Unjailed"
+
 	EventVoted = "Voted"
 )
 
 // INodeManagerABI is the input ABI used to generate the binding from.
-const INodeManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"method\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"input\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"size\",\"type\":\"uint64\"}],\"name\":\"ConsensusSigned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epoch\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"nextEpoch\",\"type\":\"bytes\"}],\"name\":\"EpochChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epoch\",\"type\":\"bytes\"}],\"name\":\"Proposed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epochHash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"votedNumber\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"groupSize\",\"type\":\"uint64\"}],\"name\":\"Voted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"epoch\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChangingEpoch\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChangingEpochJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentEpochJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"getEpochByID\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"getEpochListJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"proof\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"startHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"peers\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"epochHash\",\"type\":\"bytes\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+const INodeManagerABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"blsPubKey\",\"type\":\"bytes\"}],\"name\":\"BLSPublicKeySet\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"candidate\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"url\",\"type\":\"string\"}],\"name\":\"CandidateRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"candidate\",\"type\":\"address\"}],\"name\":\"CandidateUnregistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"candidate\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"url\",\"type\":\"string\"}],\"name\":\"CandidateUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"method\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"input\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"signer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"size\",\"type\":\"uint64\"}],\"name\":\"ConsensusSigned\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epoch\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"nextEpoch\",\"type\":\"bytes\"}],\"name\":\"EpochChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"offender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"height\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"round\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"reporter\",\"type\":\"address\"}],\"name\":\"EquivocationReported\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epoch\",\"type\":\"bytes\"}],\"name\":\"Proposed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"Unjailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"epochHash\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"votedNumber\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"groupSize\",\"type\":\"uint64\"}],\"name\":\"Voted\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"epoch\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"candidate\",\"type\":\"address\"}],\"name\":\"getCandidateJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCandidateListJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChangingEpoch\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getChangingEpochJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCurrentEpochJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"getEpochByID\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"getEpochListJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"offender\",\"type\":\"address\"}],\"name\":\"getEquivocations\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"}],\"name\":\"proof\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"startHeight\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"peers\",\"type\":\"bytes\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"pubKey\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"url\",\"type\":\"string\"}],\"name\":\"registerCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"evidence\",\"type\":\"bytes\"}],\"name\":\"reportEquivocation\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"blsPubKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"blsProof\",\"type\":\"bytes\"}],\"name\":\"setBLSPublicKey\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unjail\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unregisterCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"url\",\"type\":\"string\"}],\"name\":\"updateCandidate\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"epochID\",\"type\":\"uint64\"},{\"internalType\":\"bytes\",\"name\":\"epochHash\",\"type\":\"bytes\"}],\"name\":\"vote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 // INodeManager is an auto generated Go binding around an Ethereum contract.
 type INodeManager struct {
@@ -232,6 +262,68 @@ func (_INodeManager *INodeManagerCallerSession) Epoch() ([]byte, error) {
 	return _INodeManager.Contract.Epoch(&_INodeManager.CallOpts)
 }
 
+// GetCandidateJson is a free data retrieval call binding the contract method 0xfccd77ed.
+//
+// Solidity: function getCandidateJson(address candidate) view returns(string)
+func (_INodeManager *INodeManagerCaller) GetCandidateJson(opts *bind.CallOpts, candidate common.Address) (string, error) {
+	var out []interface{}
+	err := _INodeManager.contract.Call(opts, &out, "getCandidateJson", candidate)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetCandidateJson is a free data retrieval call binding the contract method 0xfccd77ed.
+//
+// Solidity: function getCandidateJson(address candidate) view returns(string)
+func (_INodeManager *INodeManagerSession) GetCandidateJson(candidate common.Address) (string, error) {
+	return _INodeManager.Contract.GetCandidateJson(&_INodeManager.CallOpts, candidate)
+}
+
+// GetCandidateJson is a free data retrieval call binding the contract method 0xfccd77ed.
+//
+// Solidity: function getCandidateJson(address candidate) view returns(string)
+func (_INodeManager *INodeManagerCallerSession) GetCandidateJson(candidate common.Address) (string, error) {
+	return _INodeManager.Contract.GetCandidateJson(&_INodeManager.CallOpts, candidate)
+}
+
+// GetCandidateListJson is a free data retrieval call binding the contract method 0x3243a58a.
+//
+// Solidity: function getCandidateListJson() view returns(string)
+func (_INodeManager *INodeManagerCaller) GetCandidateListJson(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _INodeManager.contract.Call(opts, &out, "getCandidateListJson")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetCandidateListJson is a free data retrieval call binding the contract method 0x3243a58a.
+//
+// Solidity: function getCandidateListJson() view returns(string)
+func (_INodeManager *INodeManagerSession) GetCandidateListJson() (string, error) {
+	return _INodeManager.Contract.GetCandidateListJson(&_INodeManager.CallOpts)
+}
+
+// GetCandidateListJson is a free data retrieval call binding the contract method 0x3243a58a.
+//
+// Solidity: function getCandidateListJson() view returns(string)
+func (_INodeManager *INodeManagerCallerSession) GetCandidateListJson() (string, error) {
+	return _INodeManager.Contract.GetCandidateListJson(&_INodeManager.CallOpts)
+}
+
 // GetChangingEpoch is a free data retrieval call binding the contract method 0x76b85cd9.
 //
 // Solidity: function getChangingEpoch() view returns(bytes)
@@ -387,6 +479,37 @@ func (_INodeManager *INodeManagerCallerSession) GetEpochListJson(epochID uint64)
 	return _INodeManager.Contract.GetEpochListJson(&_INodeManager.CallOpts, epochID)
 }
 
+// GetEquivocations is a free data retrieval call binding the contract method 0xf80648e0.
+//
+// Solidity: function getEquivocations(address offender) view returns(bytes)
+func (_INodeManager *INodeManagerCaller) GetEquivocations(opts *bind.CallOpts, offender common.Address) ([]byte, error) {
+	var out []interface{}
+	err := _INodeManager.contract.Call(opts, &out, "getEquivocations", offender)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// GetEquivocations is a free data retrieval call binding the contract method 0xf80648e0.
+//
+// Solidity: function getEquivocations(address offender) view returns(bytes)
+func (_INodeManager *INodeManagerSession) GetEquivocations(offender common.Address) ([]byte, error) {
+	return _INodeManager.Contract.GetEquivocations(&_INodeManager.CallOpts, offender)
+}
+
+// GetEquivocations is a free data retrieval call binding the contract method 0xf80648e0.
+//
+// Solidity: function getEquivocations(address offender) view returns(bytes)
+func (_INodeManager *INodeManagerCallerSession) GetEquivocations(offender common.Address) ([]byte, error) {
+	return _INodeManager.Contract.GetEquivocations(&_INodeManager.CallOpts, offender)
+}
+
 // Name is a free data retrieval call binding the contract method 0x06fdde03.
 //
 // Solidity: function name() view returns(string)
@@ -470,6 +593,132 @@ func (_INodeManager *INodeManagerTransactorSession) Propose(startHeight uint64,
 	return _INodeManager.Contract.Propose(&_INodeManager.TransactOpts, startHeight, peers)
 }
 
+// RegisterCandidate is a paid mutator transaction binding the contract method 0x35c3c876.
+//
+// Solidity: function registerCandidate(string pubKey, string name, string url) returns(bool)
+func (_INodeManager *INodeManagerTransactor) RegisterCandidate(opts *bind.TransactOpts, pubKey string, name string, url string) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "registerCandidate", pubKey, name, url)
+}
+
+// RegisterCandidate is a paid mutator transaction binding the contract method 0x35c3c876.
+//
+// Solidity: function registerCandidate(string pubKey, string name, string url) returns(bool)
+func (_INodeManager *INodeManagerSession) RegisterCandidate(pubKey string, name string, url string) (*types.Transaction, error) {
+	return _INodeManager.Contract.RegisterCandidate(&_INodeManager.TransactOpts, pubKey, name, url)
+}
+
+// RegisterCandidate is a paid mutator transaction binding the contract method 0x35c3c876.
+//
+// Solidity: function registerCandidate(string pubKey, string name, string url) returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) RegisterCandidate(pubKey string, name string, url string) (*types.Transaction, error) {
+	return _INodeManager.Contract.RegisterCandidate(&_INodeManager.TransactOpts, pubKey, name, url)
+}
+
+// ReportEquivocation is a paid mutator transaction binding the contract method 0x6216e6f0.
+//
+// Solidity: function reportEquivocation(bytes evidence) returns(bool)
+func (_INodeManager *INodeManagerTransactor) ReportEquivocation(opts *bind.TransactOpts, evidence []byte) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "reportEquivocation", evidence)
+}
+
+// ReportEquivocation is a paid mutator transaction binding the contract method 0x6216e6f0.
+//
+// Solidity: function reportEquivocation(bytes evidence) returns(bool)
+func (_INodeManager *INodeManagerSession) ReportEquivocation(evidence []byte) (*types.Transaction, error) {
+	return _INodeManager.Contract.ReportEquivocation(&_INodeManager.TransactOpts, evidence)
+}
+
+// ReportEquivocation is a paid mutator transaction binding the contract method 0x6216e6f0.
+//
+// Solidity: function reportEquivocation(bytes evidence) returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) ReportEquivocation(evidence []byte) (*types.Transaction, error) {
+	return _INodeManager.Contract.ReportEquivocation(&_INodeManager.TransactOpts, evidence)
+}
+
+// SetBLSPublicKey is a paid mutator transaction binding the contract method 0xc7634b6f.
+//
+// Solidity: function setBLSPublicKey(bytes blsPubKey, bytes blsProof) returns(bool)
+func (_INodeManager *INodeManagerTransactor) SetBLSPublicKey(opts *bind.TransactOpts, blsPubKey []byte, blsProof []byte) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "setBLSPublicKey", blsPubKey, blsProof)
+}
+
+// SetBLSPublicKey is a paid mutator transaction binding the contract method 0xc7634b6f.
+//
+// Solidity: function setBLSPublicKey(bytes blsPubKey, bytes blsProof) returns(bool)
+func (_INodeManager *INodeManagerSession) SetBLSPublicKey(blsPubKey []byte, blsProof []byte) (*types.Transaction, error) {
+	return _INodeManager.Contract.SetBLSPublicKey(&_INodeManager.TransactOpts, blsPubKey, blsProof)
+}
+
+// SetBLSPublicKey is a paid mutator transaction binding the contract method 0xc7634b6f.
+//
+// Solidity: function setBLSPublicKey(bytes blsPubKey, bytes blsProof) returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) SetBLSPublicKey(blsPubKey []byte, blsProof []byte) (*types.Transaction, error) {
+	return _INodeManager.Contract.SetBLSPublicKey(&_INodeManager.TransactOpts, blsPubKey, blsProof)
+}
+
+// Unjail is a paid mutator transaction binding the contract method 0xf679d305.
+//
+// Solidity: function unjail() returns(bool)
+func (_INodeManager *INodeManagerTransactor) Unjail(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "unjail")
+}
+
+// Unjail is a paid mutator transaction binding the contract method 0xf679d305.
+//
+// Solidity: function unjail() returns(bool)
+func (_INodeManager *INodeManagerSession) Unjail() (*types.Transaction, error) {
+	return _INodeManager.Contract.Unjail(&_INodeManager.TransactOpts)
+}
+
+// Unjail is a paid mutator transaction binding the contract method 0xf679d305.
+//
+// Solidity: function unjail() returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) Unjail() (*types.Transaction, error) {
+	return _INodeManager.Contract.Unjail(&_INodeManager.TransactOpts)
+}
+
+// UnregisterCandidate is a paid mutator transaction binding the contract method 0xa8b28ff5.
+//
+// Solidity: function unregisterCandidate() returns(bool)
+func (_INodeManager *INodeManagerTransactor) UnregisterCandidate(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "unregisterCandidate")
+}
+
+// UnregisterCandidate is a paid mutator transaction binding the contract method 0xa8b28ff5.
+//
+// Solidity: function unregisterCandidate() returns(bool)
+func (_INodeManager *INodeManagerSession) UnregisterCandidate() (*types.Transaction, error) {
+	return _INodeManager.Contract.UnregisterCandidate(&_INodeManager.TransactOpts)
+}
+
+// UnregisterCandidate is a paid mutator transaction binding the contract method 0xa8b28ff5.
+//
+// Solidity: function unregisterCandidate() returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) UnregisterCandidate() (*types.Transaction, error) {
+	return _INodeManager.Contract.UnregisterCandidate(&_INodeManager.TransactOpts)
+}
+
+// UpdateCandidate is a paid mutator transaction binding the contract method 0x2294c28d.
+//
+// Solidity: function updateCandidate(string name, string url) returns(bool)
+func (_INodeManager *INodeManagerTransactor) UpdateCandidate(opts *bind.TransactOpts, name string, url string) (*types.Transaction, error) {
+	return _INodeManager.contract.Transact(opts, "updateCandidate", name, url)
+}
+
+// UpdateCandidate is a paid mutator transaction binding the contract method 0x2294c28d.
+//
+// Solidity: function updateCandidate(string name, string url) returns(bool)
+func (_INodeManager *INodeManagerSession) UpdateCandidate(name string, url string) (*types.Transaction, error) {
+	return _INodeManager.Contract.UpdateCandidate(&_INodeManager.TransactOpts, name, url)
+}
+
+// UpdateCandidate is a paid mutator transaction binding the contract method 0x2294c28d.
+//
+// Solidity: function updateCandidate(string name, string url) returns(bool)
+func (_INodeManager *INodeManagerTransactorSession) UpdateCandidate(name string, url string) (*types.Transaction, error) {
+	return _INodeManager.Contract.UpdateCandidate(&_INodeManager.TransactOpts, name, url)
+}
+
 // Vote is a paid mutator transaction binding the contract method 0x08c16dbb.
 //
 // Solidity: function vote(uint64 epochID, bytes epochHash) returns(bool)
@@ -491,9 +740,9 @@ func (_INodeManager *INodeManagerTransactorSession) Vote(epochID uint64, epochHa
 	return _INodeManager.Contract.Vote(&_INodeManager.TransactOpts, epochID, epochHash)
 }
 
-// INodeManagerConsensusSignedIterator is returned from FilterConsensusSigned and is used to iterate over the raw logs and unpacked data for ConsensusSigned events raised by the INodeManager contract.
-type INodeManagerConsensusSignedIterator struct {
-	Event *INodeManagerConsensusSigned // Event containing the contract specifics and raw log
+// INodeManagerBLSPublicKeySetIterator is returned from FilterBLSPublicKeySet and is used to iterate over the raw logs and unpacked data for BLSPublicKeySet events raised by the INodeManager contract.
+type INodeManagerBLSPublicKeySetIterator struct {
+	Event *INodeManagerBLSPublicKeySet // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -507,7 +756,7 @@ type INodeManagerConsensusSignedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *INodeManagerConsensusSignedIterator) Next() bool {
+func (it *INodeManagerBLSPublicKeySetIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -516,7 +765,7 @@ func (it *INodeManagerConsensusSignedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(INodeManagerConsensusSigned)
+			it.Event = new(INodeManagerBLSPublicKeySet)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -531,7 +780,7 @@ func (it *INodeManagerConsensusSignedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(INodeManagerConsensusSigned)
+		it.Event = new(INodeManagerBLSPublicKeySet)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -547,44 +796,42 @@ func (it *INodeManagerConsensusSignedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *INodeManagerConsensusSignedIterator) Error() error {
+func (it *INodeManagerBLSPublicKeySetIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *INodeManagerConsensusSignedIterator) Close() error {
+func (it *INodeManagerBLSPublicKeySetIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// INodeManagerConsensusSigned represents a ConsensusSigned event raised by the INodeManager contract.
-type INodeManagerConsensusSigned struct {
-	Method string
-	Input  []byte
-	Signer common.Address
-	Size   uint64
-	Raw    types.Log // Blockchain specific contextual infos
+// INodeManagerBLSPublicKeySet represents a BLSPublicKeySet event raised by the INodeManager contract.
+type INodeManagerBLSPublicKeySet struct {
+	Validator common.Address
+	BlsPubKey []byte
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterConsensusSigned is a free log retrieval operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+// FilterBLSPublicKeySet is a free log retrieval operation binding the contract event 0xaaf263138276dc23ae37b9548be2a14798a2ac1b3fb36c2d34cbacca5e5b811c.
 //
-// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
-func (_INodeManager *INodeManagerFilterer) FilterConsensusSigned(opts *bind.FilterOpts) (*INodeManagerConsensusSignedIterator, error) {
+// Solidity: event BLSPublicKeySet(address validator, bytes blsPubKey)
+func (_INodeManager *INodeManagerFilterer) FilterBLSPublicKeySet(opts *bind.FilterOpts) (*INodeManagerBLSPublicKeySetIterator, error) {
 
-	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "ConsensusSigned")
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "BLSPublicKeySet")
 	if err != nil {
 		return nil, err
 	}
-	return &INodeManagerConsensusSignedIterator{contract: _INodeManager.contract, event: "ConsensusSigned", logs: logs, sub: sub}, nil
+	return &INodeManagerBLSPublicKeySetIterator{contract: _INodeManager.contract, event: "BLSPublicKeySet", logs: logs, sub: sub}, nil
 }
 
-// WatchConsensusSigned is a free log subscription operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+// WatchBLSPublicKeySet is a free log subscription operation binding the contract event 0xaaf263138276dc23ae37b9548be2a14798a2ac1b3fb36c2d34cbacca5e5b811c.
 //
-// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
-func (_INodeManager *INodeManagerFilterer) WatchConsensusSigned(opts *bind.WatchOpts, sink chan<- *INodeManagerConsensusSigned) (event.Subscription, error) {
+// Solidity: event BLSPublicKeySet(address validator, bytes blsPubKey)
+func (_INodeManager *INodeManagerFilterer) WatchBLSPublicKeySet(opts *bind.WatchOpts, sink chan<- *INodeManagerBLSPublicKeySet) (event.Subscription, error) {
 
-	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "ConsensusSigned")
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "BLSPublicKeySet")
 	if err != nil {
 		return nil, err
 	}
@@ -594,8 +841,8 @@ func (_INodeManager *INodeManagerFilterer) WatchConsensusSigned(opts *bind.Watch
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(INodeManagerConsensusSigned)
-				if err := _INodeManager.contract.UnpackLog(event, "ConsensusSigned", log); err != nil {
+				event := new(INodeManagerBLSPublicKeySet)
+				if err := _INodeManager.contract.UnpackLog(event, "BLSPublicKeySet", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -616,21 +863,21 @@ func (_INodeManager *INodeManagerFilterer) WatchConsensusSigned(opts *bind.Watch
 	}), nil
 }
 
-// ParseConsensusSigned is a log parse operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+// ParseBLSPublicKeySet is a log parse operation binding the contract event 0xaaf263138276dc23ae37b9548be2a14798a2ac1b3fb36c2d34cbacca5e5b811c.
 //
-// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
-func (_INodeManager *INodeManagerFilterer) ParseConsensusSigned(log types.Log) (*INodeManagerConsensusSigned, error) {
-	event := new(INodeManagerConsensusSigned)
-	if err := _INodeManager.contract.UnpackLog(event, "ConsensusSigned", log); err != nil {
+// Solidity: event BLSPublicKeySet(address validator, bytes blsPubKey)
+func (_INodeManager *INodeManagerFilterer) ParseBLSPublicKeySet(log types.Log) (*INodeManagerBLSPublicKeySet, error) {
+	event := new(INodeManagerBLSPublicKeySet)
+	if err := _INodeManager.contract.UnpackLog(event, "BLSPublicKeySet", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// INodeManagerEpochChangedIterator is returned from FilterEpochChanged and is used to iterate over the raw logs and unpacked data for EpochChanged events raised by the INodeManager contract.
-type INodeManagerEpochChangedIterator struct {
-	Event *INodeManagerEpochChanged // Event containing the contract specifics and raw log
+// INodeManagerCandidateRegisteredIterator is returned from FilterCandidateRegistered and is used to iterate over the raw logs and unpacked data for CandidateRegistered events raised by the INodeManager contract.
+type INodeManagerCandidateRegisteredIterator struct {
+	Event *INodeManagerCandidateRegistered // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -644,7 +891,7 @@ type INodeManagerEpochChangedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *INodeManagerEpochChangedIterator) Next() bool {
+func (it *INodeManagerCandidateRegisteredIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -653,7 +900,7 @@ func (it *INodeManagerEpochChangedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(INodeManagerEpochChanged)
+			it.Event = new(INodeManagerCandidateRegistered)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -668,7 +915,7 @@ func (it *INodeManagerEpochChangedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(INodeManagerEpochChanged)
+		it.Event = new(INodeManagerCandidateRegistered)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -684,42 +931,43 @@ func (it *INodeManagerEpochChangedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *INodeManagerEpochChangedIterator) Error() error {
+func (it *INodeManagerCandidateRegisteredIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *INodeManagerEpochChangedIterator) Close() error {
+func (it *INodeManagerCandidateRegisteredIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// INodeManagerEpochChanged represents a EpochChanged event raised by the INodeManager contract.
-type INodeManagerEpochChanged struct {
-	Epoch     []byte
-	NextEpoch []byte
+// INodeManagerCandidateRegistered represents a CandidateRegistered event raised by the INodeManager contract.
+type INodeManagerCandidateRegistered struct {
+	Candidate common.Address
+	Name      string
+	Url       string
 	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterEpochChanged is a free log retrieval operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+// FilterCandidateRegistered is a free log retrieval operation binding the contract event 0x4f56d88836b720b9c3c946b2e9f5546b0162d4b9fa5b7957997d41433c5a90db.
 //
-// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
-func (_INodeManager *INodeManagerFilterer) FilterEpochChanged(opts *bind.FilterOpts) (*INodeManagerEpochChangedIterator, error) {
+// Solidity: event CandidateRegistered(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) FilterCandidateRegistered(opts *bind.FilterOpts) (*INodeManagerCandidateRegisteredIterator, error) {
 
-	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "EpochChanged")
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "CandidateRegistered")
 	if err != nil {
 		return nil, err
 	}
-	return &INodeManagerEpochChangedIterator{contract: _INodeManager.contract, event: "EpochChanged", logs: logs, sub: sub}, nil
+	return &INodeManagerCandidateRegisteredIterator{contract: _INodeManager.contract, event: "CandidateRegistered", logs: logs, sub: sub}, nil
 }
 
-// WatchEpochChanged is a free log subscription operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+// WatchCandidateRegistered is a free log subscription operation binding the contract event 0x4f56d88836b720b9c3c946b2e9f5546b0162d4b9fa5b7957997d41433c5a90db.
 //
-// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
-func (_INodeManager *INodeManagerFilterer) WatchEpochChanged(opts *bind.WatchOpts, sink chan<- *INodeManagerEpochChanged) (event.Subscription, error) {
+// Solidity: event CandidateRegistered(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) WatchCandidateRegistered(opts *bind.WatchOpts, sink chan<- *INodeManagerCandidateRegistered) (event.Subscription, error) {
 
-	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "EpochChanged")
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "CandidateRegistered")
 	if err != nil {
 		return nil, err
 	}
@@ -729,8 +977,8 @@ func (_INodeManager *INodeManagerFilterer) WatchEpochChanged(opts *bind.WatchOpt
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(INodeManagerEpochChanged)
-				if err := _INodeManager.contract.UnpackLog(event, "EpochChanged", log); err != nil {
+				event := new(INodeManagerCandidateRegistered)
+				if err := _INodeManager.contract.UnpackLog(event, "CandidateRegistered", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -751,21 +999,21 @@ func (_INodeManager *INodeManagerFilterer) WatchEpochChanged(opts *bind.WatchOpt
 	}), nil
 }
 
-// ParseEpochChanged is a log parse operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+// ParseCandidateRegistered is a log parse operation binding the contract event 0x4f56d88836b720b9c3c946b2e9f5546b0162d4b9fa5b7957997d41433c5a90db.
 //
-// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
-func (_INodeManager *INodeManagerFilterer) ParseEpochChanged(log types.Log) (*INodeManagerEpochChanged, error) {
-	event := new(INodeManagerEpochChanged)
-	if err := _INodeManager.contract.UnpackLog(event, "EpochChanged", log); err != nil {
+// Solidity: event CandidateRegistered(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) ParseCandidateRegistered(log types.Log) (*INodeManagerCandidateRegistered, error) {
+	event := new(INodeManagerCandidateRegistered)
+	if err := _INodeManager.contract.UnpackLog(event, "CandidateRegistered", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// INodeManagerProposedIterator is returned from FilterProposed and is used to iterate over the raw logs and unpacked data for Proposed events raised by the INodeManager contract.
-type INodeManagerProposedIterator struct {
-	Event *INodeManagerProposed // Event containing the contract specifics and raw log
+// INodeManagerCandidateUnregisteredIterator is returned from FilterCandidateUnregistered and is used to iterate over the raw logs and unpacked data for CandidateUnregistered events raised by the INodeManager contract.
+type INodeManagerCandidateUnregisteredIterator struct {
+	Event *INodeManagerCandidateUnregistered // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -779,7 +1027,7 @@ type INodeManagerProposedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *INodeManagerProposedIterator) Next() bool {
+func (it *INodeManagerCandidateUnregisteredIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -788,7 +1036,7 @@ func (it *INodeManagerProposedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(INodeManagerProposed)
+			it.Event = new(INodeManagerCandidateUnregistered)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -803,7 +1051,7 @@ func (it *INodeManagerProposedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(INodeManagerProposed)
+		it.Event = new(INodeManagerCandidateUnregistered)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -819,41 +1067,41 @@ func (it *INodeManagerProposedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *INodeManagerProposedIterator) Error() error {
+func (it *INodeManagerCandidateUnregisteredIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *INodeManagerProposedIterator) Close() error {
+func (it *INodeManagerCandidateUnregisteredIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// INodeManagerProposed represents a Proposed event raised by the INodeManager contract.
-type INodeManagerProposed struct {
-	Epoch []byte
-	Raw   types.Log // Blockchain specific contextual infos
+// INodeManagerCandidateUnregistered represents a CandidateUnregistered event raised by the INodeManager contract.
+type INodeManagerCandidateUnregistered struct {
+	Candidate common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterProposed is a free log retrieval operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+// FilterCandidateUnregistered is a free log retrieval operation binding the contract event 0x3cdadb93586528af5dca44184ba3e5366cd7b3e36d66e4daa3f9b184d56611c6.
 //
-// Solidity: event Proposed(bytes epoch)
-func (_INodeManager *INodeManagerFilterer) FilterProposed(opts *bind.FilterOpts) (*INodeManagerProposedIterator, error) {
+// Solidity: event CandidateUnregistered(address candidate)
+func (_INodeManager *INodeManagerFilterer) FilterCandidateUnregistered(opts *bind.FilterOpts) (*INodeManagerCandidateUnregisteredIterator, error) {
 
-	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "Proposed")
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "CandidateUnregistered")
 	if err != nil {
 		return nil, err
 	}
-	return &INodeManagerProposedIterator{contract: _INodeManager.contract, event: "Proposed", logs: logs, sub: sub}, nil
+	return &INodeManagerCandidateUnregisteredIterator{contract: _INodeManager.contract, event: "CandidateUnregistered", logs: logs, sub: sub}, nil
 }
 
-// WatchProposed is a free log subscription operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+// WatchCandidateUnregistered is a free log subscription operation binding the contract event 0x3cdadb93586528af5dca44184ba3e5366cd7b3e36d66e4daa3f9b184d56611c6.
 //
-// Solidity: event Proposed(bytes epoch)
-func (_INodeManager *INodeManagerFilterer) WatchProposed(opts *bind.WatchOpts, sink chan<- *INodeManagerProposed) (event.Subscription, error) {
+// Solidity: event CandidateUnregistered(address candidate)
+func (_INodeManager *INodeManagerFilterer) WatchCandidateUnregistered(opts *bind.WatchOpts, sink chan<- *INodeManagerCandidateUnregistered) (event.Subscription, error) {
 
-	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "Proposed")
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "CandidateUnregistered")
 	if err != nil {
 		return nil, err
 	}
@@ -863,8 +1111,8 @@ func (_INodeManager *INodeManagerFilterer) WatchProposed(opts *bind.WatchOpts, s
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(INodeManagerProposed)
-				if err := _INodeManager.contract.UnpackLog(event, "Proposed", log); err != nil {
+				event := new(INodeManagerCandidateUnregistered)
+				if err := _INodeManager.contract.UnpackLog(event, "CandidateUnregistered", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -885,12 +1133,825 @@ func (_INodeManager *INodeManagerFilterer) WatchProposed(opts *bind.WatchOpts, s
 	}), nil
 }
 
-// ParseProposed is a log parse operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+// ParseCandidateUnregistered is a log parse operation binding the contract event 0x3cdadb93586528af5dca44184ba3e5366cd7b3e36d66e4daa3f9b184d56611c6.
 //
-// Solidity: event Proposed(bytes epoch)
-func (_INodeManager *INodeManagerFilterer) ParseProposed(log types.Log) (*INodeManagerProposed, error) {
-	event := new(INodeManagerProposed)
-	if err := _INodeManager.contract.UnpackLog(event, "Proposed", log); err != nil {
+// Solidity: event CandidateUnregistered(address candidate)
+func (_INodeManager *INodeManagerFilterer) ParseCandidateUnregistered(log types.Log) (*INodeManagerCandidateUnregistered, error) {
+	event := new(INodeManagerCandidateUnregistered)
+	if err := _INodeManager.contract.UnpackLog(event, "CandidateUnregistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerCandidateUpdatedIterator is returned from FilterCandidateUpdated and is used to iterate over the raw logs and unpacked data for CandidateUpdated events raised by the INodeManager contract.
+type INodeManagerCandidateUpdatedIterator struct {
+	Event *INodeManagerCandidateUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerCandidateUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerCandidateUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerCandidateUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerCandidateUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerCandidateUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerCandidateUpdated represents a CandidateUpdated event raised by the INodeManager contract.
+type INodeManagerCandidateUpdated struct {
+	Candidate common.Address
+	Name      string
+	Url       string
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterCandidateUpdated is a free log retrieval operation binding the contract event 0xb7d916fe8aaa2c1a9f7198192f7afee2738483bf1453c9325b074cdc9f15cd08.
+//
+// Solidity: event CandidateUpdated(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) FilterCandidateUpdated(opts *bind.FilterOpts) (*INodeManagerCandidateUpdatedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "CandidateUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerCandidateUpdatedIterator{contract: _INodeManager.contract, event: "CandidateUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchCandidateUpdated is a free log subscription operation binding the contract event 0xb7d916fe8aaa2c1a9f7198192f7afee2738483bf1453c9325b074cdc9f15cd08.
+//
+// Solidity: event CandidateUpdated(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) WatchCandidateUpdated(opts *bind.WatchOpts, sink chan<- *INodeManagerCandidateUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "CandidateUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerCandidateUpdated)
+				if err := _INodeManager.contract.UnpackLog(event, "CandidateUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCandidateUpdated is a log parse operation binding the contract event 0xb7d916fe8aaa2c1a9f7198192f7afee2738483bf1453c9325b074cdc9f15cd08.
+//
+// Solidity: event CandidateUpdated(address candidate, string name, string url)
+func (_INodeManager *INodeManagerFilterer) ParseCandidateUpdated(log types.Log) (*INodeManagerCandidateUpdated, error) {
+	event := new(INodeManagerCandidateUpdated)
+	if err := _INodeManager.contract.UnpackLog(event, "CandidateUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerConsensusSignedIterator is returned from FilterConsensusSigned and is used to iterate over the raw logs and unpacked data for ConsensusSigned events raised by the INodeManager contract.
+type INodeManagerConsensusSignedIterator struct {
+	Event *INodeManagerConsensusSigned // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerConsensusSignedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerConsensusSigned)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerConsensusSigned)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerConsensusSignedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerConsensusSignedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerConsensusSigned represents a ConsensusSigned event raised by the INodeManager contract.
+type INodeManagerConsensusSigned struct {
+	Method string
+	Input  []byte
+	Signer common.Address
+	Size   uint64
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusSigned is a free log retrieval operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+//
+// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
+func (_INodeManager *INodeManagerFilterer) FilterConsensusSigned(opts *bind.FilterOpts) (*INodeManagerConsensusSignedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "ConsensusSigned")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerConsensusSignedIterator{contract: _INodeManager.contract, event: "ConsensusSigned", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusSigned is a free log subscription operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+//
+// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
+func (_INodeManager *INodeManagerFilterer) WatchConsensusSigned(opts *bind.WatchOpts, sink chan<- *INodeManagerConsensusSigned) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "ConsensusSigned")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerConsensusSigned)
+				if err := _INodeManager.contract.UnpackLog(event, "ConsensusSigned", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusSigned is a log parse operation binding the contract event 0x0061afebf4fdedb651e1607bf3b25a3b5073565ab6424ca51a4e66bd632b15ce.
+//
+// Solidity: event ConsensusSigned(string method, bytes input, address signer, uint64 size)
+func (_INodeManager *INodeManagerFilterer) ParseConsensusSigned(log types.Log) (*INodeManagerConsensusSigned, error) {
+	event := new(INodeManagerConsensusSigned)
+	if err := _INodeManager.contract.UnpackLog(event, "ConsensusSigned", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerEpochChangedIterator is returned from FilterEpochChanged and is used to iterate over the raw logs and unpacked data for EpochChanged events raised by the INodeManager contract.
+type INodeManagerEpochChangedIterator struct {
+	Event *INodeManagerEpochChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerEpochChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerEpochChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerEpochChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerEpochChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerEpochChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerEpochChanged represents a EpochChanged event raised by the INodeManager contract.
+type INodeManagerEpochChanged struct {
+	Epoch     []byte
+	NextEpoch []byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEpochChanged is a free log retrieval operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+//
+// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
+func (_INodeManager *INodeManagerFilterer) FilterEpochChanged(opts *bind.FilterOpts) (*INodeManagerEpochChangedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "EpochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerEpochChangedIterator{contract: _INodeManager.contract, event: "EpochChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchEpochChanged is a free log subscription operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+//
+// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
+func (_INodeManager *INodeManagerFilterer) WatchEpochChanged(opts *bind.WatchOpts, sink chan<- *INodeManagerEpochChanged) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "EpochChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerEpochChanged)
+				if err := _INodeManager.contract.UnpackLog(event, "EpochChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochChanged is a log parse operation binding the contract event 0x5bad90814f3890720ae7e64921fad1d8441d38991c42dd2958fba86418bc120c.
+//
+// Solidity: event EpochChanged(bytes epoch, bytes nextEpoch)
+func (_INodeManager *INodeManagerFilterer) ParseEpochChanged(log types.Log) (*INodeManagerEpochChanged, error) {
+	event := new(INodeManagerEpochChanged)
+	if err := _INodeManager.contract.UnpackLog(event, "EpochChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerEquivocationReportedIterator is returned from FilterEquivocationReported and is used to iterate over the raw logs and unpacked data for EquivocationReported events raised by the INodeManager contract.
+type INodeManagerEquivocationReportedIterator struct {
+	Event *INodeManagerEquivocationReported // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerEquivocationReportedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerEquivocationReported)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerEquivocationReported)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerEquivocationReportedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerEquivocationReportedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerEquivocationReported represents a EquivocationReported event raised by the INodeManager contract.
+type INodeManagerEquivocationReported struct {
+	Offender common.Address
+	Height   uint64
+	Round    uint64
+	Reporter common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterEquivocationReported is a free log retrieval operation binding the contract event 0x749421becb9df4a80db77f97f7f22e8c941f87847eddbd11ee624a1ec924bf75.
+//
+// Solidity: event EquivocationReported(address offender, uint64 height, uint64 round, address reporter)
+func (_INodeManager *INodeManagerFilterer) FilterEquivocationReported(opts *bind.FilterOpts) (*INodeManagerEquivocationReportedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "EquivocationReported")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerEquivocationReportedIterator{contract: _INodeManager.contract, event: "EquivocationReported", logs: logs, sub: sub}, nil
+}
+
+// WatchEquivocationReported is a free log subscription operation binding the contract event 0x749421becb9df4a80db77f97f7f22e8c941f87847eddbd11ee624a1ec924bf75.
+//
+// Solidity: event EquivocationReported(address offender, uint64 height, uint64 round, address reporter)
+func (_INodeManager *INodeManagerFilterer) WatchEquivocationReported(opts *bind.WatchOpts, sink chan<- *INodeManagerEquivocationReported) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "EquivocationReported")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerEquivocationReported)
+				if err := _INodeManager.contract.UnpackLog(event, "EquivocationReported", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEquivocationReported is a log parse operation binding the contract event 0x749421becb9df4a80db77f97f7f22e8c941f87847eddbd11ee624a1ec924bf75.
+//
+// Solidity: event EquivocationReported(address offender, uint64 height, uint64 round, address reporter)
+func (_INodeManager *INodeManagerFilterer) ParseEquivocationReported(log types.Log) (*INodeManagerEquivocationReported, error) {
+	event := new(INodeManagerEquivocationReported)
+	if err := _INodeManager.contract.UnpackLog(event, "EquivocationReported", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerProposedIterator is returned from FilterProposed and is used to iterate over the raw logs and unpacked data for Proposed events raised by the INodeManager contract.
+type INodeManagerProposedIterator struct {
+	Event *INodeManagerProposed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerProposedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerProposed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerProposed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerProposedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerProposedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerProposed represents a Proposed event raised by the INodeManager contract.
+type INodeManagerProposed struct {
+	Epoch []byte
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposed is a free log retrieval operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+//
+// Solidity: event Proposed(bytes epoch)
+func (_INodeManager *INodeManagerFilterer) FilterProposed(opts *bind.FilterOpts) (*INodeManagerProposedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "Proposed")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerProposedIterator{contract: _INodeManager.contract, event: "Proposed", logs: logs, sub: sub}, nil
+}
+
+// WatchProposed is a free log subscription operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+//
+// Solidity: event Proposed(bytes epoch)
+func (_INodeManager *INodeManagerFilterer) WatchProposed(opts *bind.WatchOpts, sink chan<- *INodeManagerProposed) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "Proposed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerProposed)
+				if err := _INodeManager.contract.UnpackLog(event, "Proposed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposed is a log parse operation binding the contract event 0x10b2060c55406ea48522476f67fd813d4984b12078555d3e2a377e35839d7d01.
+//
+// Solidity: event Proposed(bytes epoch)
+func (_INodeManager *INodeManagerFilterer) ParseProposed(log types.Log) (*INodeManagerProposed, error) {
+	event := new(INodeManagerProposed)
+	if err := _INodeManager.contract.UnpackLog(event, "Proposed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INodeManagerUnjailedIterator is returned from FilterUnjailed and is used to iterate over the raw logs and unpacked data for Unjailed events raised by the INodeManager contract.
+type INodeManagerUnjailedIterator struct {
+	Event *INodeManagerUnjailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INodeManagerUnjailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INodeManagerUnjailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INodeManagerUnjailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INodeManagerUnjailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INodeManagerUnjailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INodeManagerUnjailed represents a Unjailed event raised by the INodeManager contract.
+type INodeManagerUnjailed struct {
+	Validator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnjailed is a free log retrieval operation binding the contract event 0xfa5039497ad9ba11f0eb5239b2614e925541bbcc0cf3476dd68e1927c86d33ff.
+//
+// Solidity: event Unjailed(address validator)
+func (_INodeManager *INodeManagerFilterer) FilterUnjailed(opts *bind.FilterOpts) (*INodeManagerUnjailedIterator, error) {
+
+	logs, sub, err := _INodeManager.contract.FilterLogs(opts, "Unjailed")
+	if err != nil {
+		return nil, err
+	}
+	return &INodeManagerUnjailedIterator{contract: _INodeManager.contract, event: "Unjailed", logs: logs, sub: sub}, nil
+}
+
+// WatchUnjailed is a free log subscription operation binding the contract event 0xfa5039497ad9ba11f0eb5239b2614e925541bbcc0cf3476dd68e1927c86d33ff.
+//
+// Solidity: event Unjailed(address validator)
+func (_INodeManager *INodeManagerFilterer) WatchUnjailed(opts *bind.WatchOpts, sink chan<- *INodeManagerUnjailed) (event.Subscription, error) {
+
+	logs, sub, err := _INodeManager.contract.WatchLogs(opts, "Unjailed")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INodeManagerUnjailed)
+				if err := _INodeManager.contract.UnpackLog(event, "Unjailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnjailed is a log parse operation binding the contract event 0xfa5039497ad9ba11f0eb5239b2614e925541bbcc0cf3476dd68e1927c86d33ff.
+//
+// Solidity: event Unjailed(address validator)
+func (_INodeManager *INodeManagerFilterer) ParseUnjailed(log types.Log) (*INodeManagerUnjailed, error) {
+	event := new(INodeManagerUnjailed)
+	if err := _INodeManager.contract.UnpackLog(event, "Unjailed", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -0,0 +1,1243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package staking_abi
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+var (
+	MethodRegisterValidator = "registerValidator"
+
+	MethodStake = "stake"
+
+	MethodUnstake = "unstake"
+
+	MethodUpdateCommission = "updateCommission"
+
+	MethodWithdraw = "withdraw"
+
+	MethodGetDelegationJson = "getDelegationJson"
+
+	MethodGetRewards = "getRewards"
+
+	MethodGetUnbondingJson = "getUnbondingJson"
+
+	MethodGetValidatorJson = "getValidatorJson"
+
+	MethodGetValidatorRankingJson = "getValidatorRankingJson"
+
+	MethodName = "name"
+
+	EventCommissionUpdated = "CommissionUpdated"
+
+	EventStaked = "Staked"
+
+	EventUnstaked = "Unstaked"
+
+	EventValidatorRegistered = "ValidatorRegistered"
+
+	EventWithdrawn = "Withdrawn"
+)
+
+// StakingABI is the input ABI used to generate the binding from.
+const StakingABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"commission\",\"type\":\"uint64\"}],\"name\":\"CommissionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Staked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"completeHeight\",\"type\":\"uint64\"}],\"name\":\"Unstaked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"pubKey\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"commission\",\"type\":\"uint64\"}],\"name\":\"ValidatorRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"principal\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rewards\",\"type\":\"uint256\"}],\"name\":\"Withdrawn\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getDelegationJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"getRewards\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"}],\"name\":\"getUnbondingJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"getValidatorJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidatorRankingJson\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"pubKey\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"commission\",\"type\":\"uint64\"}],\"name\":\"registerValidator\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"}],\"name\":\"stake\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"unstake\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"commission\",\"type\":\"uint64\"}],\"name\":\"updateCommission\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"withdraw\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// Staking is an auto generated Go binding around an Ethereum contract.
+type Staking struct {
+	StakingCaller     // Read-only binding to the contract
+	StakingTransactor // Write-only binding to the contract
+	StakingFilterer   // Log filterer for contract events
+}
+
+// StakingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StakingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StakingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StakingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StakingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StakingSession struct {
+	Contract     *Staking          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// StakingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StakingCallerSession struct {
+	Contract *StakingCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// StakingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StakingTransactorSession struct {
+	Contract     *StakingTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// StakingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StakingRaw struct {
+	Contract *Staking // Generic contract binding to access the raw methods on
+}
+
+// StakingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StakingCallerRaw struct {
+	Contract *StakingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StakingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StakingTransactorRaw struct {
+	Contract *StakingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStaking creates a new instance of Staking, bound to a specific deployed contract.
+func NewStaking(address common.Address, backend bind.ContractBackend) (*Staking, error) {
+	contract, err := bindStaking(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Staking{StakingCaller: StakingCaller{contract: contract}, StakingTransactor: StakingTransactor{contract: contract}, StakingFilterer: StakingFilterer{contract: contract}}, nil
+}
+
+// NewStakingCaller creates a new read-only instance of Staking, bound to a specific deployed contract.
+func NewStakingCaller(address common.Address, caller bind.ContractCaller) (*StakingCaller, error) {
+	contract, err := bindStaking(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingCaller{contract: contract}, nil
+}
+
+// NewStakingTransactor creates a new write-only instance of Staking, bound to a specific deployed contract.
+func NewStakingTransactor(address common.Address, transactor bind.ContractTransactor) (*StakingTransactor, error) {
+	contract, err := bindStaking(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingTransactor{contract: contract}, nil
+}
+
+// NewStakingFilterer creates a new log filterer instance of Staking, bound to a specific deployed contract.
+func NewStakingFilterer(address common.Address, filterer bind.ContractFilterer) (*StakingFilterer, error) {
+	contract, err := bindStaking(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingFilterer{contract: contract}, nil
+}
+
+// bindStaking binds a generic wrapper to an already deployed contract.
+func bindStaking(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StakingABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.StakingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.StakingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Staking *StakingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Staking.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Staking *StakingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Staking *StakingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Staking.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetDelegationJson is a free data retrieval call binding the contract method 0x429d7f3f.
+//
+// Solidity: function getDelegationJson(address delegator, address validator) view returns(string)
+func (_Staking *StakingCaller) GetDelegationJson(opts *bind.CallOpts, delegator common.Address, validator common.Address) (string, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "getDelegationJson", delegator, validator)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetDelegationJson is a free data retrieval call binding the contract method 0x429d7f3f.
+//
+// Solidity: function getDelegationJson(address delegator, address validator) view returns(string)
+func (_Staking *StakingSession) GetDelegationJson(delegator common.Address, validator common.Address) (string, error) {
+	return _Staking.Contract.GetDelegationJson(&_Staking.CallOpts, delegator, validator)
+}
+
+// GetDelegationJson is a free data retrieval call binding the contract method 0x429d7f3f.
+//
+// Solidity: function getDelegationJson(address delegator, address validator) view returns(string)
+func (_Staking *StakingCallerSession) GetDelegationJson(delegator common.Address, validator common.Address) (string, error) {
+	return _Staking.Contract.GetDelegationJson(&_Staking.CallOpts, delegator, validator)
+}
+
+// GetRewards is a free data retrieval call binding the contract method 0x79ee54f7.
+//
+// Solidity: function getRewards(address account) view returns(uint256)
+func (_Staking *StakingCaller) GetRewards(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "getRewards", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetRewards is a free data retrieval call binding the contract method 0x79ee54f7.
+//
+// Solidity: function getRewards(address account) view returns(uint256)
+func (_Staking *StakingSession) GetRewards(account common.Address) (*big.Int, error) {
+	return _Staking.Contract.GetRewards(&_Staking.CallOpts, account)
+}
+
+// GetRewards is a free data retrieval call binding the contract method 0x79ee54f7.
+//
+// Solidity: function getRewards(address account) view returns(uint256)
+func (_Staking *StakingCallerSession) GetRewards(account common.Address) (*big.Int, error) {
+	return _Staking.Contract.GetRewards(&_Staking.CallOpts, account)
+}
+
+// GetUnbondingJson is a free data retrieval call binding the contract method 0xff008778.
+//
+// Solidity: function getUnbondingJson(address delegator) view returns(string)
+func (_Staking *StakingCaller) GetUnbondingJson(opts *bind.CallOpts, delegator common.Address) (string, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "getUnbondingJson", delegator)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetUnbondingJson is a free data retrieval call binding the contract method 0xff008778.
+//
+// Solidity: function getUnbondingJson(address delegator) view returns(string)
+func (_Staking *StakingSession) GetUnbondingJson(delegator common.Address) (string, error) {
+	return _Staking.Contract.GetUnbondingJson(&_Staking.CallOpts, delegator)
+}
+
+// GetUnbondingJson is a free data retrieval call binding the contract method 0xff008778.
+//
+// Solidity: function getUnbondingJson(address delegator) view returns(string)
+func (_Staking *StakingCallerSession) GetUnbondingJson(delegator common.Address) (string, error) {
+	return _Staking.Contract.GetUnbondingJson(&_Staking.CallOpts, delegator)
+}
+
+// GetValidatorJson is a free data retrieval call binding the contract method 0x86881e70.
+//
+// Solidity: function getValidatorJson(address validator) view returns(string)
+func (_Staking *StakingCaller) GetValidatorJson(opts *bind.CallOpts, validator common.Address) (string, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "getValidatorJson", validator)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetValidatorJson is a free data retrieval call binding the contract method 0x86881e70.
+//
+// Solidity: function getValidatorJson(address validator) view returns(string)
+func (_Staking *StakingSession) GetValidatorJson(validator common.Address) (string, error) {
+	return _Staking.Contract.GetValidatorJson(&_Staking.CallOpts, validator)
+}
+
+// GetValidatorJson is a free data retrieval call binding the contract method 0x86881e70.
+//
+// Solidity: function getValidatorJson(address validator) view returns(string)
+func (_Staking *StakingCallerSession) GetValidatorJson(validator common.Address) (string, error) {
+	return _Staking.Contract.GetValidatorJson(&_Staking.CallOpts, validator)
+}
+
+// GetValidatorRankingJson is a free data retrieval call binding the contract method 0xcac03be5.
+//
+// Solidity: function getValidatorRankingJson() view returns(string)
+func (_Staking *StakingCaller) GetValidatorRankingJson(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "getValidatorRankingJson")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetValidatorRankingJson is a free data retrieval call binding the contract method 0xcac03be5.
+//
+// Solidity: function getValidatorRankingJson() view returns(string)
+func (_Staking *StakingSession) GetValidatorRankingJson() (string, error) {
+	return _Staking.Contract.GetValidatorRankingJson(&_Staking.CallOpts)
+}
+
+// GetValidatorRankingJson is a free data retrieval call binding the contract method 0xcac03be5.
+//
+// Solidity: function getValidatorRankingJson() view returns(string)
+func (_Staking *StakingCallerSession) GetValidatorRankingJson() (string, error) {
+	return _Staking.Contract.GetValidatorRankingJson(&_Staking.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Staking *StakingCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _Staking.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Staking *StakingSession) Name() (string, error) {
+	return _Staking.Contract.Name(&_Staking.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_Staking *StakingCallerSession) Name() (string, error) {
+	return _Staking.Contract.Name(&_Staking.CallOpts)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0x5a6c1ce5.
+//
+// Solidity: function registerValidator(string pubKey, uint64 commission) payable returns(bool)
+func (_Staking *StakingTransactor) RegisterValidator(opts *bind.TransactOpts, pubKey string, commission uint64) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "registerValidator", pubKey, commission)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0x5a6c1ce5.
+//
+// Solidity: function registerValidator(string pubKey, uint64 commission) payable returns(bool)
+func (_Staking *StakingSession) RegisterValidator(pubKey string, commission uint64) (*types.Transaction, error) {
+	return _Staking.Contract.RegisterValidator(&_Staking.TransactOpts, pubKey, commission)
+}
+
+// RegisterValidator is a paid mutator transaction binding the contract method 0x5a6c1ce5.
+//
+// Solidity: function registerValidator(string pubKey, uint64 commission) payable returns(bool)
+func (_Staking *StakingTransactorSession) RegisterValidator(pubKey string, commission uint64) (*types.Transaction, error) {
+	return _Staking.Contract.RegisterValidator(&_Staking.TransactOpts, pubKey, commission)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns(bool)
+func (_Staking *StakingTransactor) Stake(opts *bind.TransactOpts, validator common.Address) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "stake", validator)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns(bool)
+func (_Staking *StakingSession) Stake(validator common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.Stake(&_Staking.TransactOpts, validator)
+}
+
+// Stake is a paid mutator transaction binding the contract method 0x26476204.
+//
+// Solidity: function stake(address validator) payable returns(bool)
+func (_Staking *StakingTransactorSession) Stake(validator common.Address) (*types.Transaction, error) {
+	return _Staking.Contract.Stake(&_Staking.TransactOpts, validator)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns(bool)
+func (_Staking *StakingTransactor) Unstake(opts *bind.TransactOpts, validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "unstake", validator, amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns(bool)
+func (_Staking *StakingSession) Unstake(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Unstake(&_Staking.TransactOpts, validator, amount)
+}
+
+// Unstake is a paid mutator transaction binding the contract method 0xc2a672e0.
+//
+// Solidity: function unstake(address validator, uint256 amount) returns(bool)
+func (_Staking *StakingTransactorSession) Unstake(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Unstake(&_Staking.TransactOpts, validator, amount)
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0x5154a19a.
+//
+// Solidity: function updateCommission(uint64 commission) returns(bool)
+func (_Staking *StakingTransactor) UpdateCommission(opts *bind.TransactOpts, commission uint64) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "updateCommission", commission)
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0x5154a19a.
+//
+// Solidity: function updateCommission(uint64 commission) returns(bool)
+func (_Staking *StakingSession) UpdateCommission(commission uint64) (*types.Transaction, error) {
+	return _Staking.Contract.UpdateCommission(&_Staking.TransactOpts, commission)
+}
+
+// UpdateCommission is a paid mutator transaction binding the contract method 0x5154a19a.
+//
+// Solidity: function updateCommission(uint64 commission) returns(bool)
+func (_Staking *StakingTransactorSession) UpdateCommission(commission uint64) (*types.Transaction, error) {
+	return _Staking.Contract.UpdateCommission(&_Staking.TransactOpts, commission)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(bool)
+func (_Staking *StakingTransactor) Withdraw(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "withdraw")
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(bool)
+func (_Staking *StakingSession) Withdraw() (*types.Transaction, error) {
+	return _Staking.Contract.Withdraw(&_Staking.TransactOpts)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(bool)
+func (_Staking *StakingTransactorSession) Withdraw() (*types.Transaction, error) {
+	return _Staking.Contract.Withdraw(&_Staking.TransactOpts)
+}
+
+// StakingCommissionUpdatedIterator is returned from FilterCommissionUpdated and is used to iterate over the raw logs and unpacked data for CommissionUpdated events raised by the Staking contract.
+type StakingCommissionUpdatedIterator struct {
+	Event *StakingCommissionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingCommissionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingCommissionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingCommissionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingCommissionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingCommissionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingCommissionUpdated represents a CommissionUpdated event raised by the Staking contract.
+type StakingCommissionUpdated struct {
+	Validator  common.Address
+	Commission uint64
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterCommissionUpdated is a free log retrieval operation binding the contract event 0xaca020ec6a52dcd97b7e69d17d5f34f6f453fe331ae7a1f12837fcecaf0b8590.
+//
+// Solidity: event CommissionUpdated(address indexed validator, uint64 commission)
+func (_Staking *StakingFilterer) FilterCommissionUpdated(opts *bind.FilterOpts, validator []common.Address) (*StakingCommissionUpdatedIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "CommissionUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingCommissionUpdatedIterator{contract: _Staking.contract, event: "CommissionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchCommissionUpdated is a free log subscription operation binding the contract event 0xaca020ec6a52dcd97b7e69d17d5f34f6f453fe331ae7a1f12837fcecaf0b8590.
+//
+// Solidity: event CommissionUpdated(address indexed validator, uint64 commission)
+func (_Staking *StakingFilterer) WatchCommissionUpdated(opts *bind.WatchOpts, sink chan<- *StakingCommissionUpdated, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "CommissionUpdated", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingCommissionUpdated)
+				if err := _Staking.contract.UnpackLog(event, "CommissionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCommissionUpdated is a log parse operation binding the contract event 0xaca020ec6a52dcd97b7e69d17d5f34f6f453fe331ae7a1f12837fcecaf0b8590.
+//
+// Solidity: event CommissionUpdated(address indexed validator, uint64 commission)
+func (_Staking *StakingFilterer) ParseCommissionUpdated(log types.Log) (*StakingCommissionUpdated, error) {
+	event := new(StakingCommissionUpdated)
+	if err := _Staking.contract.UnpackLog(event, "CommissionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingStakedIterator is returned from FilterStaked and is used to iterate over the raw logs and unpacked data for Staked events raised by the Staking contract.
+type StakingStakedIterator struct {
+	Event *StakingStaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingStakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingStaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingStaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingStakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingStakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingStaked represents a Staked event raised by the Staking contract.
+type StakingStaked struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterStaked is a free log retrieval operation binding the contract event 0x5dac0c1b1112564a045ba943c9d50270893e8e826c49be8e7073adc713ab7bd7.
+//
+// Solidity: event Staked(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) FilterStaked(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingStakedIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Staked", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingStakedIterator{contract: _Staking.contract, event: "Staked", logs: logs, sub: sub}, nil
+}
+
+// WatchStaked is a free log subscription operation binding the contract event 0x5dac0c1b1112564a045ba943c9d50270893e8e826c49be8e7073adc713ab7bd7.
+//
+// Solidity: event Staked(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) WatchStaked(opts *bind.WatchOpts, sink chan<- *StakingStaked, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Staked", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingStaked)
+				if err := _Staking.contract.UnpackLog(event, "Staked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseStaked is a log parse operation binding the contract event 0x5dac0c1b1112564a045ba943c9d50270893e8e826c49be8e7073adc713ab7bd7.
+//
+// Solidity: event Staked(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) ParseStaked(log types.Log) (*StakingStaked, error) {
+	event := new(StakingStaked)
+	if err := _Staking.contract.UnpackLog(event, "Staked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingUnstakedIterator is returned from FilterUnstaked and is used to iterate over the raw logs and unpacked data for Unstaked events raised by the Staking contract.
+type StakingUnstakedIterator struct {
+	Event *StakingUnstaked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUnstakedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUnstaked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUnstaked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUnstakedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUnstakedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUnstaked represents a Unstaked event raised by the Staking contract.
+type StakingUnstaked struct {
+	Delegator      common.Address
+	Validator      common.Address
+	Amount         *big.Int
+	CompleteHeight uint64
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUnstaked is a free log retrieval operation binding the contract event 0xdfe8efbc2dd2e9c5ba503defc06ded971b38c061a8f60734d615e97ff8c5dce7.
+//
+// Solidity: event Unstaked(address indexed delegator, address indexed validator, uint256 amount, uint64 completeHeight)
+func (_Staking *StakingFilterer) FilterUnstaked(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingUnstakedIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Unstaked", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUnstakedIterator{contract: _Staking.contract, event: "Unstaked", logs: logs, sub: sub}, nil
+}
+
+// WatchUnstaked is a free log subscription operation binding the contract event 0xdfe8efbc2dd2e9c5ba503defc06ded971b38c061a8f60734d615e97ff8c5dce7.
+//
+// Solidity: event Unstaked(address indexed delegator, address indexed validator, uint256 amount, uint64 completeHeight)
+func (_Staking *StakingFilterer) WatchUnstaked(opts *bind.WatchOpts, sink chan<- *StakingUnstaked, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Unstaked", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUnstaked)
+				if err := _Staking.contract.UnpackLog(event, "Unstaked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUnstaked is a log parse operation binding the contract event 0xdfe8efbc2dd2e9c5ba503defc06ded971b38c061a8f60734d615e97ff8c5dce7.
+//
+// Solidity: event Unstaked(address indexed delegator, address indexed validator, uint256 amount, uint64 completeHeight)
+func (_Staking *StakingFilterer) ParseUnstaked(log types.Log) (*StakingUnstaked, error) {
+	event := new(StakingUnstaked)
+	if err := _Staking.contract.UnpackLog(event, "Unstaked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingValidatorRegisteredIterator is returned from FilterValidatorRegistered and is used to iterate over the raw logs and unpacked data for ValidatorRegistered events raised by the Staking contract.
+type StakingValidatorRegisteredIterator struct {
+	Event *StakingValidatorRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingValidatorRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingValidatorRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingValidatorRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingValidatorRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingValidatorRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingValidatorRegistered represents a ValidatorRegistered event raised by the Staking contract.
+type StakingValidatorRegistered struct {
+	Validator  common.Address
+	PubKey     string
+	Commission uint64
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterValidatorRegistered is a free log retrieval operation binding the contract event 0x77f86083a5ce0478482dca9c581f12d53a73761a43e73d55f4defd2bb5852292.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, string pubKey, uint64 commission)
+func (_Staking *StakingFilterer) FilterValidatorRegistered(opts *bind.FilterOpts, validator []common.Address) (*StakingValidatorRegisteredIterator, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "ValidatorRegistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingValidatorRegisteredIterator{contract: _Staking.contract, event: "ValidatorRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchValidatorRegistered is a free log subscription operation binding the contract event 0x77f86083a5ce0478482dca9c581f12d53a73761a43e73d55f4defd2bb5852292.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, string pubKey, uint64 commission)
+func (_Staking *StakingFilterer) WatchValidatorRegistered(opts *bind.WatchOpts, sink chan<- *StakingValidatorRegistered, validator []common.Address) (event.Subscription, error) {
+
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "ValidatorRegistered", validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingValidatorRegistered)
+				if err := _Staking.contract.UnpackLog(event, "ValidatorRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseValidatorRegistered is a log parse operation binding the contract event 0x77f86083a5ce0478482dca9c581f12d53a73761a43e73d55f4defd2bb5852292.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, string pubKey, uint64 commission)
+func (_Staking *StakingFilterer) ParseValidatorRegistered(log types.Log) (*StakingValidatorRegistered, error) {
+	event := new(StakingValidatorRegistered)
+	if err := _Staking.contract.UnpackLog(event, "ValidatorRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingWithdrawnIterator is returned from FilterWithdrawn and is used to iterate over the raw logs and unpacked data for Withdrawn events raised by the Staking contract.
+type StakingWithdrawnIterator struct {
+	Event *StakingWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingWithdrawn represents a Withdrawn event raised by the Staking contract.
+type StakingWithdrawn struct {
+	Delegator common.Address
+	Principal *big.Int
+	Rewards   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawn is a free log retrieval operation binding the contract event 0x92ccf450a286a957af52509bc1c9939d1a6a481783e142e41e2499f0bb66ebc6.
+//
+// Solidity: event Withdrawn(address indexed delegator, uint256 principal, uint256 rewards)
+func (_Staking *StakingFilterer) FilterWithdrawn(opts *bind.FilterOpts, delegator []common.Address) (*StakingWithdrawnIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Withdrawn", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingWithdrawnIterator{contract: _Staking.contract, event: "Withdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawn is a free log subscription operation binding the contract event 0x92ccf450a286a957af52509bc1c9939d1a6a481783e142e41e2499f0bb66ebc6.
+//
+// Solidity: event Withdrawn(address indexed delegator, uint256 principal, uint256 rewards)
+func (_Staking *StakingFilterer) WatchWithdrawn(opts *bind.WatchOpts, sink chan<- *StakingWithdrawn, delegator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Withdrawn", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingWithdrawn)
+				if err := _Staking.contract.UnpackLog(event, "Withdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawn is a log parse operation binding the contract event 0x92ccf450a286a957af52509bc1c9939d1a6a481783e142e41e2499f0bb66ebc6.
+//
+// Solidity: event Withdrawn(address indexed delegator, uint256 principal, uint256 rewards)
+func (_Staking *StakingFilterer) ParseWithdrawn(log types.Log) (*StakingWithdrawn, error) {
+	event := new(StakingWithdrawn)
+	if err := _Staking.contract.UnpackLog(event, "Withdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -21,6 +21,8 @@ package maas_config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native"
@@ -31,27 +33,50 @@ import (
 
 var (
 	gasTable = map[string]uint64{
-		MethodName:         0,
-		MethodChangeOwner:  30000,
-		MethodGetOwner:     0,
-		MethodBlockAccount: 30000,
-		MethodIsBlocked:    0,
-		MethodGetBlacklist: 0,
-
-		MethodEnableGasManage:    30000,
-		MethodSetGasManager:      30000,
-		MethodIsGasManageEnabled: 0,
-		MethodIsGasManager:       0,
-		MethodGetGasManagerList:  0,
-
-		MethodSetGasUsers:    30000,
-		MethodIsGasUser:      0,
-		MethodGetGasUserList: 0,
-
-		MethodSetAdmins:    30000,
-		MethodIsAdmin:      0,
-		MethodGetAdminList: 0,
-	}
+		MethodName:             0,
+		MethodChangeOwner:      30000,
+		MethodGetOwner:         0,
+		MethodBlockAccount:     30000,
+		MethodIsBlocked:        0,
+		MethodGetBlacklist:     0,
+		MethodGetBlacklistPage: 0,
+
+		MethodEnableGasManage:       30000,
+		MethodSetGasManager:         30000,
+		MethodIsGasManageEnabled:    0,
+		MethodIsGasManager:          0,
+		MethodGetGasManagerList:     0,
+		MethodGetGasManagerListPage: 0,
+
+		MethodSetGasUsers:        30000,
+		MethodIsGasUser:          0,
+		MethodGetGasUserList:     0,
+		MethodGetGasUserListPage: 0,
+
+		MethodEnableNodeWhite:      30000,
+		MethodIsNodeWhiteEnabled:   0,
+		MethodSetNodeWhitelist:     30000,
+		MethodIsInNodeWhitelist:    0,
+		MethodGetNodeWhitelist:     0,
+		MethodGetNodeWhitelistPage: 0,
+
+		MethodSetAdmins:        30000,
+		MethodIsAdmin:          0,
+		MethodGetAdminList:     0,
+		MethodGetAdminListPage: 0,
+
+		MethodSetGovernance: 30000,
+		MethodGetGovernance: 0,
+		MethodPropose:       30000,
+		MethodApprove:       30000,
+		MethodCancel:        30000,
+		MethodExecute:       30000,
+		MethodGetProposal:   0,
+	}
+
+	// EpochValidators returns the validators of the current epoch. It is registered by node_manager,
+	// which can not be imported here since it depends on this package through core.
+	EpochValidators func(s *native.NativeContract) ([]common.Address, error)
 )
 
 func InitMaasConfig() {
@@ -69,20 +94,39 @@ func RegisterMaasConfigContract(s *native.NativeContract) {
 	s.Register(MethodBlockAccount, BlockAccount)
 	s.Register(MethodIsBlocked, IsBlocked)
 	s.Register(MethodGetBlacklist, GetBlacklist)
+	s.Register(MethodGetBlacklistPage, GetBlacklistPage)
 
 	s.Register(MethodEnableGasManage, EnableGasManage)
 	s.Register(MethodSetGasManager, SetGasManager)
 	s.Register(MethodIsGasManageEnabled, IsGasManageEnabled)
 	s.Register(MethodIsGasManager, IsGasManager)
 	s.Register(MethodGetGasManagerList, GetGasManagerList)
+	s.Register(MethodGetGasManagerListPage, GetGasManagerListPage)
 
 	s.Register(MethodSetGasUsers, SetGasUsers)
 	s.Register(MethodIsGasUser, IsGasUser)
 	s.Register(MethodGetGasUserList, GetGasUserList)
+	s.Register(MethodGetGasUserListPage, GetGasUserListPage)
+
+	s.Register(MethodEnableNodeWhite, EnableNodeWhite)
+	s.Register(MethodIsNodeWhiteEnabled, IsNodeWhiteEnabled)
+	s.Register(MethodSetNodeWhitelist, SetNodeWhitelist)
+	s.Register(MethodIsInNodeWhitelist, IsInNodeWhitelist)
+	s.Register(MethodGetNodeWhitelist, GetNodeWhitelist)
+	s.Register(MethodGetNodeWhitelistPage, GetNodeWhitelistPage)
 
 	s.Register(MethodSetAdmins, SetAdmins)
 	s.Register(MethodIsAdmin, IsAdmin)
 	s.Register(MethodGetAdminList, GetAdminList)
+	s.Register(MethodGetAdminListPage, GetAdminListPage)
+
+	s.Register(MethodSetGovernance, SetGovernance)
+	s.Register(MethodGetGovernance, GetGovernance)
+	s.Register(MethodPropose, Propose)
+	s.Register(MethodApprove, Approve)
+	s.Register(MethodCancel, Cancel)
+	s.Register(MethodExecute, Execute)
+	s.Register(MethodGetProposal, GetProposal)
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -105,32 +149,45 @@ func ChangeOwner(s *native.NativeContract) ([]byte, error) {
 		return utils.ByteFailed, errors.New("invalid authority for owner")
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := changeOwner(s, ctx.Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func changeOwner(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodChangeOwnerInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("ChangeOwner", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
+		return errors.New("invalid input")
 	}
 
 	// verify new owner address
-	m := getAddressMap(s, blacklistKey)
-	_, ok := m[input.Addr]
-	if ok {
+	if blacklist.contains(s.GetCacheDB(), input.Addr) {
 		err := errors.New("new owner address in blacklist")
 		log.Trace("ChangeOwner", "invalid new owner", err)
-		return utils.ByteFailed, err
+		return err
 	}
 
 	// store owner
+	currentOwner := getOwner(s)
 	set(s, ownerKey, input.Addr.Bytes())
+	if err := checkGovernorCount(s); err != nil {
+		return err
+	}
 
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventChangeOwner}, common.BytesToHash(currentOwner.Bytes()), common.BytesToHash(input.Addr.Bytes())); err != nil {
 		log.Trace("ChangeOwner", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventChangeOwner error")
+		return errors.New("emit EventChangeOwner error")
 	}
-
-	return utils.ByteSuccess, nil
+	return nil
 }
 
 // get owner
@@ -163,9 +220,7 @@ func checkOwner(s *native.NativeContract) error {
 
 func isAdmin(s *native.NativeContract) bool {
 	origin := s.ContractRef().TxOrigin()
-	m := getAddressMap(s, gasAdminListKey)
-	_, ok := m[origin]
-	return ok
+	return gasAdminList.contains(s.GetCacheDB(), origin)
 }
 
 func checkOwnerOrAdmin(s *native.NativeContract) error {
@@ -183,59 +238,49 @@ func checkOwnerOrAdmin(s *native.NativeContract) error {
 
 // block account(add account to blacklist map) or unblock account
 func BlockAccount(s *native.NativeContract) ([]byte, error) {
-	ctx := s.ContractRef().CurrentContext()
-
 	// check owner
 	if err := checkOwner(s); err != nil {
 		return utils.ByteFailed, err
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := blockAccount(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func blockAccount(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodBlockAccountInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("blockAccount", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
+		return errors.New("invalid input")
 	}
 
 	currentOwner := getOwner(s)
 	if input.Addr == currentOwner {
 		err := errors.New("block owner is forbidden")
 		log.Trace("blockAccount", "block owner is forbidden", err)
-		return utils.ByteFailed, err
+		return err
 	}
 
-	m := getAddressMap(s, blacklistKey)
 	if input.DoBlock {
-		m[input.Addr] = struct{}{}
+		blacklist.add(s.GetCacheDB(), input.Addr)
 	} else {
-		delete(m, input.Addr)
+		blacklist.remove(s.GetCacheDB(), input.Addr)
 	}
 
-	value, err := json.Marshal(m)
-	if err != nil {
-		log.Trace("blockAccount", "encode value failed", err)
-		return utils.ByteFailed, errors.New("encode value failed")
-	}
-	set(s, blacklistKey, value)
-
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventBlockAccount}, common.BytesToHash(input.Addr.Bytes()), input.DoBlock); err != nil {
 		log.Trace("blockAccount", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventBlockAccount error")
-	}
-
-	return utils.ByteSuccess, nil
-}
-
-func getAddressMap(s *native.NativeContract, key []byte) map[common.Address]struct{} {
-	value, _ := get(s, key)
-	m := make(map[common.Address]struct{})
-	if len(value) > 0 {
-		if err := json.Unmarshal(value, &m); err != nil {
-			log.Trace("getAddressMap", "decode value failed", err)
-		}
+		return errors.New("emit EventBlockAccount error")
 	}
-	return m
+	return nil
 }
 
 // check if account is blocked
@@ -250,40 +295,42 @@ func IsBlocked(s *native.NativeContract) ([]byte, error) {
 	}
 
 	// get value
-	m := getAddressMap(s, blacklistKey)
-	_, ok := m[input.Addr]
-	output := &MethodBoolOutput{Success: ok}
+	output := &MethodBoolOutput{Success: blacklist.contains(s.GetCacheDB(), input.Addr)}
 
 	return output.Encode(MethodIsBlocked)
 }
 
 // get blacklist json
 func GetBlacklist(s *native.NativeContract) ([]byte, error) {
-	// get value
-	m := getAddressMap(s, blacklistKey)
-	list := make([]common.Address, 0, len(m))
-	for key := range m {
-		list = append(list, key)
-	}
-	result, _ := json.Marshal(list)
+	result, _ := json.Marshal(blacklist.all(s.GetCacheDB()))
 	output := &MethodStringOutput{Result: string(result)}
 	return output.Encode(MethodGetBlacklist)
 }
 
 // enable gas manage
 func EnableGasManage(s *native.NativeContract) ([]byte, error) {
-	ctx := s.ContractRef().CurrentContext()
-
 	// check owner
 	if err := checkOwner(s); err != nil {
 		return utils.ByteFailed, err
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := enableGasManage(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func enableGasManage(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodEnableGasManageInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("EnableGasManage", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
+		return errors.New("invalid input")
 	}
 
 	// set enable status
@@ -296,10 +343,9 @@ func EnableGasManage(s *native.NativeContract) ([]byte, error) {
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventEnableGasManage}, input.DoEnable); err != nil {
 		log.Trace("EnableGasManage", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventEnableGasManage error")
+		return errors.New("emit EventEnableGasManage error")
 	}
-
-	return utils.ByteSuccess, nil
+	return nil
 }
 
 // check if gas manage is enabled
@@ -312,41 +358,42 @@ func IsGasManageEnabled(s *native.NativeContract) ([]byte, error) {
 
 // set gas manager address
 func SetGasManager(s *native.NativeContract) ([]byte, error) {
-	ctx := s.ContractRef().CurrentContext()
-
 	// check owner
 	if err := checkOwner(s); err != nil {
 		return utils.ByteFailed, err
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := setGasManager(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func setGasManager(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodSetGasManagerInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("SetGasManager", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
+		return errors.New("invalid input")
 	}
 
-	m := getAddressMap(s, gasManagerListKey)
 	if input.IsManager {
-		m[input.Addr] = struct{}{}
+		gasManagerList.add(s.GetCacheDB(), input.Addr)
 	} else {
-		delete(m, input.Addr)
-	}
-
-	value, err := json.Marshal(m)
-	if err != nil {
-		log.Trace("SetGasManager", "encode value failed", err)
-		return utils.ByteFailed, errors.New("encode value failed")
+		gasManagerList.remove(s.GetCacheDB(), input.Addr)
 	}
-	set(s, gasManagerListKey, value)
 
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventSetGasManager}, common.BytesToHash(input.Addr.Bytes()), input.IsManager); err != nil {
 		log.Trace("SetGasManager", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventSetGasManager error")
+		return errors.New("emit EventSetGasManager error")
 	}
-
-	return utils.ByteSuccess, nil
+	return nil
 }
 
 // check if address is in gas manager list
@@ -361,65 +408,56 @@ func IsGasManager(s *native.NativeContract) ([]byte, error) {
 	}
 
 	// get value
-	m := getAddressMap(s, gasManagerListKey)
-	_, ok := m[input.Addr]
-	output := &MethodBoolOutput{Success: ok}
+	output := &MethodBoolOutput{Success: gasManagerList.contains(s.GetCacheDB(), input.Addr)}
 
 	return output.Encode(MethodIsGasManager)
 }
 
 // get gas manager list json
 func GetGasManagerList(s *native.NativeContract) ([]byte, error) {
-	// get value
-	m := getAddressMap(s, gasManagerListKey)
-	list := make([]common.Address, 0, len(m))
-	for key := range m {
-		list = append(list, key)
-	}
-	result, _ := json.Marshal(list)
+	result, _ := json.Marshal(gasManagerList.all(s.GetCacheDB()))
 	output := &MethodStringOutput{Result: string(result)}
 	return output.Encode(MethodGetGasManagerList)
 }
 
 // set gas users
 func SetGasUsers(s *native.NativeContract) ([]byte, error) {
-	ctx := s.ContractRef().CurrentContext()
-
 	// check owner
 	if err := checkOwnerOrAdmin(s); err != nil {
 		return utils.ByteFailed, err
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := setGasUsers(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func setGasUsers(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodSetGasUsersInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("SetGasUsers", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
-	}
-
-	m := getAddressMap(s, gasUserListKey)
-	for _, v := range input.Addrs {
-		if input.AddOrRemove {
-			m[v] = struct{}{}
-		} else {
-			delete(m, v)
-		}
+		return errors.New("invalid input")
 	}
 
-	value, err := json.Marshal(m)
-	if err != nil {
-		log.Trace("SetGasUsers", "encode value failed", err)
-		return utils.ByteFailed, errors.New("encode value failed")
+	if input.AddOrRemove {
+		gasUserList.add(s.GetCacheDB(), input.Addrs...)
+	} else {
+		gasUserList.remove(s.GetCacheDB(), input.Addrs...)
 	}
-	set(s, gasUserListKey, value)
 
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventSetGasUsers}, input.Addrs, input.AddOrRemove); err != nil {
 		log.Trace("SetGasUsers", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventSetGasUsers error")
+		return errors.New("emit EventSetGasUsers error")
 	}
-
-	return utils.ByteSuccess, nil
+	return nil
 }
 
 // check if address is in gas user list
@@ -434,65 +472,221 @@ func IsGasUser(s *native.NativeContract) ([]byte, error) {
 	}
 
 	// get value
-	m := getAddressMap(s, gasUserListKey)
-	_, ok := m[input.Addr]
-	output := &MethodBoolOutput{Success: ok}
+	output := &MethodBoolOutput{Success: gasUserList.contains(s.GetCacheDB(), input.Addr)}
 
 	return output.Encode(MethodIsGasUser)
 }
 
 // get gas user list json
 func GetGasUserList(s *native.NativeContract) ([]byte, error) {
+	result, _ := json.Marshal(gasUserList.all(s.GetCacheDB()))
+	output := &MethodStringOutput{Result: string(result)}
+	return output.Encode(MethodGetGasUserList)
+}
+
+// enable node whitelist, only the nodes in whitelist can connect to each other once enabled
+func EnableNodeWhite(s *native.NativeContract) ([]byte, error) {
+	// check owner
+	if err := checkOwner(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := enableNodeWhite(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func enableNodeWhite(s *native.NativeContract, payload []byte) error {
+	// decode input
+	input := new(MethodEnableNodeWhiteInput)
+	if err := input.Decode(payload); err != nil {
+		log.Trace("EnableNodeWhite", "decode input failed", err)
+		return errors.New("invalid input")
+	}
+
+	// set enable status
+	if input.DoEnable {
+		db := s.GetCacheDB()
+		if err := checkNodeWhitelist(s, func(addr common.Address) bool {
+			return nodeWhitelist.contains(db, addr)
+		}); err != nil {
+			log.Trace("EnableNodeWhite", "check node whitelist failed", err)
+			return err
+		}
+		set(s, nodeWhiteEnableKey, utils.BYTE_TRUE)
+	} else {
+		del(s, nodeWhiteEnableKey)
+	}
+
+	// emit event log
+	if err := s.AddNotify(ABI, []string{EventEnableNodeWhite}, input.DoEnable); err != nil {
+		log.Trace("EnableNodeWhite", "emit event log failed", err)
+		return errors.New("emit EventEnableNodeWhite error")
+	}
+	return nil
+}
+
+// check if node whitelist is enabled
+func IsNodeWhiteEnabled(s *native.NativeContract) ([]byte, error) {
 	// get value
-	m := getAddressMap(s, gasUserListKey)
-	list := make([]common.Address, 0, len(m))
-	for key := range m {
-		list = append(list, key)
+	value, _ := get(s, nodeWhiteEnableKey)
+	output := &MethodBoolOutput{Success: len(value) > 0}
+	return output.Encode(MethodIsNodeWhiteEnabled)
+}
+
+// set node whitelist
+func SetNodeWhitelist(s *native.NativeContract) ([]byte, error) {
+	// check owner
+	if err := checkOwner(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := setNodeWhitelist(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func setNodeWhitelist(s *native.NativeContract, payload []byte) error {
+	// decode input
+	input := new(MethodSetNodeWhitelistInput)
+	if err := input.Decode(payload); err != nil {
+		log.Trace("SetNodeWhitelist", "decode input failed", err)
+		return errors.New("invalid input")
+	}
+
+	// the whitelist must keep every validator once enabled
+	if value, _ := get(s, nodeWhiteEnableKey); len(value) > 0 {
+		db := s.GetCacheDB()
+		changed := make(map[common.Address]struct{})
+		for _, addr := range input.Addrs {
+			changed[addr] = struct{}{}
+		}
+		if err := checkNodeWhitelist(s, func(addr common.Address) bool {
+			_, ok := changed[addr]
+			if input.AddOrRemove {
+				return ok || nodeWhitelist.contains(db, addr)
+			}
+			return !ok && nodeWhitelist.contains(db, addr)
+		}); err != nil {
+			log.Trace("SetNodeWhitelist", "check node whitelist failed", err)
+			return err
+		}
+	}
+
+	if input.AddOrRemove {
+		nodeWhitelist.add(s.GetCacheDB(), input.Addrs...)
+	} else {
+		nodeWhitelist.remove(s.GetCacheDB(), input.Addrs...)
+	}
+
+	// emit event log
+	if err := s.AddNotify(ABI, []string{EventSetNodeWhitelist}, input.Addrs, input.AddOrRemove); err != nil {
+		log.Trace("SetNodeWhitelist", "emit event log failed", err)
+		return errors.New("emit EventSetNodeWhitelist error")
+	}
+	return nil
+}
+
+// checkNodeWhitelist rejects a node whitelist that misses any validator of the current epoch, peers out
+// of the whitelist are dropped by the p2p server and the chain would halt.
+func checkNodeWhitelist(s *native.NativeContract, listed func(addr common.Address) bool) error {
+	if EpochValidators == nil {
+		return errors.New("epoch validators not registered")
+	}
+	validators, err := EpochValidators(s)
+	if err != nil {
+		return fmt.Errorf("get epoch validators failed, err: %v", err)
+	}
+	if len(validators) == 0 {
+		return errors.New("empty epoch validators")
+	}
+	for _, addr := range validators {
+		if !listed(addr) {
+			return fmt.Errorf("validator %s not in node whitelist", addr.Hex())
+		}
 	}
-	result, _ := json.Marshal(list)
+	return nil
+}
+
+// check if node address is in node whitelist
+func IsInNodeWhitelist(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	// decode input
+	input := new(MethodIsInNodeWhitelistInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("IsInNodeWhitelist", "decode input failed", err)
+		return utils.ByteFailed, errors.New("invalid input")
+	}
+
+	// get value
+	output := &MethodBoolOutput{Success: nodeWhitelist.contains(s.GetCacheDB(), input.Addr)}
+
+	return output.Encode(MethodIsInNodeWhitelist)
+}
+
+// get node whitelist json
+func GetNodeWhitelist(s *native.NativeContract) ([]byte, error) {
+	result, _ := json.Marshal(nodeWhitelist.all(s.GetCacheDB()))
 	output := &MethodStringOutput{Result: string(result)}
-	return output.Encode(MethodGetGasUserList)
+	return output.Encode(MethodGetNodeWhitelist)
 }
 
 // set admins
 func SetAdmins(s *native.NativeContract) ([]byte, error) {
-	ctx := s.ContractRef().CurrentContext()
-
 	// check owner
 	if err := checkOwner(s); err != nil {
 		return utils.ByteFailed, err
 	}
 
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := setAdmins(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func setAdmins(s *native.NativeContract, payload []byte) error {
 	// decode input
 	input := new(MethodSetAdminsInput)
-	if err := input.Decode(ctx.Payload); err != nil {
+	if err := input.Decode(payload); err != nil {
 		log.Trace("SetAdmins", "decode input failed", err)
-		return utils.ByteFailed, errors.New("invalid input")
+		return errors.New("invalid input")
 	}
 
-	m := getAddressMap(s, gasAdminListKey)
-	for _, v := range input.Addrs {
-		if input.AddOrRemove {
-			m[v] = struct{}{}
-		} else {
-			delete(m, v)
-		}
+	if input.AddOrRemove {
+		gasAdminList.add(s.GetCacheDB(), input.Addrs...)
+	} else {
+		gasAdminList.remove(s.GetCacheDB(), input.Addrs...)
 	}
 
-	value, err := json.Marshal(m)
-	if err != nil {
-		log.Trace("SetAdmins", "encode value failed", err)
-		return utils.ByteFailed, errors.New("encode value failed")
+	// governance should still be able to reach the approval threshold
+	if err := checkGovernorCount(s); err != nil {
+		return err
 	}
-	set(s, gasAdminListKey, value)
 
 	// emit event log
 	if err := s.AddNotify(ABI, []string{EventSetAdmins}, input.Addrs, input.AddOrRemove); err != nil {
 		log.Trace("SetAdmins", "emit event log failed", err)
-		return utils.ByteFailed, errors.New("emit EventSetAdmins error")
+		return errors.New("emit EventSetAdmins error")
 	}
-
-	return utils.ByteSuccess, nil
+	return nil
 }
 
 // check if address is in admin list
@@ -507,21 +701,62 @@ func IsAdmin(s *native.NativeContract) ([]byte, error) {
 	}
 
 	// get value
-	m := getAddressMap(s, gasAdminListKey)
-	_, ok := m[input.Addr]
-	output := &MethodBoolOutput{Success: ok}
+	output := &MethodBoolOutput{Success: gasAdminList.contains(s.GetCacheDB(), input.Addr)}
 
 	return output.Encode(MethodIsAdmin)
 }
 
 // get admin list json
 func GetAdminList(s *native.NativeContract) ([]byte, error) {
-	m := getAddressMap(s, gasAdminListKey)
-	list := make([]common.Address, 0, len(m))
-	for key := range m {
-		list = append(list, key)
-	}
-	result, _ := json.Marshal(list)
+	result, _ := json.Marshal(gasAdminList.all(s.GetCacheDB()))
 	output := &MethodStringOutput{Result: string(result)}
 	return output.Encode(MethodGetAdminList)
 }
+
+// get address list page, `limit` is capped by MaxListPageLimit
+func getListPage(s *native.NativeContract, list *addressList, methodName string) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	// decode input
+	input := new(MethodListPageInput)
+	if err := input.Decode(ctx.Payload, methodName); err != nil {
+		log.Trace(methodName, "decode input failed", err)
+		return utils.ByteFailed, errors.New("invalid input")
+	}
+	if input.Start == nil || input.Limit == nil || !input.Start.IsUint64() || !input.Limit.IsUint64() {
+		return utils.ByteFailed, errors.New("invalid page range")
+	}
+	limit := input.Limit.Uint64()
+	if limit > MaxListPageLimit {
+		limit = MaxListPageLimit
+	}
+
+	page, total := list.page(s.GetCacheDB(), input.Start.Uint64(), limit)
+	output := &MethodListPageOutput{List: page, Total: new(big.Int).SetUint64(total)}
+	return output.Encode(methodName)
+}
+
+// get blacklist page
+func GetBlacklistPage(s *native.NativeContract) ([]byte, error) {
+	return getListPage(s, blacklist, MethodGetBlacklistPage)
+}
+
+// get gas manager list page
+func GetGasManagerListPage(s *native.NativeContract) ([]byte, error) {
+	return getListPage(s, gasManagerList, MethodGetGasManagerListPage)
+}
+
+// get gas user list page
+func GetGasUserListPage(s *native.NativeContract) ([]byte, error) {
+	return getListPage(s, gasUserList, MethodGetGasUserListPage)
+}
+
+// get admin list page
+func GetAdminListPage(s *native.NativeContract) ([]byte, error) {
+	return getListPage(s, gasAdminList, MethodGetAdminListPage)
+}
+
+// get node whitelist page
+func GetNodeWhitelistPage(s *native.NativeContract) ([]byte, error) {
+	return getListPage(s, nodeWhitelist, MethodGetNodeWhitelistPage)
+}
@@ -515,3 +515,114 @@ func TestMethodEnableGasManage(t *testing.T) {
 		assert.Equal(t, v.ReturnData, result)
 	}
 }
+
+func TestMethodSetNodeWhitelist(t *testing.T) {
+	type TestCase struct {
+		Payload       []byte
+		BeforeHandler func(c *TestCase, ctx *native.NativeContract)
+		ReturnData    []byte
+		Expect        error
+	}
+	nodes := []common.Address{testAddresses[3], testAddresses[4]}
+	epochValidators := EpochValidators
+	EpochValidators = func(s *native.NativeContract) ([]common.Address, error) {
+		return nodes[1:], nil
+	}
+	defer func() { EpochValidators = epochValidators }()
+
+	cases := []*TestCase{
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodSetNodeWhitelistInput{Addrs: nodes, AddOrRemove: true}).Encode()
+			},
+			ReturnData: []byte{'0'},
+			Expect:     errors.New("invalid authority for owner"),
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				setDefaultOwner(ctx)
+				c.Payload, _ = (&MethodEnableNodeWhiteInput{DoEnable: true}).Encode()
+			},
+			ReturnData: []byte{'0'},
+			Expect:     errors.New("validator " + testAddresses[4].Hex() + " not in node whitelist"),
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodSetNodeWhitelistInput{Addrs: nodes, AddOrRemove: true}).Encode()
+			},
+			ReturnData: []byte{'1'},
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodEnableNodeWhiteInput{DoEnable: true}).Encode()
+			},
+			ReturnData: []byte{'1'},
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = utils.PackMethod(ABI, MethodIsNodeWhiteEnabled)
+			},
+			ReturnData: encodeMethodBoolOutput(true, MethodIsNodeWhiteEnabled),
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodIsInNodeWhitelistInput{Addr: testAddresses[4]}).Encode()
+			},
+			ReturnData: encodeMethodBoolOutput(true, MethodIsInNodeWhitelist),
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodSetNodeWhitelistInput{Addrs: nodes[1:], AddOrRemove: false}).Encode()
+			},
+			ReturnData: []byte{'0'},
+			Expect:     errors.New("validator " + testAddresses[4].Hex() + " not in node whitelist"),
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodSetNodeWhitelistInput{Addrs: nodes[:1], AddOrRemove: false}).Encode()
+			},
+			ReturnData: []byte{'1'},
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodIsInNodeWhitelistInput{Addr: testAddresses[3]}).Encode()
+			},
+			ReturnData: encodeMethodBoolOutput(false, MethodIsInNodeWhitelist),
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = utils.PackMethod(ABI, MethodGetNodeWhitelist)
+			},
+			ReturnData: encodeMethodStringOutput("[\""+strings.ToLower(testAddresses[4].String())+"\"]", MethodGetNodeWhitelist),
+			Expect:     nil,
+		},
+		{
+			BeforeHandler: func(c *TestCase, ctx *native.NativeContract) {
+				c.Payload, _ = (&MethodListPageInput{Start: big.NewInt(0), Limit: big.NewInt(10)}).Encode(MethodGetNodeWhitelistPage)
+			},
+			ReturnData: func() []byte {
+				enc, _ := (&MethodListPageOutput{List: nodes[1:], Total: big.NewInt(1)}).Encode(MethodGetNodeWhitelistPage)
+				return enc
+			}(),
+			Expect: nil,
+		},
+	}
+
+	resetTestContext()
+	ctx := generateNativeContract(testCaller, 3)
+
+	for _, v := range cases {
+		if v.BeforeHandler != nil {
+			v.BeforeHandler(v, ctx)
+		}
+		result, _, err := ctx.ContractRef().NativeCall(testCaller, this, v.Payload)
+		assert.Equal(t, v.Expect, err)
+		assert.Equal(t, v.ReturnData, result)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maas_config
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// GenesisConfig is the maas config written into the genesis state, so that the chain is governed from
+// the first block instead of being owned by whoever calls changeOwner first.
+type GenesisConfig struct {
+	Owner           common.Address   `json:"owner"`
+	Admins          []common.Address `json:"admins,omitempty"`
+	Blacklist       []common.Address `json:"blacklist,omitempty"`
+	GasManageEnable bool             `json:"gasManageEnable,omitempty"`
+	GasManagers     []common.Address `json:"gasManagers,omitempty"`
+	GasUsers        []common.Address `json:"gasUsers,omitempty"`
+	NodeWhiteEnable bool             `json:"nodeWhiteEnable,omitempty"`
+	NodeWhitelist   []common.Address `json:"nodeWhitelist,omitempty"`
+}
+
+// Validate checks the genesis config against the rules applied to the same mutations of maas config.
+func (c *GenesisConfig) Validate() error {
+	blocked := make(map[common.Address]struct{})
+	for _, addr := range c.Blacklist {
+		blocked[addr] = struct{}{}
+	}
+	if _, ok := blocked[c.Owner]; ok && c.Owner != common.EmptyAddress {
+		return fmt.Errorf("owner %s in blacklist", c.Owner.Hex())
+	}
+	for _, addr := range c.Admins {
+		if _, ok := blocked[addr]; ok {
+			return fmt.Errorf("admin %s in blacklist", addr.Hex())
+		}
+	}
+	return nil
+}
+
+// StoreGenesis writes the genesis maas config into state, it should be applied after the address list
+// migration of genesis block so that the lists are written in the right layout.
+func StoreGenesis(statedb *state.StateDB, config *GenesisConfig) {
+	db := (*state.CacheDB)(statedb)
+	if config.Owner != common.EmptyAddress {
+		customSet(db, ownerKey, config.Owner.Bytes())
+	}
+	gasAdminList.add(db, config.Admins...)
+	blacklist.add(db, config.Blacklist...)
+	if config.GasManageEnable {
+		customSet(db, gasManageEnableKey, utils.BYTE_TRUE)
+	}
+	gasManagerList.add(db, config.GasManagers...)
+	gasUserList.add(db, config.GasUsers...)
+	if config.NodeWhiteEnable {
+		customSet(db, nodeWhiteEnableKey, utils.BYTE_TRUE)
+	}
+	nodeWhitelist.add(db, config.NodeWhitelist...)
+}
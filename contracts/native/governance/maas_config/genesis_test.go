@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maas_config
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStoreGenesis(t *testing.T) {
+	config := &GenesisConfig{
+		Owner:           testAddresses[0],
+		Admins:          testAddresses[1:3],
+		Blacklist:       testAddresses[4:5],
+		GasManageEnable: true,
+		GasManagers:     testAddresses[1:2],
+		GasUsers:        testAddresses[3:5],
+		NodeWhiteEnable: true,
+		NodeWhitelist:   testAddresses[2:4],
+	}
+
+	for _, migrated := range []bool{false, true} {
+		resetTestContext()
+		if migrated {
+			MigrateAddressLists(testStateDB)
+		}
+		StoreGenesis(testStateDB, config)
+
+		db := (*state.CacheDB)(testStateDB)
+		assert.Equal(t, testAddresses[0], getOwner(testEmptyCtx))
+		assert.True(t, gasAdminList.contains(db, testAddresses[1]))
+		assert.True(t, gasAdminList.contains(db, testAddresses[2]))
+		assert.Equal(t, uint64(3), governorCount(testEmptyCtx))
+		assert.True(t, blacklist.contains(db, testAddresses[4]))
+		value, _ := customGet(db, gasManageEnableKey)
+		assert.NotEmpty(t, value)
+		assert.True(t, gasManagerList.contains(db, testAddresses[1]))
+		assert.Equal(t, uint64(2), gasUserList.size(db))
+		value, _ = customGet(db, nodeWhiteEnableKey)
+		assert.NotEmpty(t, value)
+		assert.Equal(t, uint64(2), nodeWhitelist.size(db))
+		assert.True(t, nodeWhitelist.contains(db, testAddresses[3]))
+
+		// the legacy lists are moved into per-address storage by the migration
+		if !migrated {
+			MigrateAddressLists(testStateDB)
+			assert.True(t, gasAdminList.contains(db, testAddresses[2]))
+			assert.True(t, nodeWhitelist.contains(db, testAddresses[2]))
+			assert.True(t, gasUserList.contains(db, testAddresses[4]))
+		}
+	}
+}
+
+func TestGenesisConfigValidate(t *testing.T) {
+	config := &GenesisConfig{Owner: testAddresses[0], Admins: testAddresses[1:2], Blacklist: testAddresses[2:3]}
+	assert.NoError(t, config.Validate())
+
+	config.Blacklist = testAddresses[0:1]
+	assert.Error(t, config.Validate())
+
+	config.Blacklist = testAddresses[1:2]
+	assert.Error(t, config.Validate())
+
+	config.Owner = common.EmptyAddress
+	config.Blacklist = []common.Address{common.EmptyAddress}
+	config.Admins = nil
+	assert.NoError(t, config.Validate())
+}
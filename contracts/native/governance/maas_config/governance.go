@@ -0,0 +1,441 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maas_config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Governance denotes the approval mode of maas config mutations. Mutations are applied by the owner
+// directly if threshold is 0, otherwise they should be proposed, approved by `threshold` governors
+// (the owner and admins) and executed at least `delay` blocks after approval.
+type Governance struct {
+	Threshold uint64 `json:"threshold"`
+	Delay     uint64 `json:"delay"`
+}
+
+type ProposalStatus uint8
+
+const (
+	ProposalPending   ProposalStatus = iota + 1 // waiting for approvals
+	ProposalQueued                              // approved and time locked until eta
+	ProposalExecuted                            // mutation applied
+	ProposalCancelled                           // dropped before execution
+)
+
+func (p ProposalStatus) String() string {
+	switch p {
+	case ProposalPending:
+		return "pending"
+	case ProposalQueued:
+		return "queued"
+	case ProposalExecuted:
+		return "executed"
+	case ProposalCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+func (p ProposalStatus) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// Proposal is a queued maas config mutation, the payload is the abi encoded call of governed method.
+type Proposal struct {
+	ID           uint64           `json:"id"`
+	Proposer     common.Address   `json:"proposer"`
+	Method       string           `json:"method"`
+	Payload      hexutil.Bytes    `json:"payload"`
+	Approvals    []common.Address `json:"approvals"`
+	Status       ProposalStatus   `json:"status"`
+	CreateHeight uint64           `json:"createHeight"`
+	Eta          uint64           `json:"eta"` // height from which the queued proposal is executable
+}
+
+// governedMethods are the mutations which should be approved by governance once enabled.
+var governedMethods = map[string]func(s *native.NativeContract, payload []byte) error{
+	MethodChangeOwner:      changeOwner,
+	MethodBlockAccount:     blockAccount,
+	MethodEnableGasManage:  enableGasManage,
+	MethodSetGasManager:    setGasManager,
+	MethodSetGasUsers:      setGasUsers,
+	MethodSetAdmins:        setAdmins,
+	MethodEnableNodeWhite:  enableNodeWhite,
+	MethodSetNodeWhitelist: setNodeWhitelist,
+	MethodSetGovernance:    setGovernance,
+}
+
+func getGovernance(s *native.NativeContract) *Governance {
+	gov := new(Governance)
+	value, _ := get(s, governanceKey)
+	if len(value) > 0 {
+		if err := rlp.DecodeBytes(value, gov); err != nil {
+			log.Warn("maas config governance decode failed", "err", err)
+		}
+	}
+	return gov
+}
+
+func getProposal(s *native.NativeContract, id uint64) (*Proposal, error) {
+	value, _ := get(s, proposalKey(id))
+	if len(value) == 0 {
+		return nil, fmt.Errorf("proposal %d not found", id)
+	}
+	proposal := new(Proposal)
+	if err := rlp.DecodeBytes(value, proposal); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func storeProposal(s *native.NativeContract, proposal *Proposal) error {
+	value, err := rlp.EncodeToBytes(proposal)
+	if err != nil {
+		return err
+	}
+	set(s, proposalKey(proposal.ID), value)
+	return nil
+}
+
+func isGovernor(s *native.NativeContract, addr common.Address) bool {
+	owner := getOwner(s)
+	return (owner != common.EmptyAddress && addr == owner) || gasAdminList.contains(s.GetCacheDB(), addr)
+}
+
+func governorCount(s *native.NativeContract) uint64 {
+	count := gasAdminList.size(s.GetCacheDB())
+	if owner := getOwner(s); owner != common.EmptyAddress && !gasAdminList.contains(s.GetCacheDB(), owner) {
+		count++
+	}
+	return count
+}
+
+// checkGovernorCount checks that the governors are able to reach the approval threshold.
+func checkGovernorCount(s *native.NativeContract) error {
+	if threshold := getGovernance(s).Threshold; threshold > 0 && governorCount(s) < threshold {
+		return fmt.Errorf("governors less than approval threshold %d", threshold)
+	}
+	return nil
+}
+
+// checkDirectCall rejects mutations applied without governance approval.
+func checkDirectCall(s *native.NativeContract) error {
+	if getGovernance(s).Threshold > 0 {
+		return errors.New("mutation should be proposed and approved by governance")
+	}
+	return nil
+}
+
+func checkGovernor(s *native.NativeContract) (common.Address, error) {
+	caller := s.ContractRef().CurrentContext().Caller
+	origin := s.ContractRef().TxOrigin()
+	if caller != origin {
+		return common.EmptyAddress, errors.New("caller is not equal to origin")
+	}
+	if !isGovernor(s, origin) {
+		return common.EmptyAddress, errors.New("invalid authority for governor")
+	}
+	return origin, nil
+}
+
+func emitProposalEvent(s *native.NativeContract, event string, id uint64, args ...interface{}) error {
+	data := append([]interface{}{common.BigToHash(new(big.Int).SetUint64(id))}, args...)
+	if err := s.AddNotify(ABI, []string{event}, data...); err != nil {
+		log.Trace(event, "emit event log failed", err)
+		return fmt.Errorf("emit Event%s error", event)
+	}
+	return nil
+}
+
+// set governance, applied by owner directly before governance enabled
+func SetGovernance(s *native.NativeContract) ([]byte, error) {
+	// check owner
+	if err := checkOwner(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	// check governance
+	if err := checkDirectCall(s); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	if err := setGovernance(s, s.ContractRef().CurrentContext().Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+func setGovernance(s *native.NativeContract, payload []byte) error {
+	// decode input
+	input := new(MethodSetGovernanceInput)
+	if err := input.Decode(payload); err != nil {
+		log.Trace("SetGovernance", "decode input failed", err)
+		return errors.New("invalid input")
+	}
+	if input.Threshold == nil || input.Delay == nil || !input.Threshold.IsUint64() || !input.Delay.IsUint64() {
+		return errors.New("invalid governance params")
+	}
+
+	gov := &Governance{Threshold: input.Threshold.Uint64(), Delay: input.Delay.Uint64()}
+	value, err := rlp.EncodeToBytes(gov)
+	if err != nil {
+		return err
+	}
+	set(s, governanceKey, value)
+	if err := checkGovernorCount(s); err != nil {
+		return err
+	}
+
+	// emit event log
+	if err := s.AddNotify(ABI, []string{EventSetGovernance}, input.Threshold, input.Delay); err != nil {
+		log.Trace("SetGovernance", "emit event log failed", err)
+		return errors.New("emit EventSetGovernance error")
+	}
+	return nil
+}
+
+// get governance params
+func GetGovernance(s *native.NativeContract) ([]byte, error) {
+	gov := getGovernance(s)
+	output := &MethodGovernanceOutput{
+		Threshold: new(big.Int).SetUint64(gov.Threshold),
+		Delay:     new(big.Int).SetUint64(gov.Delay),
+	}
+	return output.Encode()
+}
+
+// propose a governed mutation, the proposer approves it implicitly
+func Propose(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	proposer, err := checkGovernor(s)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	gov := getGovernance(s)
+	if gov.Threshold == 0 {
+		return utils.ByteFailed, errors.New("governance not enabled")
+	}
+
+	// decode input
+	input := new(MethodProposeInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("Propose", "decode input failed", err)
+		return utils.ByteFailed, errors.New("invalid input")
+	}
+
+	// verify the mutation
+	if len(input.Payload) < 4 {
+		return utils.ByteFailed, errors.New("invalid proposal payload")
+	}
+	method, err := ABI.MethodById(input.Payload[:4])
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if _, ok := governedMethods[method.Name]; !ok {
+		return utils.ByteFailed, fmt.Errorf("method %s is not governed", method.Name)
+	}
+	if _, err := method.Inputs.Unpack(input.Payload[4:]); err != nil {
+		return utils.ByteFailed, fmt.Errorf("invalid proposal payload: %v", err)
+	}
+
+	// store proposal
+	value, _ := get(s, proposalCountKey)
+	id := utils.GetBytesUint64(value) + 1
+	set(s, proposalCountKey, utils.GetUint64Bytes(id))
+	proposal := &Proposal{
+		ID:           id,
+		Proposer:     proposer,
+		Method:       method.Name,
+		Payload:      input.Payload,
+		Approvals:    []common.Address{proposer},
+		Status:       ProposalPending,
+		CreateHeight: s.ContractRef().BlockHeight().Uint64(),
+	}
+	if err := emitProposalEvent(s, EventProposalCreated, id, common.BytesToHash(proposer.Bytes()), []byte(input.Payload)); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := queueProposal(s, gov, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := storeProposal(s, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+
+	output := &MethodProposeOutput{ID: new(big.Int).SetUint64(id)}
+	return output.Encode()
+}
+
+// queueProposal starts the time lock of proposal once approved by threshold governors.
+func queueProposal(s *native.NativeContract, gov *Governance, proposal *Proposal) error {
+	if uint64(len(proposal.Approvals)) < gov.Threshold {
+		return nil
+	}
+	proposal.Status = ProposalQueued
+	proposal.Eta = s.ContractRef().BlockHeight().Uint64() + gov.Delay
+	return emitProposalEvent(s, EventProposalQueued, proposal.ID, new(big.Int).SetUint64(proposal.Eta))
+}
+
+func decodeProposalID(s *native.NativeContract, methodName string) (uint64, error) {
+	input := new(MethodProposalIDInput)
+	if err := input.Decode(s.ContractRef().CurrentContext().Payload, methodName); err != nil {
+		log.Trace(methodName, "decode input failed", err)
+		return 0, errors.New("invalid input")
+	}
+	if input.ID == nil || !input.ID.IsUint64() {
+		return 0, errors.New("invalid proposal id")
+	}
+	return input.ID.Uint64(), nil
+}
+
+// approve a pending proposal
+func Approve(s *native.NativeContract) ([]byte, error) {
+	approver, err := checkGovernor(s)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	gov := getGovernance(s)
+	if gov.Threshold == 0 {
+		return utils.ByteFailed, errors.New("governance not enabled")
+	}
+	id, err := decodeProposalID(s, MethodApprove)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	proposal, err := getProposal(s, id)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if proposal.Status != ProposalPending {
+		return utils.ByteFailed, fmt.Errorf("proposal %d is %s", id, proposal.Status)
+	}
+	for _, addr := range proposal.Approvals {
+		if addr == approver {
+			return utils.ByteFailed, fmt.Errorf("proposal %d already approved by %s", id, approver.Hex())
+		}
+	}
+
+	proposal.Approvals = append(proposal.Approvals, approver)
+	approvals := new(big.Int).SetUint64(uint64(len(proposal.Approvals)))
+	if err := emitProposalEvent(s, EventProposalApproved, id, common.BytesToHash(approver.Bytes()), approvals); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := queueProposal(s, gov, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := storeProposal(s, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+// cancel a pending or queued proposal before execution, only the proposer is allowed to withdraw it,
+// so that a single governor is not able to veto the proposals approved by others
+func Cancel(s *native.NativeContract) ([]byte, error) {
+	canceller, err := checkGovernor(s)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	id, err := decodeProposalID(s, MethodCancel)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	proposal, err := getProposal(s, id)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if proposal.Proposer != canceller {
+		return utils.ByteFailed, fmt.Errorf("proposal %d is only cancelled by proposer %s", id, proposal.Proposer.Hex())
+	}
+	if proposal.Status != ProposalPending && proposal.Status != ProposalQueued {
+		return utils.ByteFailed, fmt.Errorf("proposal %d is %s", id, proposal.Status)
+	}
+
+	proposal.Status = ProposalCancelled
+	if err := storeProposal(s, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := emitProposalEvent(s, EventProposalCancelled, id, common.BytesToHash(canceller.Bytes())); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+// execute a queued proposal after its time lock
+func Execute(s *native.NativeContract) ([]byte, error) {
+	if _, err := checkGovernor(s); err != nil {
+		return utils.ByteFailed, err
+	}
+	if getGovernance(s).Threshold == 0 {
+		return utils.ByteFailed, errors.New("governance not enabled")
+	}
+	id, err := decodeProposalID(s, MethodExecute)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	proposal, err := getProposal(s, id)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	if proposal.Status != ProposalQueued {
+		return utils.ByteFailed, fmt.Errorf("proposal %d is %s", id, proposal.Status)
+	}
+	if height := s.ContractRef().BlockHeight().Uint64(); height < proposal.Eta {
+		return utils.ByteFailed, fmt.Errorf("proposal %d is time locked until block %d", id, proposal.Eta)
+	}
+
+	proposal.Status = ProposalExecuted
+	if err := storeProposal(s, proposal); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := governedMethods[proposal.Method](s, proposal.Payload); err != nil {
+		return utils.ByteFailed, err
+	}
+	if err := emitProposalEvent(s, EventProposalExecuted, id); err != nil {
+		return utils.ByteFailed, err
+	}
+	return utils.ByteSuccess, nil
+}
+
+// get proposal json
+func GetProposal(s *native.NativeContract) ([]byte, error) {
+	id, err := decodeProposalID(s, MethodGetProposal)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	proposal, err := getProposal(s, id)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	result, _ := json.Marshal(proposal)
+	output := &MethodStringOutput{Result: string(result)}
+	return output.Encode(MethodGetProposal)
+}
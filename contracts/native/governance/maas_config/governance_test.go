@@ -0,0 +1,206 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maas_config
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func governanceCall(origin common.Address, blockNum int, payload []byte) ([]byte, error) {
+	ctx := generateNativeContract(origin, blockNum)
+	// failed calls are reverted as they are in evm
+	ctx.ContractRef().EnableRevert()
+	result, _, err := ctx.ContractRef().NativeCall(origin, this, payload)
+	return result, err
+}
+
+func proposalAction(t *testing.T, methodName string, id uint64) []byte {
+	payload, err := (&MethodProposalIDInput{ID: new(big.Int).SetUint64(id)}).Encode(methodName)
+	assert.NoError(t, err)
+	return payload
+}
+
+func propose(t *testing.T, origin common.Address, blockNum int, mutation []byte) (uint64, error) {
+	payload, err := (&MethodProposeInput{Payload: mutation}).Encode()
+	assert.NoError(t, err)
+	result, err := governanceCall(origin, blockNum, payload)
+	if err != nil {
+		return 0, err
+	}
+	output := new(MethodProposeOutput)
+	assert.NoError(t, output.Decode(result))
+	return output.ID.Uint64(), nil
+}
+
+func getTestProposal(t *testing.T, id uint64) *Proposal {
+	result, err := governanceCall(testCaller, 1, proposalAction(t, MethodGetProposal, id))
+	assert.NoError(t, err)
+	output := new(MethodStringOutput)
+	assert.NoError(t, output.Decode(result, MethodGetProposal))
+	var proposal struct {
+		Status    string
+		Approvals []common.Address
+		Eta       uint64
+	}
+	assert.NoError(t, json.Unmarshal([]byte(output.Result), &proposal))
+	return &Proposal{Status: map[string]ProposalStatus{
+		"pending": ProposalPending, "queued": ProposalQueued, "executed": ProposalExecuted, "cancelled": ProposalCancelled,
+	}[proposal.Status], Approvals: proposal.Approvals, Eta: proposal.Eta}
+}
+
+func TestGovernanceProposal(t *testing.T) {
+	resetTestContext()
+	owner, admin1, admin2, target, stranger := testAddresses[0], testAddresses[1], testAddresses[2], testAddresses[3], testAddresses[4]
+	setDefaultOwner(generateNativeContract(owner, 1))
+
+	payload, _ := (&MethodSetAdminsInput{Addrs: []common.Address{admin1, admin2}, AddOrRemove: true}).Encode()
+	_, err := governanceCall(owner, 1, payload)
+	assert.NoError(t, err)
+
+	// threshold above governors count
+	payload, _ = (&MethodSetGovernanceInput{Threshold: big.NewInt(4), Delay: big.NewInt(10)}).Encode()
+	_, err = governanceCall(owner, 1, payload)
+	assert.Error(t, err)
+
+	payload, _ = (&MethodSetGovernanceInput{Threshold: big.NewInt(2), Delay: big.NewInt(10)}).Encode()
+	_, err = governanceCall(owner, 1, payload)
+	assert.NoError(t, err)
+
+	getGovernancePayload, _ := utils.PackMethod(ABI, MethodGetGovernance)
+	result, err := governanceCall(stranger, 1, getGovernancePayload)
+	assert.NoError(t, err)
+	gov := new(MethodGovernanceOutput)
+	assert.NoError(t, gov.Decode(result))
+	assert.Equal(t, uint64(2), gov.Threshold.Uint64())
+	assert.Equal(t, uint64(10), gov.Delay.Uint64())
+
+	// owner is no longer able to mutate directly
+	blockTarget, _ := (&MethodBlockAccountInput{Addr: target, DoBlock: true}).Encode()
+	_, err = governanceCall(owner, 2, blockTarget)
+	assert.EqualError(t, err, "mutation should be proposed and approved by governance")
+
+	// only governors propose governed methods
+	_, err = propose(t, stranger, 5, blockTarget)
+	assert.Error(t, err)
+	isBlocked, _ := (&MethodIsBlockedInput{Addr: target}).Encode()
+	_, err = propose(t, admin1, 5, isBlocked)
+	assert.Error(t, err)
+
+	id, err := propose(t, admin1, 5, blockTarget)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), id)
+	assert.Equal(t, ProposalPending, getTestProposal(t, id).Status)
+
+	_, err = governanceCall(owner, 5, proposalAction(t, MethodExecute, id))
+	assert.Error(t, err)
+	_, err = governanceCall(admin1, 5, proposalAction(t, MethodApprove, id))
+	assert.Error(t, err)
+
+	_, err = governanceCall(admin2, 6, proposalAction(t, MethodApprove, id))
+	assert.NoError(t, err)
+	proposal := getTestProposal(t, id)
+	assert.Equal(t, ProposalQueued, proposal.Status)
+	assert.Equal(t, []common.Address{admin1, admin2}, proposal.Approvals)
+	assert.Equal(t, uint64(16), proposal.Eta)
+
+	// time locked
+	_, err = governanceCall(owner, 15, proposalAction(t, MethodExecute, id))
+	assert.EqualError(t, err, "proposal 1 is time locked until block 16")
+
+	_, err = governanceCall(owner, 16, proposalAction(t, MethodExecute, id))
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalExecuted, getTestProposal(t, id).Status)
+	result, err = governanceCall(stranger, 16, isBlocked)
+	assert.NoError(t, err)
+	assert.Equal(t, encodeMethodBoolOutput(true, MethodIsBlocked), result)
+
+	_, err = governanceCall(owner, 17, proposalAction(t, MethodExecute, id))
+	assert.Error(t, err)
+}
+
+func TestGovernanceCancel(t *testing.T) {
+	resetTestContext()
+	owner, admin1, admin2, target, stranger := testAddresses[0], testAddresses[1], testAddresses[2], testAddresses[3], testAddresses[4]
+	setDefaultOwner(generateNativeContract(owner, 1))
+
+	payload, _ := (&MethodSetAdminsInput{Addrs: []common.Address{admin1, admin2}, AddOrRemove: true}).Encode()
+	_, err := governanceCall(owner, 1, payload)
+	assert.NoError(t, err)
+	payload, _ = (&MethodSetGovernanceInput{Threshold: big.NewInt(2), Delay: big.NewInt(0)}).Encode()
+	_, err = governanceCall(owner, 1, payload)
+	assert.NoError(t, err)
+
+	// cancel a pending proposal
+	enable, _ := (&MethodEnableGasManageInput{DoEnable: true}).Encode()
+	id, err := propose(t, owner, 2, enable)
+	assert.NoError(t, err)
+	_, err = governanceCall(stranger, 2, proposalAction(t, MethodCancel, id))
+	assert.Error(t, err)
+	// other governors are not able to veto the proposal
+	_, err = governanceCall(admin2, 2, proposalAction(t, MethodCancel, id))
+	assert.EqualError(t, err, "proposal 1 is only cancelled by proposer "+owner.Hex())
+	assert.Equal(t, ProposalPending, getTestProposal(t, id).Status)
+	_, err = governanceCall(owner, 2, proposalAction(t, MethodCancel, id))
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalCancelled, getTestProposal(t, id).Status)
+	_, err = governanceCall(admin1, 3, proposalAction(t, MethodApprove, id))
+	assert.Error(t, err)
+
+	// cancel a queued proposal
+	blockTarget, _ := (&MethodBlockAccountInput{Addr: target, DoBlock: true}).Encode()
+	id, err = propose(t, admin1, 3, blockTarget)
+	assert.NoError(t, err)
+	_, err = governanceCall(admin2, 3, proposalAction(t, MethodApprove, id))
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalQueued, getTestProposal(t, id).Status)
+	_, err = governanceCall(owner, 3, proposalAction(t, MethodCancel, id))
+	assert.Error(t, err)
+	_, err = governanceCall(admin1, 3, proposalAction(t, MethodCancel, id))
+	assert.NoError(t, err)
+	assert.Equal(t, ProposalCancelled, getTestProposal(t, id).Status)
+	_, err = governanceCall(owner, 4, proposalAction(t, MethodExecute, id))
+	assert.Error(t, err)
+
+	// removing admins below threshold fails at execution
+	removeAdmins, _ := (&MethodSetAdminsInput{Addrs: []common.Address{admin1, admin2}, AddOrRemove: false}).Encode()
+	id, err = propose(t, admin1, 5, removeAdmins)
+	assert.NoError(t, err)
+	_, err = governanceCall(admin2, 5, proposalAction(t, MethodApprove, id))
+	assert.NoError(t, err)
+	_, err = governanceCall(owner, 5, proposalAction(t, MethodExecute, id))
+	assert.EqualError(t, err, "governors less than approval threshold 2")
+	assert.Equal(t, ProposalQueued, getTestProposal(t, id).Status)
+
+	// disable governance by proposal
+	disable, _ := (&MethodSetGovernanceInput{Threshold: big.NewInt(0), Delay: big.NewInt(0)}).Encode()
+	id, err = propose(t, admin1, 6, disable)
+	assert.NoError(t, err)
+	_, err = governanceCall(owner, 6, proposalAction(t, MethodApprove, id))
+	assert.NoError(t, err)
+	_, err = governanceCall(admin2, 6, proposalAction(t, MethodExecute, id))
+	assert.NoError(t, err)
+	_, err = governanceCall(owner, 7, enable)
+	assert.NoError(t, err)
+}
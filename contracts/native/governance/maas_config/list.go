@@ -0,0 +1,240 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package maas_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// storage key prefix of per-address lists
+const (
+	LIST_MIGRATED = "list_migrated"
+	LIST_SIZE     = "_size"
+	LIST_INDEX    = "_index"
+	LIST_ITEM     = "_item"
+)
+
+// MaxListPageLimit is the max number of addresses returned by one page getter
+const MaxListPageLimit = 1000
+
+var listMigratedKey = utils.ConcatKey(this, []byte(LIST_MIGRATED))
+
+// addressList is a set of addresses stored per address, membership is checked by the index key of address
+// and the items are kept in a dense array so that they can be paged. Before the migration the list is
+// read from and written to the legacy JSON encoded map.
+type addressList struct {
+	name      string
+	legacyKey []byte
+}
+
+var (
+	blacklist      = &addressList{name: BLACKLIST, legacyKey: blacklistKey}
+	gasManagerList = &addressList{name: GAS_MANAGER_LIST, legacyKey: gasManagerListKey}
+	gasUserList    = &addressList{name: GAS_USER_LIST, legacyKey: gasUserListKey}
+	gasAdminList   = &addressList{name: GAS_ADMIN_LIST, legacyKey: gasAdminListKey}
+	nodeWhitelist  = &addressList{name: NODE_WHITELIST, legacyKey: nodeWhitelistKey}
+
+	addressLists = []*addressList{blacklist, gasManagerList, gasUserList, gasAdminList, nodeWhitelist}
+)
+
+func (l *addressList) sizeKey() []byte {
+	return utils.ConcatKey(this, []byte(l.name+LIST_SIZE))
+}
+
+func (l *addressList) indexKey(addr common.Address) []byte {
+	return utils.ConcatKey(this, []byte(l.name+LIST_INDEX), addr.Bytes())
+}
+
+func (l *addressList) itemKey(index uint64) []byte {
+	return utils.ConcatKey(this, []byte(l.name+LIST_ITEM), utils.GetUint64Bytes(index))
+}
+
+// index returns the 1-based position of address in list, 0 means not exist.
+func (l *addressList) index(db *state.CacheDB, addr common.Address) uint64 {
+	value, _ := customGet(db, l.indexKey(addr))
+	return utils.GetBytesUint64(value)
+}
+
+func (l *addressList) size(db *state.CacheDB) uint64 {
+	if !isListMigrated(db) {
+		return uint64(len(l.legacyMap(db)))
+	}
+	value, _ := customGet(db, l.sizeKey())
+	return utils.GetBytesUint64(value)
+}
+
+func (l *addressList) contains(db *state.CacheDB, addr common.Address) bool {
+	if !isListMigrated(db) {
+		_, ok := l.legacyMap(db)[addr]
+		return ok
+	}
+	return l.index(db, addr) > 0
+}
+
+func (l *addressList) add(db *state.CacheDB, addrs ...common.Address) {
+	if !isListMigrated(db) {
+		m := l.legacyMap(db)
+		for _, addr := range addrs {
+			m[addr] = struct{}{}
+		}
+		l.storeLegacyMap(db, m)
+		return
+	}
+	for _, addr := range addrs {
+		l.insert(db, addr)
+	}
+}
+
+func (l *addressList) remove(db *state.CacheDB, addrs ...common.Address) {
+	if !isListMigrated(db) {
+		m := l.legacyMap(db)
+		for _, addr := range addrs {
+			delete(m, addr)
+		}
+		l.storeLegacyMap(db, m)
+		return
+	}
+	for _, addr := range addrs {
+		l.delete(db, addr)
+	}
+}
+
+func (l *addressList) insert(db *state.CacheDB, addr common.Address) {
+	if l.index(db, addr) > 0 {
+		return
+	}
+	size, _ := customGet(db, l.sizeKey())
+	index := utils.GetBytesUint64(size) + 1
+	customSet(db, l.itemKey(index), addr.Bytes())
+	customSet(db, l.indexKey(addr), utils.GetUint64Bytes(index))
+	customSet(db, l.sizeKey(), utils.GetUint64Bytes(index))
+}
+
+// delete moves the last item to the position of the deleted one to keep the items dense.
+func (l *addressList) delete(db *state.CacheDB, addr common.Address) {
+	index := l.index(db, addr)
+	if index == 0 {
+		return
+	}
+	size, _ := customGet(db, l.sizeKey())
+	last := utils.GetBytesUint64(size)
+	if index != last {
+		value, _ := customGet(db, l.itemKey(last))
+		moved := common.BytesToAddress(value)
+		customSet(db, l.itemKey(index), moved.Bytes())
+		customSet(db, l.indexKey(moved), utils.GetUint64Bytes(index))
+	}
+	customDel(db, l.itemKey(last))
+	customDel(db, l.indexKey(addr))
+	if last > 1 {
+		customSet(db, l.sizeKey(), utils.GetUint64Bytes(last-1))
+	} else {
+		customDel(db, l.sizeKey())
+	}
+}
+
+// page returns at most limit addresses start from the 0-based position start, and the total size of list.
+func (l *addressList) page(db *state.CacheDB, start, limit uint64) ([]common.Address, uint64) {
+	if !isListMigrated(db) {
+		list := sortedAddresses(l.legacyMap(db))
+		total := uint64(len(list))
+		if start >= total {
+			return []common.Address{}, total
+		}
+		end := total
+		if limit < total-start {
+			end = start + limit
+		}
+		return list[start:end], total
+	}
+
+	total := l.size(db)
+	list := make([]common.Address, 0)
+	for i := start; i < total && uint64(len(list)) < limit; i++ {
+		value, _ := customGet(db, l.itemKey(i+1))
+		list = append(list, common.BytesToAddress(value))
+	}
+	return list, total
+}
+
+// all returns all addresses of list, it should only be used by the deprecated json getters.
+func (l *addressList) all(db *state.CacheDB) []common.Address {
+	list, _ := l.page(db, 0, l.size(db))
+	return list
+}
+
+func (l *addressList) legacyMap(db *state.CacheDB) map[common.Address]struct{} {
+	value, _ := customGet(db, l.legacyKey)
+	m := make(map[common.Address]struct{})
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &m); err != nil {
+			log.Trace("legacyMap", "decode value failed", err)
+		}
+	}
+	return m
+}
+
+func (l *addressList) storeLegacyMap(db *state.CacheDB, m map[common.Address]struct{}) {
+	value, _ := json.Marshal(m)
+	customSet(db, l.legacyKey, value)
+}
+
+func sortedAddresses(m map[common.Address]struct{}) []common.Address {
+	list := make([]common.Address, 0, len(m))
+	for addr := range m {
+		list = append(list, addr)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return bytes.Compare(list[i][:], list[j][:]) < 0
+	})
+	return list
+}
+
+func isListMigrated(db *state.CacheDB) bool {
+	value, _ := customGet(db, listMigratedKey)
+	return len(value) > 0
+}
+
+// MigrateAddressLists moves the JSON encoded address lists of maas config into per-address storage, it
+// should be applied once at the fork block before any transaction is executed.
+func MigrateAddressLists(statedb *state.StateDB) {
+	db := (*state.CacheDB)(statedb)
+	if isListMigrated(db) {
+		return
+	}
+	legacy := make([]map[common.Address]struct{}, len(addressLists))
+	for i, l := range addressLists {
+		legacy[i] = l.legacyMap(db)
+	}
+	customSet(db, listMigratedKey, utils.BYTE_TRUE)
+	for i, l := range addressLists {
+		for _, addr := range sortedAddresses(legacy[i]) {
+			l.insert(db, addr)
+		}
+		customDel(db, l.legacyKey)
+		log.Info("Migrate maas config address list", "name", l.name, "size", len(legacy[i]))
+	}
+}
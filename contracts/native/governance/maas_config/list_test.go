@@ -0,0 +1,86 @@
+package maas_config
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressList(t *testing.T) {
+	resetTestContext()
+	db := (*state.CacheDB)(testStateDB)
+	MigrateAddressLists(testStateDB)
+
+	blacklist.add(db, testAddresses[0], testAddresses[1], testAddresses[2], testAddresses[1])
+	assert.Equal(t, uint64(3), blacklist.size(db))
+	assert.True(t, blacklist.contains(db, testAddresses[1]))
+	assert.False(t, blacklist.contains(db, testAddresses[3]))
+	assert.False(t, gasManagerList.contains(db, testAddresses[1]))
+
+	// remove the first one, the last one should be moved to its position
+	blacklist.remove(db, testAddresses[0], testAddresses[3])
+	assert.Equal(t, uint64(2), blacklist.size(db))
+	assert.False(t, blacklist.contains(db, testAddresses[0]))
+	assert.Equal(t, []common.Address{testAddresses[2], testAddresses[1]}, blacklist.all(db))
+
+	page, total := blacklist.page(db, 1, 10)
+	assert.Equal(t, uint64(2), total)
+	assert.Equal(t, []common.Address{testAddresses[1]}, page)
+	page, _ = blacklist.page(db, 5, 10)
+	assert.Empty(t, page)
+
+	blacklist.remove(db, testAddresses[1], testAddresses[2])
+	assert.Equal(t, uint64(0), blacklist.size(db))
+	assert.Empty(t, blacklist.all(db))
+}
+
+func TestMigrateAddressLists(t *testing.T) {
+	resetTestContext()
+	db := (*state.CacheDB)(testStateDB)
+
+	blacklist.add(db, testAddresses[2], testAddresses[1])
+	gasUserList.add(db, testAddresses[3])
+	legacy, _ := customGet(db, blacklistKey)
+	assert.NotEmpty(t, legacy)
+	assert.True(t, blacklist.contains(db, testAddresses[1]))
+
+	MigrateAddressLists(testStateDB)
+	legacy, _ = customGet(db, blacklistKey)
+	assert.Empty(t, legacy)
+	assert.True(t, isListMigrated(db))
+	assert.True(t, blacklist.contains(db, testAddresses[1]))
+	assert.True(t, blacklist.contains(db, testAddresses[2]))
+	assert.True(t, gasUserList.contains(db, testAddresses[3]))
+	assert.Equal(t, uint64(0), gasAdminList.size(db))
+	assert.Equal(t, sortedAddresses(map[common.Address]struct{}{testAddresses[1]: {}, testAddresses[2]: {}}), blacklist.all(db))
+
+	// migration should only be applied once
+	blacklist.remove(db, testAddresses[1])
+	MigrateAddressLists(testStateDB)
+	assert.False(t, blacklist.contains(db, testAddresses[1]))
+}
+
+func TestMethodGetBlacklistPage(t *testing.T) {
+	resetTestContext()
+	MigrateAddressLists(testStateDB)
+	ctx := generateNativeContract(testCaller, 3)
+	setDefaultOwner(ctx)
+	for _, addr := range testAddresses[1:] {
+		payload, _ := (&MethodBlockAccountInput{Addr: addr, DoBlock: true}).Encode()
+		_, _, err := ctx.ContractRef().NativeCall(testCaller, this, payload)
+		assert.NoError(t, err)
+	}
+
+	payload, err := (&MethodListPageInput{Start: big.NewInt(1), Limit: big.NewInt(2)}).Encode(MethodGetBlacklistPage)
+	assert.NoError(t, err)
+	result, _, err := ctx.ContractRef().NativeCall(testCaller, this, payload)
+	assert.NoError(t, err)
+
+	output := new(MethodListPageOutput)
+	assert.NoError(t, output.Decode(result, MethodGetBlacklistPage))
+	assert.Equal(t, testAddresses[2:4], output.List)
+	assert.Equal(t, uint64(len(testAddresses)-1), output.Total.Uint64())
+}
@@ -34,17 +34,28 @@ const (
 	GAS_MANAGER_LIST  = "gas_manager_list"
 	GAS_USER_LIST     = "gas_user_list"
 	GAS_ADMIN_LIST    = "gas_admin_list"
+	GOVERNANCE        = "governance"
+	PROPOSAL          = "proposal"
+	PROPOSAL_COUNT    = "proposal_count"
 )
 
 var (
 	blacklistKey       = utils.ConcatKey(this, []byte(BLACKLIST))
 	ownerKey           = utils.ConcatKey(this, []byte(OWNER))
 	gasManageEnableKey = utils.ConcatKey(this, []byte(GAS_MANAGE_ENABLE))
+	nodeWhiteEnableKey = utils.ConcatKey(this, []byte(NODE_WHITE_ENABLE))
+	nodeWhitelistKey   = utils.ConcatKey(this, []byte(NODE_WHITELIST))
 	gasManagerListKey  = utils.ConcatKey(this, []byte(GAS_MANAGER_LIST))
 	gasUserListKey     = utils.ConcatKey(this, []byte(GAS_USER_LIST))
 	gasAdminListKey    = utils.ConcatKey(this, []byte(GAS_ADMIN_LIST))
+	governanceKey      = utils.ConcatKey(this, []byte(GOVERNANCE))
+	proposalCountKey   = utils.ConcatKey(this, []byte(PROPOSAL_COUNT))
 )
 
+func proposalKey(id uint64) []byte {
+	return utils.ConcatKey(this, []byte(PROPOSAL), utils.GetUint64Bytes(id))
+}
+
 // ====================================================================
 //
 // storage basic operations
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native_registry
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// The minimum distance between the approving block and the activation height, nodes should upgrade
+// their binary in this period.
+const MinActivationDelay uint64 = 60
+
+var (
+	ErrUnknownContract = errors.New("contract is not a native contract")
+
+	ErrInvalidVersion = errors.New("contract version should be greater than the default version")
+
+	ErrActivationHeight = errors.New("activation height invalid")
+
+	ErrStorage = errors.New("failed to store activation")
+
+	ErrEmitLog = errors.New("failed to emit log")
+)
+
+func InitNativeRegistry() {
+	InitABI()
+	native.Contracts[this] = RegisterNativeRegistryContract(handler{})
+	native.ActiveVersion = activeVersion
+}
+
+// handler implements the methods of native registry contract.
+type handler struct{}
+
+func (handler) Name(s *native.NativeContract, input *MethodNameInput) (*MethodNameOutput, error) {
+	return &MethodNameOutput{Result: contractName}, nil
+}
+
+// ApproveUpgrade validator approves the native contract to dispatch to version from active height, the
+// activation is stored after signed by quorum size of validators in current epoch.
+func (handler) ApproveUpgrade(s *native.NativeContract, input *MethodApproveUpgradeInput) (*MethodApproveUpgradeOutput, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight().Uint64()
+	signer := s.ContractRef().TxOrigin()
+
+	if !native.IsNativeContract(input.ContractAddr) {
+		log.Trace("approveUpgrade", "check contract failed", input.ContractAddr.Hex())
+		return nil, ErrUnknownContract
+	}
+
+	// the activation already approved, late signers needn't sign any more
+	list, err := getActivations(s.GetCacheDB(), input.ContractAddr)
+	if err != nil {
+		log.Trace("approveUpgrade", "get activations failed", err)
+		return nil, ErrStorage
+	}
+	for _, v := range list {
+		if v.Version == input.Version && v.ActiveHeight == input.ActiveHeight {
+			return &MethodApproveUpgradeOutput{Result: true}, nil
+		}
+	}
+
+	if input.Version == native.DefaultVersion {
+		log.Trace("approveUpgrade", "check version failed", input.Version)
+		return nil, ErrInvalidVersion
+	}
+	if input.ActiveHeight < height+MinActivationDelay {
+		log.Trace("approveUpgrade", "active height should be after", height+MinActivationDelay, "got", input.ActiveHeight)
+		return nil, ErrActivationHeight
+	}
+	if len(list) > 0 && input.ActiveHeight <= list[len(list)-1].ActiveHeight {
+		log.Trace("approveUpgrade", "active height should be after", list[len(list)-1].ActiveHeight, "got", input.ActiveHeight)
+		return nil, ErrActivationHeight
+	}
+
+	// collect signatures of validators
+	ok, err := node_manager.CheckConsensusSigns(s, MethodApproveUpgrade, ctx.Payload, signer)
+	if err != nil {
+		log.Trace("approveUpgrade", "check consensus signs failed", err)
+		return nil, err
+	}
+	if !ok {
+		return &MethodApproveUpgradeOutput{Result: true}, nil
+	}
+
+	// store activation and emit event log
+	activation := &Activation{Version: input.Version, ActiveHeight: input.ActiveHeight}
+	if err := storeActivation(s.GetCacheDB(), input.ContractAddr, activation); err != nil {
+		log.Trace("approveUpgrade", "store activation failed", err)
+		return nil, ErrStorage
+	}
+	if err := EmitUpgradeApproved(s, common.BytesToHash(input.ContractAddr.Bytes()), input.Version, input.ActiveHeight); err != nil {
+		log.Trace("approveUpgrade", "emit event log failed", err)
+		return nil, ErrEmitLog
+	}
+	log.Info("Native contract upgrade approved", "contract", input.ContractAddr.Hex(), "version", input.Version, "height", input.ActiveHeight)
+
+	return &MethodApproveUpgradeOutput{Result: true}, nil
+}
+
+// GetActiveVersion returns the version of contract active at current block.
+func (handler) GetActiveVersion(s *native.NativeContract, input *MethodGetActiveVersionInput) (*MethodGetActiveVersionOutput, error) {
+	version := activeVersion(s.StateDB(), input.ContractAddr, s.ContractRef().BlockHeight())
+	return &MethodGetActiveVersionOutput{Result: version}, nil
+}
+
+// GetActivationsJson returns all approved activations of contract.
+func (handler) GetActivationsJson(s *native.NativeContract, input *MethodGetActivationsJsonInput) (*MethodGetActivationsJsonOutput, error) {
+	list, err := getActivations(s.GetCacheDB(), input.ContractAddr)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if list == nil {
+		list = make([]*Activation, 0)
+	}
+	enc, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	return &MethodGetActivationsJsonOutput{Result: string(enc)}, nil
+}
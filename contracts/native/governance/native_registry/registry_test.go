@@ -0,0 +1,132 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native_registry
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testSupplyGas  uint64 = 100000000000000000
+	testValidators []common.Address
+	testContract   = native.NativeContractAddrMap[native.NativeExtra3]
+)
+
+func TestMain(m *testing.M) {
+	node_manager.InitNodeManager()
+	InitNativeRegistry()
+
+	// test contract returns the version as its name
+	for _, version := range []uint64{1, 2} {
+		name := string(rune('0' + version))
+		native.RegisterVersion(testContract, version, func(s *native.NativeContract) {
+			s.Prepare(ABI, map[string]uint64{MethodName: 0})
+			s.Register(MethodName, func(s *native.NativeContract) ([]byte, error) {
+				return utils.PackOutputs(ABI, MethodName, name)
+			})
+		})
+	}
+	os.Exit(m.Run())
+}
+
+func newTestStateDB(t *testing.T) *state.StateDB {
+	db := utils.NewTestStateDB()
+	alloc := make(core.GenesisAlloc)
+	testValidators = make([]common.Address, 0)
+	for i := 0; i < 4; i++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		alloc[addr] = core.GenesisAccount{Balance: common.Big0, PublicKey: crypto.CompressPubkey(&key.PublicKey)}
+		testValidators = append(testValidators, addr)
+	}
+	assert.NoError(t, core.RegGenesis(db, alloc.Peers()))
+	return db
+}
+
+func approve(db *state.StateDB, signer common.Address, height int64, version, activeHeight uint64) error {
+	ref := native.NewContractRef(db, signer, signer, big.NewInt(height), common.EmptyHash, testSupplyGas, nil)
+	payload, _ := (&MethodApproveUpgradeInput{ContractAddr: testContract, Version: version, ActiveHeight: activeHeight}).Encode()
+	_, _, err := ref.NativeCall(signer, this, payload)
+	return err
+}
+
+func testContractName(db *state.StateDB, height int64, versioned bool) (string, error) {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, big.NewInt(height), common.EmptyHash, testSupplyGas, nil)
+	if versioned {
+		ref.EnableVersioning()
+	}
+	payload, _ := utils.PackMethod(ABI, MethodName)
+	enc, _, err := ref.NativeCall(common.EmptyAddress, testContract, payload)
+	if err != nil {
+		return "", err
+	}
+	output := new(MethodNameOutput)
+	if err := output.Decode(enc); err != nil {
+		return "", err
+	}
+	return output.Result, nil
+}
+
+func TestApproveUpgrade(t *testing.T) {
+	db := newTestStateDB(t)
+
+	assert.Equal(t, ErrInvalidVersion, approve(db, testValidators[0], 10, native.DefaultVersion, 100))
+	assert.Equal(t, ErrActivationHeight, approve(db, testValidators[0], 50, 1, 100))
+	assert.Equal(t, node_manager.ErrInvalidAuthority, approve(db, common.HexToAddress("0x01"), 10, 1, 100))
+
+	// activation stored after quorum size of validators signed
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, uint64(0), activeVersion(db, testContract, big.NewInt(-1)))
+		assert.NoError(t, approve(db, testValidators[i], 10, 1, 100))
+	}
+	assert.Equal(t, uint64(1), activeVersion(db, testContract, big.NewInt(-1)))
+	// late signer is ignored
+	assert.NoError(t, approve(db, testValidators[3], 11, 1, 100))
+	list, err := getActivations((*state.CacheDB)(db), testContract)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	// activation height should be increasing
+	assert.Equal(t, ErrActivationHeight, approve(db, testValidators[0], 20, 2, 90))
+	for i := 1; i < 4; i++ {
+		assert.NoError(t, approve(db, testValidators[i], 20, 2, 200))
+	}
+
+	_, err = testContractName(db, 99, true)
+	assert.Error(t, err)
+	for height, expect := range map[int64]string{100: "1", 199: "1", 200: "2", 1000: "2"} {
+		name, err := testContractName(db, height, true)
+		assert.NoError(t, err)
+		assert.Equal(t, expect, name)
+	}
+	// approved versions are not dispatched before the native registry fork
+	_, err = testContractName(db, 1000, false)
+	assert.Error(t, err)
+}
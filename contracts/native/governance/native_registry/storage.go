@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native_registry
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_ACTIVATION = "st_activation"
+)
+
+// Activation denotes the version of native contract dispatched from the height.
+type Activation struct {
+	Version      uint64 `json:"version"`
+	ActiveHeight uint64 `json:"activeHeight"`
+}
+
+type ActivationList struct {
+	List []*Activation
+}
+
+func activationKey(contract common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_ACTIVATION), contract.Bytes())
+}
+
+// getActivations returns the activations of contract sorted by active height.
+func getActivations(db *state.CacheDB, contract common.Address) ([]*Activation, error) {
+	value, err := db.Get(activationKey(contract))
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	var list *ActivationList
+	if err := rlp.DecodeBytes(value, &list); err != nil {
+		return nil, err
+	}
+	return list.List, nil
+}
+
+func storeActivation(db *state.CacheDB, contract common.Address, activation *Activation) error {
+	list, err := getActivations(db, contract)
+	if err != nil {
+		return err
+	}
+	list = append(list, activation)
+	value, err := rlp.EncodeToBytes(&ActivationList{List: list})
+	if err != nil {
+		return err
+	}
+	db.Put(activationKey(contract), value)
+	return nil
+}
+
+// activeVersion returns the version of contract active at height, negative height denotes the latest
+// approved version.
+func activeVersion(db *state.StateDB, contract common.Address, height *big.Int) uint64 {
+	list, err := getActivations((*state.CacheDB)(db), contract)
+	if err != nil || len(list) == 0 {
+		return native.DefaultVersion
+	}
+	if height == nil || height.Sign() < 0 {
+		return list[len(list)-1].Version
+	}
+	version := native.DefaultVersion
+	for _, v := range list {
+		if v.ActiveHeight > height.Uint64() {
+			break
+		}
+		version = v.Version
+	}
+	return version
+}
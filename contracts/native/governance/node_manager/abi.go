@@ -18,12 +18,15 @@
 
 package node_manager
 
+//go:generate nativegen --abi ../../solidity/node_manager.abi --type INodeManager --pkg node_manager_abi --binding --out ../../go_abi/node_manager_abi/node_manager_abi.go
+
 import (
 	"fmt"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
@@ -221,6 +224,77 @@ func (m *MethodProofOutput) Decode(payload []byte) error {
 	return nil
 }
 
+type MethodReportEquivocationInput struct {
+	Evidence *hotstuff.Evidence
+}
+
+func (m *MethodReportEquivocationInput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.Evidence)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackMethod(ABI, MethodReportEquivocation, enc)
+}
+func (m *MethodReportEquivocationInput) Decode(payload []byte) error {
+	var data struct {
+		Evidence []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodReportEquivocation, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.Evidence, &m.Evidence)
+}
+
+type MethodReportEquivocationOutput struct {
+	Success bool
+}
+
+func (m *MethodReportEquivocationOutput) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, MethodReportEquivocation, m.Success)
+}
+func (m *MethodReportEquivocationOutput) Decode(payload []byte) error {
+	return utils.UnpackOutputs(ABI, MethodReportEquivocation, m, payload)
+}
+
+type MethodGetEquivocationsInput struct {
+	Offender common.Address
+}
+
+func (m *MethodGetEquivocationsInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGetEquivocations, m.Offender)
+}
+func (m *MethodGetEquivocationsInput) Decode(payload []byte) error {
+	var data struct {
+		Offender common.Address
+	}
+	if err := utils.UnpackMethod(ABI, MethodGetEquivocations, &data, payload); err != nil {
+		return err
+	}
+	m.Offender = data.Offender
+	return nil
+}
+
+type MethodGetEquivocationsOutput struct {
+	List *EquivocationList
+}
+
+func (m *MethodGetEquivocationsOutput) Encode() ([]byte, error) {
+	enc, err := rlp.EncodeToBytes(m.List)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PackOutputs(ABI, MethodGetEquivocations, enc)
+}
+func (m *MethodGetEquivocationsOutput) Decode(payload []byte) error {
+	var data struct {
+		List []byte
+	}
+	if err := utils.UnpackOutputs(ABI, MethodGetEquivocations, &data, payload); err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(data.List, &m.List)
+}
+
 func emitEventProposed(s *native.NativeContract, epoch *EpochInfo) error {
 	enc, err := rlp.EncodeToBytes(epoch)
 	if err != nil {
@@ -249,6 +323,10 @@ func emitConsensusSign(s *native.NativeContract, sign *ConsensusSign, signer com
 	return s.AddNotify(ABI, []string{EventConsensusSigned}, sign.Method, sign.Input, signer, uint64(num))
 }
 
+func emitEquivocationReported(s *native.NativeContract, record *Equivocation) error {
+	return s.AddNotify(ABI, []string{EventEquivocationReported}, record.Offender, record.Height, record.Round, record.Reporter)
+}
+
 type MethodGetEpochListJsonInput struct {
 	EpochID uint64
 }
@@ -278,3 +356,103 @@ func (m *MethodGetJsonOutput) Encode(methodName string) ([]byte, error) {
 func (m *MethodGetJsonOutput) Decode(payload []byte, methodName string) error {
 	return utils.UnpackOutputs(ABI, methodName, m, payload)
 }
+
+type MethodRegisterCandidateInput struct {
+	PubKey string
+	Name   string
+	URL    string
+}
+
+func (m *MethodRegisterCandidateInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodRegisterCandidate, m.PubKey, m.Name, m.URL)
+}
+func (m *MethodRegisterCandidateInput) Decode(payload []byte) error {
+	var data struct {
+		PubKey string
+		Name   string
+		Url    string
+	}
+	if err := utils.UnpackMethod(ABI, MethodRegisterCandidate, &data, payload); err != nil {
+		return err
+	}
+	m.PubKey, m.Name, m.URL = data.PubKey, data.Name, data.Url
+	return nil
+}
+
+type MethodUpdateCandidateInput struct {
+	Name string
+	URL  string
+}
+
+func (m *MethodUpdateCandidateInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodUpdateCandidate, m.Name, m.URL)
+}
+func (m *MethodUpdateCandidateInput) Decode(payload []byte) error {
+	var data struct {
+		Name string
+		Url  string
+	}
+	if err := utils.UnpackMethod(ABI, MethodUpdateCandidate, &data, payload); err != nil {
+		return err
+	}
+	m.Name, m.URL = data.Name, data.Url
+	return nil
+}
+
+type MethodGetCandidateJsonInput struct {
+	Candidate common.Address
+}
+
+func (m *MethodGetCandidateJsonInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodGetCandidateJson, m.Candidate)
+}
+func (m *MethodGetCandidateJsonInput) Decode(payload []byte) error {
+	var data struct {
+		Candidate common.Address
+	}
+	if err := utils.UnpackMethod(ABI, MethodGetCandidateJson, &data, payload); err != nil {
+		return err
+	}
+	m.Candidate = data.Candidate
+	return nil
+}
+
+type MethodSetBLSPublicKeyInput struct {
+	BLSPubKey []byte
+	BLSProof  []byte
+}
+
+func (m *MethodSetBLSPublicKeyInput) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, MethodSetBLSPublicKey, m.BLSPubKey, m.BLSProof)
+}
+func (m *MethodSetBLSPublicKeyInput) Decode(payload []byte) error {
+	var data struct {
+		BlsPubKey []byte
+		BlsProof  []byte
+	}
+	if err := utils.UnpackMethod(ABI, MethodSetBLSPublicKey, &data, payload); err != nil {
+		return err
+	}
+	m.BLSPubKey, m.BLSProof = data.BlsPubKey, data.BlsProof
+	return nil
+}
+
+func emitCandidateRegistered(s *native.NativeContract, candidate *Candidate) error {
+	return s.AddNotify(ABI, []string{EventCandidateRegistered}, candidate.Address, candidate.Name, candidate.URL)
+}
+
+func emitCandidateUpdated(s *native.NativeContract, candidate *Candidate) error {
+	return s.AddNotify(ABI, []string{EventCandidateUpdated}, candidate.Address, candidate.Name, candidate.URL)
+}
+
+func emitCandidateUnregistered(s *native.NativeContract, candidate common.Address) error {
+	return s.AddNotify(ABI, []string{EventCandidateUnregistered}, candidate)
+}
+
+func emitUnjailed(s *native.NativeContract, validator common.Address) error {
+	return s.AddNotify(ABI, []string{EventUnjailed}, validator)
+}
+
+func emitBLSPublicKeySet(s *native.NativeContract, validator common.Address, key *BLSKey) error {
+	return s.AddNotify(ABI, []string{EventBLSPublicKeySet}, validator, key.PubKey)
+}
@@ -0,0 +1,375 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// RegisterCandidate declares tx origin as candidate of epoch election with its metadata.
+func RegisterCandidate(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight().Uint64()
+	origin := s.ContractRef().TxOrigin()
+	if origin == common.EmptyAddress || origin != ctx.Caller {
+		log.Trace("registerCandidate", "check authority failed", "origin must be caller")
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	input := new(MethodRegisterCandidateInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("registerCandidate", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if len(input.Name) > MaxCandidateNameLen || len(input.URL) > MaxCandidateURLLen {
+		return utils.ByteFailed, ErrCandidateMetadata
+	}
+	if err := checkPeer(&PeerInfo{PubKey: input.PubKey, Address: origin}); err != nil {
+		log.Trace("registerCandidate", "check peer public key", err)
+		return utils.ByteFailed, ErrInvalidPubKey
+	}
+
+	if _, err := getCandidate(s, origin); err == nil {
+		return utils.ByteFailed, ErrCandidateExist
+	} else if err.Error() != ErrEof.Error() {
+		log.Trace("registerCandidate", "get candidate failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	list, err := getCandidateList(s)
+	if err != nil {
+		log.Trace("registerCandidate", "get candidate list failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+
+	candidate := &Candidate{
+		Address:        origin,
+		PubKey:         input.PubKey,
+		Name:           input.Name,
+		URL:            input.URL,
+		RegisterHeight: height,
+	}
+	if err := storeCandidate(s, candidate); err != nil {
+		log.Trace("registerCandidate", "store candidate failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := setCandidateList(s, append(list, origin)); err != nil {
+		log.Trace("registerCandidate", "store candidate list failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitCandidateRegistered(s, candidate); err != nil {
+		log.Trace("registerCandidate", "emit candidate registered log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return utils.ByteSuccess, nil
+}
+
+// UpdateCandidate modifies the metadata of tx origin candidate.
+func UpdateCandidate(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	origin := s.ContractRef().TxOrigin()
+	if origin == common.EmptyAddress || origin != ctx.Caller {
+		log.Trace("updateCandidate", "check authority failed", "origin must be caller")
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	input := new(MethodUpdateCandidateInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("updateCandidate", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if len(input.Name) > MaxCandidateNameLen || len(input.URL) > MaxCandidateURLLen {
+		return utils.ByteFailed, ErrCandidateMetadata
+	}
+
+	candidate, err := getCandidate(s, origin)
+	if err != nil {
+		log.Trace("updateCandidate", "get candidate failed", err)
+		return utils.ByteFailed, ErrCandidateNotExist
+	}
+	candidate.Name, candidate.URL = input.Name, input.URL
+	if err := storeCandidate(s, candidate); err != nil {
+		log.Trace("updateCandidate", "store candidate failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitCandidateUpdated(s, candidate); err != nil {
+		log.Trace("updateCandidate", "emit candidate updated log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return utils.ByteSuccess, nil
+}
+
+// UnregisterCandidate removes tx origin from the candidates, it keeps working as validator in the
+// current epoch and leaves at the next election. The downtime record is kept.
+func UnregisterCandidate(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	origin := s.ContractRef().TxOrigin()
+	if origin == common.EmptyAddress || origin != ctx.Caller {
+		log.Trace("unregisterCandidate", "check authority failed", "origin must be caller")
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	if _, err := getCandidate(s, origin); err != nil {
+		log.Trace("unregisterCandidate", "get candidate failed", err)
+		return utils.ByteFailed, ErrCandidateNotExist
+	}
+	list, err := getCandidateList(s)
+	if err != nil {
+		log.Trace("unregisterCandidate", "get candidate list failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	for i, v := range list {
+		if v == origin {
+			list = append(list[:i], list[i+1:]...)
+			break
+		}
+	}
+
+	delCandidate(s, origin)
+	if err := setCandidateList(s, list); err != nil {
+		log.Trace("unregisterCandidate", "store candidate list failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitCandidateUnregistered(s, origin); err != nil {
+		log.Trace("unregisterCandidate", "emit candidate unregistered log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return utils.ByteSuccess, nil
+}
+
+// Unjail releases tx origin from jail after the jail period, and the missed blocks are counted from zero.
+func Unjail(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	height := s.ContractRef().BlockHeight().Uint64()
+	origin := s.ContractRef().TxOrigin()
+	if origin == common.EmptyAddress || origin != ctx.Caller {
+		log.Trace("unjail", "check authority failed", "origin must be caller")
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	downtime, err := getDowntime(s, origin)
+	if err != nil {
+		log.Trace("unjail", "get downtime failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if !downtime.Jailed {
+		return utils.ByteFailed, ErrNotJailed
+	}
+	if height < downtime.JailedUntil {
+		log.Trace("unjail", "jailed until", downtime.JailedUntil, "current height", height)
+		return utils.ByteFailed, ErrJailPeriod
+	}
+
+	downtime.Jailed = false
+	downtime.Missed = 0
+	if err := storeDowntime(s, origin, downtime); err != nil {
+		log.Trace("unjail", "store downtime failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitUnjailed(s, origin); err != nil {
+		log.Trace("unjail", "emit unjailed log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return utils.ByteSuccess, nil
+}
+
+// SetBLSPublicKey publishes the BLS public key of tx origin with the proof of possession, the key is carried
+// by the epochs elected or proposed afterwards. It can't be changed once published, since the consensus
+// engine verifies the aggregated committed seals with the keys resolved by validator address.
+func SetBLSPublicKey(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	origin := s.ContractRef().TxOrigin()
+	if !s.ContractRef().BLSKeys() {
+		return utils.ByteFailed, ErrBLSKeyNotEnabled
+	}
+	if origin == common.EmptyAddress || origin != ctx.Caller {
+		log.Trace("setBLSPublicKey", "check authority failed", "origin must be caller")
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	input := new(MethodSetBLSPublicKeyInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("setBLSPublicKey", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	if err := signer.VerifyBLSProof(input.BLSPubKey, input.BLSProof); err != nil {
+		log.Trace("setBLSPublicKey", "verify BLS proof failed", err)
+		return utils.ByteFailed, ErrInvalidBLSKey
+	}
+
+	if key, err := getBLSKey(s, origin); err != nil {
+		log.Trace("setBLSPublicKey", "get BLS key failed", err)
+		return utils.ByteFailed, ErrStorage
+	} else if key != nil {
+		return utils.ByteFailed, ErrBLSKeyExist
+	}
+	key := &BLSKey{PubKey: input.BLSPubKey, Proof: input.BLSProof}
+	if err := storeBLSKey(s, origin, key); err != nil {
+		log.Trace("setBLSPublicKey", "store BLS key failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitBLSPublicKeySet(s, origin, key); err != nil {
+		log.Trace("setBLSPublicKey", "emit BLS public key set log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+	return utils.ByteSuccess, nil
+}
+
+func GetCandidateJson(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	input := new(MethodGetCandidateJsonInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("getCandidateJson", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+
+	candidate, err := getCandidate(s, input.Candidate)
+	if err != nil {
+		return utils.ByteFailed, ErrCandidateNotExist
+	}
+	status, err := peerStatus(s, &PeerInfo{PubKey: candidate.PubKey, Address: candidate.Address})
+	if err != nil {
+		log.Trace("getCandidateJson", "get peer status failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	enc, err := json.Marshal(status)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	output := &MethodGetJsonOutput{Result: string(enc)}
+	return output.Encode(MethodGetCandidateJson)
+}
+
+func GetCandidateListJson(s *native.NativeContract) ([]byte, error) {
+	list, err := getCandidateList(s)
+	if err != nil {
+		log.Trace("getCandidateListJson", "get candidate list failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	result := make([]*PeerStatus, 0, len(list))
+	for _, addr := range list {
+		status, err := peerStatus(s, &PeerInfo{Address: addr})
+		if err != nil {
+			log.Trace("getCandidateListJson", "get peer status failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		result = append(result, status)
+	}
+	enc, err := json.Marshal(result)
+	if err != nil {
+		return utils.ByteFailed, err
+	}
+	output := &MethodGetJsonOutput{Result: string(enc)}
+	return output.Encode(MethodGetCandidateListJson)
+}
+
+// peerStatus collects the candidate metadata and downtime of peer.
+func peerStatus(s *native.NativeContract, peer *PeerInfo) (*PeerStatus, error) {
+	status := &PeerStatus{Address: peer.Address, PubKey: peer.PubKey}
+	if candidate, err := getCandidate(s, peer.Address); err == nil {
+		status.PubKey = candidate.PubKey
+		status.Name, status.URL = candidate.Name, candidate.URL
+		status.Registered, status.RegisterHeight = true, candidate.RegisterHeight
+	} else if err.Error() != ErrEof.Error() {
+		return nil, err
+	}
+
+	downtime, err := getDowntime(s, peer.Address)
+	if err != nil {
+		return nil, err
+	}
+	status.Missed, status.Jailed, status.JailedUntil, status.JailCount = downtime.Missed, downtime.Jailed, downtime.JailedUntil, downtime.JailCount
+	return status, nil
+}
+
+// epochJson encodes epoch with the status of all its peers.
+func epochJson(s *native.NativeContract, epoch *EpochInfo) (string, error) {
+	status := make([]*PeerStatus, 0, epoch.Peers.Len())
+	if epoch.Peers != nil {
+		for _, peer := range epoch.Peers.List {
+			item, err := peerStatus(s, peer)
+			if err != nil {
+				return "", err
+			}
+			status = append(status, item)
+		}
+	}
+	return epoch.JsonWithStatus(status), nil
+}
+
+func isJailed(s *native.NativeContract, addr common.Address) (bool, error) {
+	downtime, err := getDowntime(s, addr)
+	if err != nil {
+		return false, err
+	}
+	return downtime.Jailed, nil
+}
+
+// TrackDowntime counts the consecutive blocks in which validators show no activity, the active addresses
+// are the proposer of block at height and the committers of its parent which are carried in the block
+// hash, so that a validator is never penalized for a single quorum certificate which left it out. Validators
+// inactive for MaxMissedBlocks blocks in a row are jailed for JailPeriod blocks. It is invoked by the
+// consensus engine at the end of every block.
+//
+// No transaction carries the downtime tracking, so that jailing emits no log, the status is queryable by
+// `getCandidateJson` and the epoch json getters.
+func TrackDowntime(db *state.StateDB, height uint64, validators, active []common.Address) error {
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+
+	seen := make(map[common.Address]struct{}, len(active))
+	for _, addr := range active {
+		seen[addr] = struct{}{}
+	}
+	for _, addr := range validators {
+		downtime, err := getDowntime(s, addr)
+		if err != nil {
+			return err
+		}
+		if downtime.Jailed {
+			continue
+		}
+		if _, ok := seen[addr]; ok {
+			if downtime.Missed == 0 {
+				continue
+			}
+			downtime.Missed = 0
+		} else {
+			downtime.Missed++
+		}
+		if downtime.Missed >= MaxMissedBlocks {
+			downtime.Jailed = true
+			downtime.JailedUntil = height + JailPeriod
+			downtime.JailCount++
+			log.Info("trackDowntime", "validator jailed", addr.Hex(), "height", height, "jailed until", downtime.JailedUntil)
+		}
+		if err := storeDowntime(s, addr, downtime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
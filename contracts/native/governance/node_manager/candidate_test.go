@@ -0,0 +1,289 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestKeyPeers(n int) ([]*ecdsa.PrivateKey, *Peers) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	peers := &Peers{List: make([]*PeerInfo, n)}
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		peers.List[i] = &PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&keys[i].PublicKey)),
+			Address: crypto.PubkeyToAddress(keys[i].PublicKey),
+		}
+	}
+	return keys, peers
+}
+
+func callNodeManager(origin common.Address, blockNum int, payload []byte) ([]byte, error) {
+	result, _, err := generateNativeContractRef(origin, blockNum).NativeCall(origin, this, payload)
+	return result, err
+}
+
+func registerTestCandidate(peer *PeerInfo, name string) error {
+	payload, _ := (&MethodRegisterCandidateInput{PubKey: peer.PubKey, Name: name, URL: "https://" + name}).Encode()
+	_, err := callNodeManager(peer.Address, 3, payload)
+	return err
+}
+
+func getTestPeerStatus(t *testing.T, addr common.Address) *PeerStatus {
+	payload, _ := (&MethodGetCandidateJsonInput{Candidate: addr}).Encode()
+	result, err := callNodeManager(addr, 3, payload)
+	assert.NoError(t, err)
+	output := new(MethodGetJsonOutput)
+	assert.NoError(t, output.Decode(result, MethodGetCandidateJson))
+	status := new(PeerStatus)
+	assert.NoError(t, json.Unmarshal([]byte(output.Result), status))
+	return status
+}
+
+func TestCandidateLifecycle(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	_, peers := generateTestKeyPeers(2)
+	peer := peers.List[0]
+
+	// public key should match tx origin
+	payload, _ := (&MethodRegisterCandidateInput{PubKey: peers.List[1].PubKey, Name: "node0"}).Encode()
+	_, err := callNodeManager(peer.Address, 3, payload)
+	assert.Equal(t, ErrInvalidPubKey, err)
+	assert.Equal(t, ErrCandidateMetadata, registerTestCandidate(peer, strings.Repeat("n", MaxCandidateNameLen+1)))
+
+	assert.NoError(t, registerTestCandidate(peer, "node0"))
+	assert.Equal(t, ErrCandidateExist, registerTestCandidate(peer, "node0"))
+	assert.NoError(t, registerTestCandidate(peers.List[1], "node1"))
+
+	status := getTestPeerStatus(t, peer.Address)
+	assert.True(t, status.Registered)
+	assert.Equal(t, peer.PubKey, status.PubKey)
+	assert.Equal(t, "node0", status.Name)
+	assert.Equal(t, uint64(3), status.RegisterHeight)
+
+	payload, _ = (&MethodUpdateCandidateInput{Name: "renamed", URL: "https://renamed"}).Encode()
+	_, err = callNodeManager(peer.Address, 4, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", getTestPeerStatus(t, peer.Address).Name)
+	_, err = callNodeManager(generateTestAddress(7), 4, payload)
+	assert.Equal(t, ErrCandidateNotExist, err)
+
+	payload, _ = utils.PackMethod(ABI, MethodUnregisterCandidate)
+	_, err = callNodeManager(peer.Address, 5, payload)
+	assert.NoError(t, err)
+	_, err = callNodeManager(peer.Address, 5, payload)
+	assert.Equal(t, ErrCandidateNotExist, err)
+
+	payload, _ = utils.PackMethod(ABI, MethodGetCandidateListJson)
+	result, err := callNodeManager(peer.Address, 5, payload)
+	assert.NoError(t, err)
+	output := new(MethodGetJsonOutput)
+	assert.NoError(t, output.Decode(result, MethodGetCandidateListJson))
+	var list []*PeerStatus
+	assert.NoError(t, json.Unmarshal([]byte(output.Result), &list))
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, peers.List[1].Address, list[0].Address)
+	assert.Equal(t, "node1", list[0].Name)
+}
+
+func TestDowntimeJail(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	validators := testGenesisEpoch.MemberList()
+	offender := validators[0]
+	committers := validators[1:]
+
+	// missed blocks are counted from zero once the validator is active again
+	for i := uint64(0); i < MaxMissedBlocks-1; i++ {
+		assert.NoError(t, TrackDowntime(testStateDB, i+1, validators, committers))
+	}
+	downtime, err := getDowntime(testEmptyCtx, offender)
+	assert.NoError(t, err)
+	assert.False(t, downtime.Jailed)
+	assert.Equal(t, MaxMissedBlocks-1, downtime.Missed)
+	assert.NoError(t, TrackDowntime(testStateDB, MaxMissedBlocks, validators, []common.Address{offender}))
+	downtime, _ = getDowntime(testEmptyCtx, offender)
+	assert.False(t, downtime.Jailed)
+	assert.Equal(t, uint64(0), downtime.Missed)
+
+	height := 2 * MaxMissedBlocks
+	for i := uint64(0); i < MaxMissedBlocks; i++ {
+		assert.NoError(t, TrackDowntime(testStateDB, height-MaxMissedBlocks+1+i, validators, committers))
+	}
+	downtime, _ = getDowntime(testEmptyCtx, offender)
+	assert.True(t, downtime.Jailed)
+	assert.Equal(t, height+JailPeriod, downtime.JailedUntil)
+	assert.Equal(t, uint64(1), downtime.JailCount)
+	for _, v := range committers {
+		downtime, _ = getDowntime(testEmptyCtx, v)
+		assert.Equal(t, uint64(0), downtime.Missed)
+	}
+
+	// jailed peer is excluded from proposal
+	payload, _ := (&MethodProposeInput{Peers: testGenesisEpoch.Peers.Copy()}).Encode()
+	_, err = callNodeManager(testCaller, 3, payload)
+	assert.Equal(t, ErrJailedPeer, err)
+
+	// status exposed by epoch json
+	payload, _ = utils.PackMethod(ABI, MethodGetCurrentEpochJson)
+	result, err := callNodeManager(testCaller, 3, payload)
+	assert.NoError(t, err)
+	output := new(MethodGetJsonOutput)
+	assert.NoError(t, output.Decode(result, MethodGetCurrentEpochJson))
+	var epoch struct {
+		PeerStatus []*PeerStatus
+	}
+	assert.NoError(t, json.Unmarshal([]byte(output.Result), &epoch))
+	assert.Equal(t, len(validators), len(epoch.PeerStatus))
+	for _, v := range epoch.PeerStatus {
+		assert.Equal(t, v.Address == offender, v.Jailed)
+	}
+
+	payload, _ = utils.PackMethod(ABI, MethodUnjail)
+	_, err = callNodeManager(offender, int(height+JailPeriod-1), payload)
+	assert.Equal(t, ErrJailPeriod, err)
+	_, err = callNodeManager(committers[0], int(height+JailPeriod), payload)
+	assert.Equal(t, ErrNotJailed, err)
+	_, err = callNodeManager(offender, int(height+JailPeriod), payload)
+	assert.NoError(t, err)
+	downtime, _ = getDowntime(testEmptyCtx, offender)
+	assert.False(t, downtime.Jailed)
+	assert.Equal(t, uint64(0), downtime.Missed)
+}
+
+func TestElectionCandidates(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	_, peers := generateTestKeyPeers(MinProposalPeersLen + 1)
+
+	for i, peer := range peers.List[:MinProposalPeersLen] {
+		assert.NoError(t, registerTestCandidate(peer, string(rune('a'+i))))
+	}
+	// candidates are not elected before the election fork
+	candidates, err := electionCandidates(generateNativeContract(testCaller, 1))
+	assert.NoError(t, err)
+	assert.Nil(t, candidates)
+
+	ctx := native.NewNativeContract(testStateDB, generateElectionContractRef(testCaller, 1))
+	candidates, err = electionCandidates(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, peers.List[:MinProposalPeersLen], candidates.List)
+
+	// jailed candidates are excluded
+	jailed := peers.List[1].Address
+	assert.NoError(t, storeDowntime(testEmptyCtx, jailed, &Downtime{Jailed: true}))
+	candidates, err = electionCandidates(ctx)
+	assert.NoError(t, err)
+	assert.Nil(t, candidates)
+
+	assert.NoError(t, registerTestCandidate(peers.List[MinProposalPeersLen], "e"))
+	candidates, err = electionCandidates(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, MinProposalPeersLen, candidates.Len())
+	assert.False(t, candidates.Contains(jailed))
+}
+
+func generateTestBLSKey(t *testing.T, key *ecdsa.PrivateKey) *BLSKey {
+	blsKey, err := signer.GenerateBLSKey()
+	assert.NoError(t, err)
+	s := signer.NewBLSSigner(key, blsKey, nil)
+	return &BLSKey{PubKey: s.BLSPublicKey(), Proof: s.BLSProof()}
+}
+
+func setTestBLSPublicKey(origin common.Address, key *BLSKey) error {
+	ref := generateNativeContractRef(origin, 3)
+	ref.EnableBLSKeys()
+	payload, _ := (&MethodSetBLSPublicKeyInput{BLSPubKey: key.PubKey, BLSProof: key.Proof}).Encode()
+	_, _, err := ref.NativeCall(origin, this, payload)
+	return err
+}
+
+func TestSetBLSPublicKey(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	keys, peers := generateTestKeyPeers(2)
+	peer := peers.List[0]
+	key, other := generateTestBLSKey(t, keys[0]), generateTestBLSKey(t, keys[1])
+
+	// BLS key is published after the aggregated seal fork only
+	payload, _ := (&MethodSetBLSPublicKeyInput{BLSPubKey: key.PubKey, BLSProof: key.Proof}).Encode()
+	_, err := callNodeManager(peer.Address, 3, payload)
+	assert.Equal(t, ErrBLSKeyNotEnabled, err)
+
+	// proof should match the public key
+	assert.Equal(t, ErrInvalidBLSKey, setTestBLSPublicKey(peer.Address, &BLSKey{PubKey: key.PubKey, Proof: other.Proof}))
+	assert.NoError(t, setTestBLSPublicKey(peer.Address, key))
+	assert.Equal(t, ErrBLSKeyExist, setTestBLSPublicKey(peer.Address, other))
+
+	// keys carried in the peer list are overridden by the published ones
+	peers.List[1].BLSPubKey, peers.List[1].BLSProof = key.PubKey, key.Proof
+	assert.NoError(t, fillBLSKeys(testEmptyCtx, peers))
+	assert.Equal(t, hexutil.Bytes(key.PubKey), peers.List[0].BLSPubKey)
+	assert.Equal(t, hexutil.Bytes(key.Proof), peers.List[0].BLSProof)
+	assert.Nil(t, peers.List[1].BLSPubKey)
+
+	epoch := &EpochInfo{ID: 2, Peers: peers, StartHeight: 100}
+	blsKeys, proofs := epoch.BLSKeys()
+	assert.Equal(t, [][]byte{key.PubKey, nil}, blsKeys)
+	assert.Equal(t, [][]byte{key.Proof, nil}, proofs)
+}
+
+func TestRegGenesisBLSKey(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	keys, peers := generateTestKeyPeers(MinProposalPeersLen)
+	genesisPeers := make([]core.GenesisPeer, len(keys))
+	for i, key := range keys {
+		genesisPeers[i] = core.GenesisPeer{Address: peers.List[i].Address, PublicKey: crypto.CompressPubkey(&key.PublicKey)}
+	}
+	key := generateTestBLSKey(t, keys[0])
+	genesisPeers[0].BLSPublicKey, genesisPeers[0].BLSProof = key.PubKey, key.Proof[1:]
+	assert.Error(t, core.RegGenesis(testStateDB, genesisPeers))
+
+	genesisPeers[0].BLSProof = key.Proof
+	assert.NoError(t, core.RegGenesis(testStateDB, genesisPeers))
+	epoch, err := getCurrentEpoch(testEmptyCtx)
+	assert.NoError(t, err)
+	blsKeys, _ := epoch.BLSKeys()
+	for i, addr := range epoch.MemberList() {
+		if addr == genesisPeers[0].Address {
+			assert.Equal(t, key.PubKey, blsKeys[i])
+		} else {
+			assert.Empty(t, blsKeys[i])
+		}
+	}
+	published, err := getBLSKey(testEmptyCtx, genesisPeers[0].Address)
+	assert.NoError(t, err)
+	assert.Equal(t, key, published)
+}
@@ -53,12 +53,36 @@ var (
 
 	ErrProposalPassed = errors.New("proposal already passed")
 
+	ErrNotCandidate = errors.New("proposal peer is not candidate")
+
+	ErrJailedPeer = errors.New("proposal peer is jailed")
+
+	ErrCandidateExist = errors.New("candidate already registered")
+
+	ErrCandidateNotExist = errors.New("candidate not registered")
+
+	ErrCandidateMetadata = errors.New("candidate name or url too long")
+
+	ErrNotJailed = errors.New("validator is not jailed")
+
+	ErrJailPeriod = errors.New("validator is still in jail period")
+
+	ErrBLSKeyNotEnabled = errors.New("BLS public key not enabled before the aggregated seal fork")
+
+	ErrInvalidBLSKey = errors.New("invalid BLS public key or proof of possession")
+
+	ErrBLSKeyExist = errors.New("BLS public key already published")
+
 	ErrOldParticipantsNumber = errors.New("old participants should >= 2/3")
 
 	ErrProposalStartHeight = errors.New("proposal start height invalid")
 
 	ErrVoteHeight = errors.New("too late to vote")
 
+	ErrInvalidEvidence = errors.New("invalid equivocation evidence")
+
+	ErrDuplicateEvidence = errors.New("duplicate equivocation evidence")
+
 	ErrStorage = errors.New("store key value failed")
 
 	ErrEmitLog = errors.New("emit log failed")
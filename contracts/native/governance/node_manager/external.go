@@ -24,6 +24,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/signer"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -32,17 +35,27 @@ import (
 
 func init() {
 	// store data in genesis block
-	core.RegGenesis = func(db *state.StateDB, data core.GenesisAlloc) error {
+	core.RegGenesis = func(db *state.StateDB, data []core.GenesisPeer) error {
 		peers := &Peers{List: make([]*PeerInfo, 0)}
-		for addr, v := range data {
+		for _, v := range data {
 			pubkey, err := crypto.DecompressPubkey(v.PublicKey)
 			if err != nil {
 				return fmt.Errorf("store genesis peers, decompress pubkey failed, err: %v", err)
 			}
-			if got := crypto.PubkeyToAddress(*pubkey); got != addr {
-				return fmt.Errorf("store genesis peers, expect address %s got %s", addr.Hex(), got.Hex())
+			if got := crypto.PubkeyToAddress(*pubkey); got != v.Address {
+				return fmt.Errorf("store genesis peers, expect address %s got %s", v.Address.Hex(), got.Hex())
+			}
+			peer := &PeerInfo{Address: v.Address, PubKey: hexutil.Encode(v.PublicKey)}
+			if len(v.BLSPublicKey) > 0 {
+				if err := signer.VerifyBLSProof(v.BLSPublicKey, v.BLSProof); err != nil {
+					return fmt.Errorf("store genesis peers, invalid BLS key of %s, err: %v", v.Address.Hex(), err)
+				}
+				key := &BLSKey{PubKey: v.BLSPublicKey, Proof: v.BLSProof}
+				if err := setBLSKey((*state.CacheDB)(db), v.Address, key); err != nil {
+					return err
+				}
+				peer.BLSPubKey, peer.BLSProof = key.PubKey, key.Proof
 			}
-			peer := &PeerInfo{Address: addr, PubKey: hexutil.Encode(v.PublicKey)}
 			peers.List = append(peers.List, peer)
 		}
 		sort.Sort(peers)
@@ -52,6 +65,15 @@ func init() {
 			return nil
 		}
 	}
+
+	// node whitelist must keep the validators of current epoch
+	maas_config.EpochValidators = func(s *native.NativeContract) ([]common.Address, error) {
+		epoch, err := getCurrentEpoch(s)
+		if err != nil {
+			return nil, err
+		}
+		return epoch.MemberList(), nil
+	}
 }
 
 func storeGenesisEpoch(s *state.StateDB, peers *Peers) (*EpochInfo, error) {
@@ -50,9 +50,19 @@ var (
 		MethodProof:            0,
 		MethodGetChangingEpoch: 0,
 
+		MethodReportEquivocation: 30000,
+		MethodGetEquivocations:   0,
+
 		MethodGetChangingEpochJson: 0,
 		MethodGetCurrentEpochJson:  0,
 		MethodGetEpochListJson:     0,
+		MethodRegisterCandidate:    30000,
+		MethodUpdateCandidate:      30000,
+		MethodUnregisterCandidate:  30000,
+		MethodUnjail:               30000,
+		MethodGetCandidateJson:     0,
+		MethodGetCandidateListJson: 0,
+		MethodSetBLSPublicKey:      200000, // pairing check of the proof of possession
 	}
 )
 
@@ -71,6 +81,13 @@ const (
 	MaxProposalNumPerEpoch int = 6
 	// Proposal should be voted and passed in period
 	MinVoteEffectivePeriod uint64 = 10
+	// Validator neither proposing nor committing in 500 consecutive blocks is jailed
+	MaxMissedBlocks uint64 = 500
+	// Jailed validator is allowed to unjail after 86400 blocks
+	JailPeriod uint64 = 86400
+	// Candidate metadata length limits
+	MaxCandidateNameLen = 64
+	MaxCandidateURLLen  = 256
 )
 
 func InitNodeManager() {
@@ -89,9 +106,19 @@ func RegisterNodeManagerContract(s *native.NativeContract) {
 	s.Register(MethodProof, GetEpochProof)
 	s.Register(MethodGetChangingEpoch, GetChangingEpoch)
 
+	s.Register(MethodReportEquivocation, ReportEquivocation)
+	s.Register(MethodGetEquivocations, GetEquivocations)
+
 	s.Register(MethodGetChangingEpochJson, GetChangingEpochJson)
 	s.Register(MethodGetCurrentEpochJson, GetCurrentEpochJson)
 	s.Register(MethodGetEpochListJson, GetEpochListJson)
+	s.Register(MethodRegisterCandidate, RegisterCandidate)
+	s.Register(MethodUpdateCandidate, UpdateCandidate)
+	s.Register(MethodUnregisterCandidate, UnregisterCandidate)
+	s.Register(MethodUnjail, Unjail)
+	s.Register(MethodGetCandidateJson, GetCandidateJson)
+	s.Register(MethodGetCandidateListJson, GetCandidateListJson)
+	s.Register(MethodSetBLSPublicKey, SetBLSPublicKey)
 }
 
 func Name(s *native.NativeContract) ([]byte, error) {
@@ -125,6 +152,16 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 
 	peers := input.Peers
 	startHeight := input.StartHeight
+	// peers are elected from the staking validators or registered candidates after the election fork once
+	// there are enough of them, and proposer may leave the peer list empty to adopt the election result.
+	candidates, err := electionCandidates(s)
+	if err != nil {
+		log.Trace("propose", "get election candidates failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if candidates != nil && (peers == nil || len(peers.List) == 0) {
+		peers = electPeers(curEpoch, candidates)
+	}
 	// check peers, try to match all peer's public key and address
 	if peers == nil || peers.List == nil || len(peers.List) == 0 {
 		log.Trace("propose", "check peers", "peer list is nil")
@@ -143,6 +180,20 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 		}
 	}
 
+	for _, peer := range peers.List {
+		if jailed, err := isJailed(s, peer.Address); err != nil {
+			log.Trace("propose", "get downtime failed", err)
+			return utils.ByteFailed, ErrStorage
+		} else if jailed {
+			log.Trace("propose", "check jailed peer", peer.Address.Hex())
+			return utils.ByteFailed, ErrJailedPeer
+		}
+		if candidates != nil && !candidates.Contains(peer.Address) {
+			log.Trace("propose", "check candidate", peer.Address.Hex())
+			return utils.ByteFailed, ErrNotCandidate
+		}
+	}
+
 	// check peers, number for proposal's peers should be at least 2/3 of old members
 	if curEpoch.OldMemberNum(peers) < curEpoch.QuorumSize() {
 		log.Trace("propose", "check old members", "proposal peers should be at least 2/3 old members")
@@ -162,6 +213,11 @@ func Propose(s *native.NativeContract) ([]byte, error) {
 		startHeight = height + DefaultEpochValidPeriod
 	}
 
+	if err := fillBLSKeys(s, peers); err != nil {
+		log.Trace("propose", "fill BLS keys failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+
 	// generate new epoch as proposal
 	epochID := curEpoch.ID + 1
 	sort.Sort(peers)
@@ -327,11 +383,14 @@ func Vote(s *native.NativeContract) ([]byte, error) {
 
 		dirtyJob(s, curEpoch, epoch)
 
+		keys, proofs := epoch.BLSKeys()
 		epochChangeFeed.Send(types.EpochChangeEvent{
-			EpochID:     epoch.StartHeight,
-			StartHeight: epoch.StartHeight,
-			Validators:  epoch.MemberList(),
-			Hash:        epoch.Hash(),
+			EpochID:       epoch.StartHeight,
+			StartHeight:   epoch.StartHeight,
+			Validators:    epoch.MemberList(),
+			Hash:          epoch.Hash(),
+			BLSPublicKeys: keys,
+			BLSProofs:     proofs,
 		})
 
 		log.Debug("vote", "proposal passed", epoch.Hash())
@@ -340,6 +399,93 @@ func Vote(s *native.NativeContract) ([]byte, error) {
 	return utils.ByteSuccess, nil
 }
 
+// ReportEquivocation verify evidence of validator sending conflicting consensus messages and record the offender.
+func ReportEquivocation(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+	reporter := s.ContractRef().TxOrigin()
+	caller := ctx.Caller
+	height := s.ContractRef().BlockHeight().Uint64()
+
+	// evidence can be reported by any account
+	if reporter == common.EmptyAddress || reporter != caller {
+		log.Trace("reportEquivocation", "check authority failed", "origin must be caller", "reporter", reporter.Hex())
+		return utils.ByteFailed, ErrInvalidAuthority
+	}
+
+	// decode and verify evidence
+	input := new(MethodReportEquivocationInput)
+	if err := input.Decode(ctx.Payload); err != nil || input.Evidence == nil {
+		log.Trace("reportEquivocation", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+	evidence := input.Evidence
+	offender, view, code, err := evidence.Verify(s.ContractRef().ChainID())
+	if err != nil {
+		log.Trace("reportEquivocation", "verify evidence failed", err, "evidence", evidence.Hash().Hex())
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+	if !view.Height.IsUint64() || !view.Round.IsUint64() || view.Height.Uint64() > height {
+		log.Trace("reportEquivocation", "check evidence view failed", view, "current height", height)
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+	// the same offence can be proved by different pairs of messages, it's punished only once
+	if findEquivocation(s, offender, view.Height.Uint64(), view.Round.Uint64(), code) {
+		log.Trace("reportEquivocation", "equivocation already exist", offender.Hex(), "view", view, "code", code)
+		return utils.ByteFailed, ErrDuplicateEvidence
+	}
+
+	// offender should be validator of the epoch at the evidence height
+	epoch, err := getEpochByHeight(s, view.Height.Uint64())
+	if err != nil {
+		log.Trace("reportEquivocation", "get epoch failed", err, "height", view.Height)
+		return utils.ByteFailed, ErrEpochNotExist
+	}
+	if _, ok := epoch.Members()[offender]; !ok {
+		log.Trace("reportEquivocation", "offender is not validator", offender.Hex(), "epoch", epoch.ID)
+		return utils.ByteFailed, ErrInvalidEvidence
+	}
+
+	record := &Equivocation{
+		Offender:     offender,
+		Height:       view.Height.Uint64(),
+		Round:        view.Round.Uint64(),
+		Code:         code,
+		Evidence:     evidence.Hash(),
+		Reporter:     reporter,
+		ReportHeight: height,
+	}
+	if err := storeEquivocation(s, record); err != nil {
+		log.Trace("reportEquivocation", "store equivocation failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	if err := emitEquivocationReported(s, record); err != nil {
+		log.Trace("reportEquivocation", "emit equivocation log failed", err)
+		return utils.ByteFailed, ErrEmitLog
+	}
+
+	log.Debug("reportEquivocation", "offender", offender.Hex(), "view", view, "reporter", reporter.Hex())
+	return utils.ByteSuccess, nil
+}
+
+// GetEquivocations retrieve the equivocation records of offender
+func GetEquivocations(s *native.NativeContract) ([]byte, error) {
+	ctx := s.ContractRef().CurrentContext()
+
+	input := new(MethodGetEquivocationsInput)
+	if err := input.Decode(ctx.Payload); err != nil {
+		log.Trace("getEquivocations", "decode input failed", err)
+		return utils.ByteFailed, ErrInvalidInput
+	}
+
+	list, err := getEquivocations(s, input.Offender)
+	if err != nil && err.Error() != ErrEof.Error() {
+		log.Trace("getEquivocations", "get equivocations failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodGetEquivocationsOutput{List: &EquivocationList{List: list}}
+	return output.Encode()
+}
+
 // dirtyJob filter current epoch and clear storage of `epoch`, `proposal`, `vote`, `voteTo`
 func dirtyJob(s *native.NativeContract, last, cur *EpochInfo) {
 	proposals, _ := getProposals(s, cur.ID)
@@ -433,7 +579,12 @@ func GetEpochListJson(s *native.NativeContract) ([]byte, error) {
 	var str strings.Builder
 	str.WriteString("[")
 	for i, v := range epochList {
-		str.WriteString(v.Json())
+		enc, err := epochJson(s, v)
+		if err != nil {
+			log.Trace("GetEpochListJson", "get peer status failed", err)
+			return utils.ByteFailed, ErrStorage
+		}
+		str.WriteString(enc)
 		if i != len(epochList)-1 {
 			str.WriteString(",")
 		}
@@ -449,7 +600,12 @@ func GetCurrentEpochJson(s *native.NativeContract) ([]byte, error) {
 		log.Trace("epoch", "get current epoch failed", err)
 		return utils.ByteFailed, ErrEpochNotExist
 	}
-	output := &MethodGetJsonOutput{Result: epoch.Json()}
+	enc, err := epochJson(s, epoch)
+	if err != nil {
+		log.Trace("epoch", "get peer status failed", err)
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodGetJsonOutput{Result: enc}
 	return output.Encode(MethodGetCurrentEpochJson)
 }
 
@@ -458,7 +614,11 @@ func GetChangingEpochJson(s *native.NativeContract) ([]byte, error) {
 	if err != nil {
 		return utils.ByteFailed, err
 	}
-	output := &MethodGetJsonOutput{Result: epoch.Json()}
+	enc, err := epochJson(s, epoch)
+	if err != nil {
+		return utils.ByteFailed, ErrStorage
+	}
+	output := &MethodGetJsonOutput{Result: enc}
 	return output.Encode(MethodGetChangingEpochJson)
 }
 
@@ -19,6 +19,7 @@
 package node_manager
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
 	"encoding/json"
 	"math/big"
@@ -28,8 +29,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
 	"github.com/ethereum/go-ethereum/contracts/native"
 	"github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/staking"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -266,6 +270,65 @@ func TestPropose(t *testing.T) {
 	}
 }
 
+// go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestProposeStakingValidators
+func TestProposeStakingValidators(t *testing.T) {
+	resetTestContext()
+	staking.InitStaking()
+
+	keys := make([]*ecdsa.PrivateKey, MinProposalPeersLen+1)
+	peers := &Peers{List: make([]*PeerInfo, len(keys))}
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		peers.List[i] = &PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&keys[i].PublicKey)),
+			Address: crypto.PubkeyToAddress(keys[i].PublicKey),
+		}
+		testStateDB.AddBalance(peers.List[i].Address, new(big.Int).Mul(staking.MinSelfStake, big.NewInt(2)))
+	}
+	testGenesisEpoch, _ = storeGenesisEpoch(testStateDB, &Peers{List: peers.List[:MinProposalPeersLen]})
+	testCaller = peers.List[0].Address
+
+	// staking validators are not enough, proposal peers are not restricted
+	stranger := generateTestPeer()
+	input := &MethodProposeInput{StartHeight: 0, Peers: &Peers{List: append(testGenesisEpoch.Peers.Copy().List, stranger)}}
+	payload, _ := input.Encode()
+	_, _, err := generateNativeContractRef(testCaller, 3).NativeCall(testCaller, this, payload)
+	assert.NoError(t, err)
+
+	for _, peer := range peers.List {
+		assert.NoError(t, registerTestValidator(peer, staking.MinSelfStake))
+	}
+
+	// proposal peers are not restricted before the election fork
+	input = &MethodProposeInput{StartHeight: 0, Peers: &Peers{List: append(testGenesisEpoch.Peers.Copy().List, generateTestPeer())}}
+	payload, _ = input.Encode()
+	_, _, err = generateNativeContractRef(peers.List[2].Address, 3).NativeCall(peers.List[2].Address, this, payload)
+	assert.NoError(t, err)
+
+	// proposal peers should be staking validators
+	input = &MethodProposeInput{StartHeight: 0, Peers: &Peers{List: append(testGenesisEpoch.Peers.Copy().List, generateTestPeer())}}
+	payload, _ = input.Encode()
+	_, _, err = generateElectionContractRef(testCaller, 3).NativeCall(testCaller, this, payload)
+	assert.Equal(t, ErrNotCandidate, err)
+
+	// empty peers adopt the stake ranking
+	input = &MethodProposeInput{StartHeight: 0, Peers: &Peers{}}
+	payload, _ = input.Encode()
+	_, _, err = generateElectionContractRef(peers.List[1].Address, 3).NativeCall(peers.List[1].Address, this, payload)
+	assert.NoError(t, err)
+
+	ctx := generateNativeContract(testCaller, 3)
+	proposals, err := getProposals(ctx, testGenesisEpoch.ID+1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(proposals))
+	epoch, err := getEpoch(ctx, proposals[2])
+	assert.NoError(t, err)
+	assert.Equal(t, len(peers.List), epoch.Peers.Len())
+	for _, peer := range peers.List {
+		assert.True(t, epoch.Peers.Contains(peer.Address))
+	}
+}
+
 // go test -v -count=1 github.com/ethereum/go-ethereum/contracts/native/governance/node_manager -run TestVote
 func TestVote(t *testing.T) {
 	epochID := uint64(2)
@@ -685,6 +748,163 @@ func TestGetProofByID(t *testing.T) {
 	assert.Equal(t, epoch.Hash(), output.Hash)
 }
 
+type testMsgType uint64
+
+func (t testMsgType) String() string { return "vote" }
+func (t testMsgType) Value() uint64  { return uint64(t) }
+
+// testChainID is the chain id which consensus messages in evidence are signed with
+var testChainID = big.NewInt(60801)
+
+func generateTestSignedMessage(t *testing.T, pk *ecdsa.PrivateKey, code uint64, height, round int64, digest common.Hash) *hotstuff.Message {
+	return generateTestTaggedMessage(t, pk, testChainID, hotstuff.MsgTagBasic, code, height, round, digest)
+}
+
+func generateTestTaggedMessage(t *testing.T, pk *ecdsa.PrivateKey, chainID *big.Int, tag, code uint64, height, round int64, digest common.Hash) *hotstuff.Message {
+	msg := &hotstuff.Message{
+		Code:    testMsgType(code),
+		View:    &hotstuff.View{Height: big.NewInt(height), Round: big.NewInt(round)},
+		Msg:     digest.Bytes(),
+		Address: crypto.PubkeyToAddress(pk.PublicKey),
+	}
+	data, err := msg.PayloadToSign(chainID, tag)
+	assert.NoError(t, err)
+	msg.Signature, err = crypto.Sign(crypto.Keccak256(data), pk)
+	assert.NoError(t, err)
+	return msg
+}
+
+func TestReportEquivocation(t *testing.T) {
+	resetTestContext()
+
+	pk, _ := crypto.GenerateKey()
+	peers := generateTestPeers(testGenesisNum - 1)
+	peers.List = append(peers.List, &PeerInfo{
+		PubKey:  hexutil.Encode(crypto.CompressPubkey(&pk.PublicKey)),
+		Address: crypto.PubkeyToAddress(pk.PublicKey),
+	})
+	_, err := storeGenesisEpoch(testStateDB, peers)
+	assert.NoError(t, err)
+	offender := crypto.PubkeyToAddress(pk.PublicKey)
+	reporter := generateTestAddress(99)
+
+	report := func(evidence *hotstuff.Evidence, blockNum int) error {
+		payload, err := (&MethodReportEquivocationInput{Evidence: evidence}).Encode()
+		assert.NoError(t, err)
+		ctx := generateNativeContract(reporter, blockNum)
+		ctx.ContractRef().SetChainID(testChainID)
+		_, _, err = ctx.ContractRef().NativeCall(reporter, this, payload)
+		return err
+	}
+
+	// messages with the same content are not equivocation
+	first := generateTestSignedMessage(t, pk, 2, 10, 1, generateTestHash(1))
+	same := generateTestSignedMessage(t, pk, 2, 10, 1, generateTestHash(1))
+	evidence, err := hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, same)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// messages in different views are not equivocation
+	other := generateTestSignedMessage(t, pk, 2, 10, 2, generateTestHash(2))
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, other)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// tampered signature
+	conflict := generateTestSignedMessage(t, pk, 2, 10, 1, generateTestHash(2))
+	tampered := *conflict
+	tampered.Msg = generateTestHash(3).Bytes()
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, &tampered)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// evidence from future
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, conflict)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 9))
+
+	// new view messages carry the local prepareQC which may be changed in the same view
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic,
+		generateTestSignedMessage(t, pk, 1, 10, 1, generateTestHash(1)),
+		generateTestSignedMessage(t, pk, 1, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// messages signed for another chain
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic,
+		generateTestTaggedMessage(t, pk, big.NewInt(1), hotstuff.MsgTagBasic, 2, 10, 1, generateTestHash(1)),
+		generateTestTaggedMessage(t, pk, big.NewInt(1), hotstuff.MsgTagBasic, 2, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// messages of basic hotstuff are not evidence of event-driven protocol
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagEventDriven, first, conflict)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// event-driven timeout messages carry the local highQC which may be changed in the same view
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagEventDriven,
+		generateTestTaggedMessage(t, pk, testChainID, hotstuff.MsgTagEventDriven, 3, 10, 1, generateTestHash(1)),
+		generateTestTaggedMessage(t, pk, testChainID, hotstuff.MsgTagEventDriven, 3, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+
+	// valid evidence can only be reported once, even if it's proved by other messages
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, conflict)
+	assert.NoError(t, err)
+	assert.NoError(t, report(evidence, 20))
+	assert.Equal(t, ErrDuplicateEvidence, report(evidence, 21))
+	another, err := hotstuff.NewEvidence(hotstuff.MsgTagBasic, first, generateTestSignedMessage(t, pk, 2, 10, 1, generateTestHash(3)))
+	assert.NoError(t, err)
+	assert.Equal(t, ErrDuplicateEvidence, report(another, 21))
+
+	// the same view with another message type is a different offence
+	another, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic,
+		generateTestSignedMessage(t, pk, 3, 10, 1, generateTestHash(1)),
+		generateTestSignedMessage(t, pk, 3, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, report(another, 21))
+
+	// event-driven proposal takes the same message type as basic new view, but it's a different offence
+	another, err = hotstuff.NewEvidence(hotstuff.MsgTagEventDriven,
+		generateTestTaggedMessage(t, pk, testChainID, hotstuff.MsgTagEventDriven, 1, 10, 1, generateTestHash(1)),
+		generateTestTaggedMessage(t, pk, testChainID, hotstuff.MsgTagEventDriven, 1, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, report(another, 21))
+
+	// query records of offender
+	payload, err := (&MethodGetEquivocationsInput{Offender: offender}).Encode()
+	assert.NoError(t, err)
+	ctx := generateNativeContract(common.EmptyAddress, 22)
+	enc, _, err := ctx.ContractRef().NativeCall(common.EmptyAddress, this, payload)
+	assert.NoError(t, err)
+	output := new(MethodGetEquivocationsOutput)
+	assert.NoError(t, output.Decode(enc))
+	assert.Equal(t, 3, len(output.List.List))
+	record := output.List.List[0]
+	assert.Equal(t, offender, record.Offender)
+	assert.Equal(t, uint64(10), record.Height)
+	assert.Equal(t, uint64(1), record.Round)
+	assert.Equal(t, uint64(2), record.Code)
+	assert.Equal(t, evidence.Hash(), record.Evidence)
+	assert.Equal(t, reporter, record.Reporter)
+	assert.Equal(t, hotstuff.MsgTagEventDriven+1, output.List.List[2].Code)
+
+	// validator not in epoch
+	stranger, _ := crypto.GenerateKey()
+	evidence, err = hotstuff.NewEvidence(hotstuff.MsgTagBasic,
+		generateTestSignedMessage(t, stranger, 2, 10, 1, generateTestHash(1)),
+		generateTestSignedMessage(t, stranger, 2, 10, 1, generateTestHash(2)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, ErrInvalidEvidence, report(evidence, 20))
+}
+
 func generateNativeContractRef(origin common.Address, blockNum int) *native.ContractRef {
 	token := make([]byte, common.HashLength)
 	rand.Read(token)
@@ -692,6 +912,24 @@ func generateNativeContractRef(origin common.Address, blockNum int) *native.Cont
 	return native.NewContractRef(testStateDB, origin, origin, big.NewInt(int64(blockNum)), hash, testSupplyGas, nil)
 }
 
+// generateElectionContractRef returns the contract ref of block after the election fork
+func generateElectionContractRef(origin common.Address, blockNum int) *native.ContractRef {
+	ref := generateNativeContractRef(origin, blockNum)
+	ref.EnableElection()
+	return ref
+}
+
+// registerTestValidator registers peer as staking validator, the self stake is transferred with the call as evm does.
+func registerTestValidator(peer *PeerInfo, selfStake *big.Int) error {
+	testStateDB.SubBalance(peer.Address, selfStake)
+	testStateDB.AddBalance(utils.StakingContractAddress, selfStake)
+	ref := generateNativeContractRef(peer.Address, 3)
+	ref.SetValue(selfStake)
+	payload, _ := (&staking.MethodRegisterValidatorInput{PubKey: peer.PubKey}).Encode()
+	_, _, err := ref.NativeCall(peer.Address, utils.StakingContractAddress, payload)
+	return err
+}
+
 func generateNativeContract(origin common.Address, blockNum int) *native.NativeContract {
 	ref := generateNativeContractRef(origin, blockNum)
 	return native.NewNativeContract(testStateDB, ref)
@@ -0,0 +1,182 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// NextRotationHeight returns the start height of the first automatically rotated epoch after height,
+// epochs start every epochLength blocks counting from rotationBlock.
+func NextRotationHeight(height, rotationBlock, epochLength uint64) uint64 {
+	if height < rotationBlock {
+		return rotationBlock + epochLength
+	}
+	return rotationBlock + ((height-rotationBlock)/epochLength+1)*epochLength
+}
+
+// isRotationHeight reports whether the epoch is rotated at height, which is MinEpochValidPeriod blocks
+// before the next epoch boundary.
+func isRotationHeight(height, rotationBlock, epochLength uint64) bool {
+	return height >= rotationBlock && NextRotationHeight(height, rotationBlock, epochLength)-height == MinEpochValidPeriod
+}
+
+// RotateEpoch elects the validators of next epoch from the candidates without proposals and votes. It
+// is invoked by the consensus engine on every block after the rotation fork, and it only takes action at
+// MinEpochValidPeriod blocks before the epoch boundary, so that the miner has the same time to prepare the
+// epoch change as a manual proposal. Rotation is skipped if:
+//   - an epoch passed by votes is waiting to take effect.
+//   - candidates are not enough to form an epoch.
+//   - 2/3 members of current epoch can not be kept by the election.
+//   - the elected members are the same as current epoch, with the same BLS keys.
+//
+// No transaction carries the automatic epoch change, so that the `EpochChanged` log is emitted with empty
+// transaction hash, it's delivered with the logs of the inserted block but not kept in receipts. The epoch
+// change event is not sent here since the block may be executed speculatively, see NotifyRotatedEpoch.
+func RotateEpoch(db *state.StateDB, height, rotationBlock, epochLength uint64) error {
+	if epochLength <= MinEpochValidPeriod {
+		return fmt.Errorf("epoch length %d should be greater than %d", epochLength, MinEpochValidPeriod)
+	}
+	if !isRotationHeight(height, rotationBlock, epochLength) {
+		return nil
+	}
+	startHeight := NextRotationHeight(height, rotationBlock, epochLength)
+
+	// automatic rotation is launched after the election fork
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.EmptyHash, 0, nil)
+	ref.EnableElection()
+	s := native.NewNativeContract(db, ref)
+
+	curEpoch, err := getCurrentEpoch(s)
+	if err != nil {
+		return err
+	}
+	if hash, err := getCurrentEpochHash(s); err != nil {
+		return err
+	} else if hash != curEpoch.Hash() {
+		log.Debug("rotateEpoch", "epoch changing", hash.Hex())
+		return nil
+	}
+
+	candidates, err := electionCandidates(s)
+	if err != nil {
+		return err
+	}
+	if candidates == nil {
+		log.Debug("rotateEpoch", "candidates not enough, height", height)
+		return nil
+	}
+	peers := electPeers(curEpoch, candidates)
+	if peers == nil {
+		log.Warn("rotateEpoch", "old members not enough in candidates, height", height)
+		return nil
+	}
+	if err := fillBLSKeys(s, peers); err != nil {
+		return err
+	}
+	if peers.Len() == curEpoch.Peers.Len() && curEpoch.OldMemberNum(peers) == peers.Len() && sameBLSKeys(curEpoch.Peers, peers) {
+		log.Debug("rotateEpoch", "validators not changed, height", height)
+		return nil
+	}
+
+	sort.Sort(peers)
+	epoch := &EpochInfo{
+		ID:          curEpoch.ID + 1,
+		Peers:       peers,
+		StartHeight: startHeight,
+		Status:      ProposalStatusPassed,
+	}
+	if err := storeEpoch(s, epoch); err != nil {
+		return err
+	}
+	if !checkProposal(s, epoch.ID, epoch.Hash()) {
+		if err := storeProposal(s, epoch.ID, epoch.Hash()); err != nil {
+			return err
+		}
+	}
+	storeCurrentEpochHash(s, epoch.Hash())
+	storeEpochProof(s, epoch.ID, epoch.Hash())
+	dirtyJob(s, curEpoch, epoch)
+
+	db.Prepare(common.EmptyHash, db.BlockHash(), db.TxIndex())
+	ref.PushContext(&native.Context{ContractAddress: utils.NodeManagerContractAddress})
+	if err := emitEpochChange(s, curEpoch, epoch); err != nil {
+		return err
+	}
+
+	log.Debug("rotateEpoch", "epoch rotated", epoch.String())
+	return nil
+}
+
+// sameBLSKeys reports whether the members of both peer lists published the same BLS keys.
+func sameBLSKeys(a, b *Peers) bool {
+	keys := make(map[common.Address][]byte)
+	for _, v := range a.List {
+		keys[v.Address] = v.BLSPubKey
+	}
+	for _, v := range b.List {
+		if !bytes.Equal(keys[v.Address], v.BLSPubKey) {
+			return false
+		}
+	}
+	return true
+}
+
+// NotifyRotatedEpoch sends the epoch change event if the epoch is rotated automatically in the block of
+// height. It should be invoked with the state of an inserted block, because RotateEpoch is executed in
+// the speculative block finalization as well.
+func NotifyRotatedEpoch(db *state.StateDB, height, rotationBlock, epochLength uint64) error {
+	if !isRotationHeight(height, rotationBlock, epochLength) {
+		return nil
+	}
+	ref := native.NewContractRef(db, common.EmptyAddress, common.EmptyAddress, new(big.Int).SetUint64(height), common.EmptyHash, 0, nil)
+	s := native.NewNativeContract(db, ref)
+	hash, err := getCurrentEpochHash(s)
+	if err != nil {
+		return err
+	}
+	epoch, err := getEpoch(s, hash)
+	if err != nil {
+		return err
+	}
+	if epoch.StartHeight != NextRotationHeight(height, rotationBlock, epochLength) {
+		return nil
+	}
+
+	keys, proofs := epoch.BLSKeys()
+	epochChangeFeed.Send(types.EpochChangeEvent{
+		EpochID:       epoch.ID,
+		StartHeight:   epoch.StartHeight,
+		Validators:    epoch.MemberList(),
+		Hash:          epoch.Hash(),
+		BLSPublicKeys: keys,
+		BLSProofs:     proofs,
+	})
+	return nil
+}
@@ -0,0 +1,175 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package node_manager
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	. "github.com/ethereum/go-ethereum/contracts/native/go_abi/node_manager_abi"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/staking"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextRotationHeight(t *testing.T) {
+	cases := []struct {
+		Height, RotationBlock, EpochLength, Expect uint64
+	}{
+		{0, 100, 200, 300},
+		{100, 100, 200, 300},
+		{299, 100, 200, 300},
+		{300, 100, 200, 500},
+		{1000, 0, 200, 1200},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.Expect, NextRotationHeight(c.Height, c.RotationBlock, c.EpochLength))
+	}
+}
+
+func TestElectPeers(t *testing.T) {
+	cur := generateTestEpochInfo(StartEpochID, 0, 4)
+
+	// old members ranked behind replace the lowest ranked new comers
+	candidates := generateTestPeers(MaxProposalPeersLen)
+	candidates.List = append(candidates.List, cur.Peers.List...)
+	peers := electPeers(cur, candidates)
+	assert.Equal(t, MaxProposalPeersLen, peers.Len())
+	assert.Equal(t, cur.QuorumSize(), cur.OldMemberNum(peers))
+	for i := 0; i < MaxProposalPeersLen-cur.QuorumSize(); i++ {
+		assert.Equal(t, candidates.List[i], peers.List[i])
+	}
+
+	// 2/3 continuity can not be satisfied
+	candidates = generateTestPeers(MinProposalPeersLen)
+	candidates.List = append(candidates.List, cur.Peers.List[:cur.QuorumSize()-1]...)
+	assert.Nil(t, electPeers(cur, candidates))
+
+	assert.Nil(t, electPeers(cur, generateTestPeers(MinProposalPeersLen-1)))
+}
+
+func TestRotateEpoch(t *testing.T) {
+	resetTestContext()
+	defer resetTestContext()
+	staking.InitStaking()
+
+	const (
+		rotationBlock uint64 = 100
+		epochLength   uint64 = 200
+		startHeight          = rotationBlock + epochLength
+		electHeight          = startHeight - MinEpochValidPeriod
+	)
+
+	keys := make([]*ecdsa.PrivateKey, MinProposalPeersLen+2)
+	peers := &Peers{List: make([]*PeerInfo, len(keys))}
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		peers.List[i] = &PeerInfo{
+			PubKey:  hexutil.Encode(crypto.CompressPubkey(&keys[i].PublicKey)),
+			Address: crypto.PubkeyToAddress(keys[i].PublicKey),
+		}
+		testStateDB.AddBalance(peers.List[i].Address, new(big.Int).Mul(staking.MinSelfStake, big.NewInt(10)))
+	}
+	testGenesisEpoch, _ = storeGenesisEpoch(testStateDB, &Peers{List: peers.List[:MinProposalPeersLen]})
+
+	ch := make(chan types.EpochChangeEvent, 1)
+	sub := SubscribeEpochChange(ch)
+	defer sub.Unsubscribe()
+
+	curEpochHash := func() string {
+		hash, err := getCurrentEpochHash(generateNativeContract(testCaller, int(electHeight)))
+		assert.NoError(t, err)
+		return hash.Hex()
+	}
+
+	// staking validators are not enough
+	assert.NoError(t, RotateEpoch(testStateDB, electHeight, rotationBlock, epochLength))
+	assert.Equal(t, testGenesisEpoch.Hash().Hex(), curEpochHash())
+
+	for i, peer := range peers.List {
+		assert.NoError(t, registerTestValidator(peer, new(big.Int).Mul(staking.MinSelfStake, big.NewInt(int64(i+1)))))
+	}
+
+	// not the election height
+	assert.NoError(t, RotateEpoch(testStateDB, electHeight-1, rotationBlock, epochLength))
+	assert.Equal(t, testGenesisEpoch.Hash().Hex(), curEpochHash())
+	assert.Error(t, RotateEpoch(testStateDB, electHeight, rotationBlock, MinEpochValidPeriod))
+
+	assert.NoError(t, RotateEpoch(testStateDB, electHeight, rotationBlock, epochLength))
+	select {
+	case <-ch:
+		t.Fatal("epoch change event sent in block finalization")
+	default:
+	}
+	logs := testStateDB.GetLogs(common.EmptyHash)
+	assert.Equal(t, ABI.Events[EventEpochChanged].ID, logs[len(logs)-1].Topics[0])
+
+	// the event is sent with the state of inserted block
+	assert.NoError(t, NotifyRotatedEpoch(testStateDB, electHeight-1, rotationBlock, epochLength))
+	assert.NoError(t, NotifyRotatedEpoch(testStateDB, electHeight, rotationBlock, epochLength))
+	event := <-ch
+	assert.Equal(t, testGenesisEpoch.ID+1, event.EpochID)
+	assert.Equal(t, startHeight, event.StartHeight)
+	assert.Equal(t, len(peers.List), len(event.Validators))
+	assert.Equal(t, event.Hash.Hex(), curEpochHash())
+
+	// the next epoch takes effect at the start height
+	epoch, err := getCurrentEpoch(generateNativeContract(testCaller, int(startHeight-1)))
+	assert.NoError(t, err)
+	assert.Equal(t, testGenesisEpoch.Hash(), epoch.Hash())
+	epoch, err = getCurrentEpoch(generateNativeContract(testCaller, int(startHeight)))
+	assert.NoError(t, err)
+	assert.Equal(t, event.Hash, epoch.Hash())
+	assert.Equal(t, ProposalStatusPassed, epoch.Status)
+	proof, err := getEpochProof(generateNativeContract(testCaller, int(startHeight)), epoch.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, epoch.Hash(), proof)
+
+	// the same validators are not rotated again
+	assert.NoError(t, RotateEpoch(testStateDB, startHeight+epochLength-MinEpochValidPeriod, rotationBlock, epochLength))
+	assert.Equal(t, event.Hash.Hex(), curEpochHash())
+	assert.NoError(t, NotifyRotatedEpoch(testStateDB, startHeight+epochLength-MinEpochValidPeriod, rotationBlock, epochLength))
+	select {
+	case <-ch:
+		t.Fatal("epoch change event sent without rotation")
+	default:
+	}
+
+	// the same validators are rotated with the BLS key published
+	key := generateTestBLSKey(t, keys[0])
+	assert.NoError(t, setTestBLSPublicKey(peers.List[0].Address, key))
+	nextHeight := startHeight + 2*epochLength - MinEpochValidPeriod
+	assert.NoError(t, RotateEpoch(testStateDB, nextHeight, rotationBlock, epochLength))
+	assert.NoError(t, NotifyRotatedEpoch(testStateDB, nextHeight, rotationBlock, epochLength))
+	event = <-ch
+	assert.Equal(t, len(peers.List), len(event.Validators))
+	assert.Equal(t, len(peers.List), len(event.BLSPublicKeys))
+	for i, addr := range event.Validators {
+		if addr == peers.List[0].Address {
+			assert.Equal(t, key.PubKey, event.BLSPublicKeys[i])
+			assert.Equal(t, key.Proof, event.BLSProofs[i])
+		} else {
+			assert.Empty(t, event.BLSPublicKeys[i])
+		}
+	}
+}
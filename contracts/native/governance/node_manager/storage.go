@@ -45,6 +45,12 @@ const (
 	SKP_CUR_EPOCH = "st_cur_epoch"
 	SKP_SIGN      = "st_sign"
 	SKP_SIGNER    = "st_signer"
+	SKP_EVIDENCE  = "st_evidence"
+	SKP_OFFENDER  = "st_offender"
+	SKP_CANDIDATE = "st_candidate"
+	SKP_CAND_LIST = "st_candidate_list"
+	SKP_DOWNTIME  = "st_downtime"
+	SKP_BLS_KEY   = "st_bls_key"
 )
 
 // ====================================================================
@@ -467,6 +473,162 @@ func clearSigner(s *native.NativeContract, hash common.Hash) {
 // storage basic operations
 //
 // ====================================================================
+func storeEquivocation(s *native.NativeContract, record *Equivocation) error {
+	list, err := getEquivocations(s, record.Offender)
+	if err != nil {
+		if err.Error() == ErrEof.Error() {
+			list = make([]*Equivocation, 0)
+		} else {
+			return err
+		}
+	}
+	list = append(list, record)
+
+	value, err := rlp.EncodeToBytes(&EquivocationList{List: list})
+	if err != nil {
+		return err
+	}
+	set(s, offenderKey(record.Offender), value)
+	set(s, evidenceKey(record.Offender, record.Height, record.Round, record.Code), record.Evidence.Bytes())
+	return nil
+}
+
+func getEquivocations(s *native.NativeContract, offender common.Address) ([]*Equivocation, error) {
+	key := offenderKey(offender)
+	value, err := get(s, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var list *EquivocationList
+	if err := rlp.DecodeBytes(value, &list); err != nil {
+		return nil, err
+	}
+	return list.List, nil
+}
+
+func findEquivocation(s *native.NativeContract, offender common.Address, height, round, code uint64) bool {
+	_, err := get(s, evidenceKey(offender, height, round, code))
+	return err == nil
+}
+
+// ====================================================================
+//
+// `candidate` and `downtime` storage
+//
+// ====================================================================
+func storeCandidate(s *native.NativeContract, candidate *Candidate) error {
+	value, err := rlp.EncodeToBytes(candidate)
+	if err != nil {
+		return err
+	}
+	set(s, candidateKey(candidate.Address), value)
+	return nil
+}
+
+func getCandidate(s *native.NativeContract, addr common.Address) (*Candidate, error) {
+	value, err := get(s, candidateKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	var candidate *Candidate
+	if err := rlp.DecodeBytes(value, &candidate); err != nil {
+		return nil, err
+	}
+	return candidate, nil
+}
+
+func delCandidate(s *native.NativeContract, addr common.Address) {
+	del(s, candidateKey(addr))
+}
+
+// getCandidateList returns the candidates address in registration order.
+func getCandidateList(s *native.NativeContract) ([]common.Address, error) {
+	value, err := get(s, candidateListKey())
+	if err != nil {
+		if err.Error() == ErrEof.Error() {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var list *AddressList
+	if err := rlp.DecodeBytes(value, &list); err != nil {
+		return nil, err
+	}
+	return list.List, nil
+}
+
+func setCandidateList(s *native.NativeContract, list []common.Address) error {
+	if len(list) == 0 {
+		del(s, candidateListKey())
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(&AddressList{List: list})
+	if err != nil {
+		return err
+	}
+	set(s, candidateListKey(), value)
+	return nil
+}
+
+func storeDowntime(s *native.NativeContract, addr common.Address, downtime *Downtime) error {
+	value, err := rlp.EncodeToBytes(downtime)
+	if err != nil {
+		return err
+	}
+	set(s, downtimeKey(addr), value)
+	return nil
+}
+
+// getDowntime returns an empty record if validator never missed any block.
+func getDowntime(s *native.NativeContract, addr common.Address) (*Downtime, error) {
+	value, err := get(s, downtimeKey(addr))
+	if err != nil {
+		if err.Error() == ErrEof.Error() {
+			return new(Downtime), nil
+		}
+		return nil, err
+	}
+	var downtime *Downtime
+	if err := rlp.DecodeBytes(value, &downtime); err != nil {
+		return nil, err
+	}
+	return downtime, nil
+}
+
+// ====================================================================
+//
+// `bls key` storage
+//
+// ====================================================================
+func storeBLSKey(s *native.NativeContract, addr common.Address, key *BLSKey) error {
+	return setBLSKey(s.GetCacheDB(), addr, key)
+}
+
+func setBLSKey(s *state.CacheDB, addr common.Address, key *BLSKey) error {
+	value, err := rlp.EncodeToBytes(key)
+	if err != nil {
+		return err
+	}
+	customSet(s, blsKeyKey(addr), value)
+	return nil
+}
+
+// getBLSKey returns nil if validator never published the BLS key.
+func getBLSKey(s *native.NativeContract, addr common.Address) (*BLSKey, error) {
+	value, err := get(s, blsKeyKey(addr))
+	if err != nil {
+		if err.Error() == ErrEof.Error() {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var key *BLSKey
+	if err := rlp.DecodeBytes(value, &key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
 
 func get(s *native.NativeContract, key []byte) ([]byte, error) {
 	return customGet(s.GetCacheDB(), key)
@@ -536,3 +698,28 @@ func signKey(hash common.Hash) []byte {
 func signerKey(hash common.Hash) []byte {
 	return utils.ConcatKey(this, []byte(SKP_SIGNER), hash.Bytes())
 }
+
+func evidenceKey(offender common.Address, height, round, code uint64) []byte {
+	return utils.ConcatKey(this, []byte(SKP_EVIDENCE), offender.Bytes(), utils.GetUint64Bytes(height),
+		utils.GetUint64Bytes(round), utils.GetUint64Bytes(code))
+}
+
+func offenderKey(offender common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_OFFENDER), offender.Bytes())
+}
+
+func candidateKey(addr common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_CANDIDATE), addr.Bytes())
+}
+
+func candidateListKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_CAND_LIST))
+}
+
+func downtimeKey(addr common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_DOWNTIME), addr.Bytes())
+}
+
+func blsKeyKey(addr common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_BLS_KEY), addr.Bytes())
+}
@@ -27,6 +27,7 @@ import (
 	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/rlp"
 )
@@ -34,29 +35,66 @@ import (
 type PeerInfo struct {
 	PubKey  string
 	Address common.Address
+
+	// BLS public key and proof of possession published by validator, which are used to verify the
+	// aggregated committed seals. They are encoded only if set, so the epoch hash keeps unchanged
+	// for validators without BLS key.
+	BLSPubKey hexutil.Bytes `json:",omitempty"`
+	BLSProof  hexutil.Bytes `json:",omitempty"`
 }
 
 func (m *PeerInfo) EncodeRLP(w io.Writer) error {
+	if len(m.BLSPubKey) > 0 {
+		return rlp.Encode(w, []interface{}{m.PubKey, m.Address, m.BLSPubKey, m.BLSProof})
+	}
 	return rlp.Encode(w, []interface{}{m.PubKey, m.Address})
 }
 
 func (m *PeerInfo) DecodeRLP(s *rlp.Stream) error {
 	var peer struct {
-		PubKey  string
-		Address common.Address
+		PubKey    string
+		Address   common.Address
+		BLSPubKey []byte `rlp:"optional"`
+		BLSProof  []byte `rlp:"optional"`
 	}
 
 	if err := s.Decode(&peer); err != nil {
 		return err
 	}
-	m.PubKey, m.Address = peer.PubKey, peer.Address
+	m.PubKey, m.Address, m.BLSPubKey, m.BLSProof = peer.PubKey, peer.Address, peer.BLSPubKey, peer.BLSProof
 	return nil
 }
 
 func (m *PeerInfo) String() string {
+	if len(m.BLSPubKey) > 0 {
+		return fmt.Sprintf("{Address: %s PubKey: %s BLSPubKey: %s}", m.Address.Hex(), m.PubKey, m.BLSPubKey)
+	}
 	return fmt.Sprintf("{Address: %s PubKey: %s}", m.Address.Hex(), m.PubKey)
 }
 
+// BLSKey is the BLS public key published by validator with the proof of possession.
+type BLSKey struct {
+	PubKey []byte
+	Proof  []byte
+}
+
+func (m *BLSKey) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.PubKey, m.Proof})
+}
+
+func (m *BLSKey) DecodeRLP(s *rlp.Stream) error {
+	var key struct {
+		PubKey []byte
+		Proof  []byte
+	}
+
+	if err := s.Decode(&key); err != nil {
+		return err
+	}
+	m.PubKey, m.Proof = key.PubKey, key.Proof
+	return nil
+}
+
 type Peers struct {
 	List []*PeerInfo
 }
@@ -92,6 +130,18 @@ func (m *Peers) Swap(i, j int) {
 	m.List[i], m.List[j] = m.List[j], m.List[i]
 }
 
+func (m *Peers) Contains(addr common.Address) bool {
+	if m == nil {
+		return false
+	}
+	for _, v := range m.List {
+		if v.Address == addr {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *Peers) Copy() *Peers {
 	enc, err := rlp.EncodeToBytes(m)
 	if err != nil {
@@ -165,6 +215,11 @@ func (m *EpochInfo) String() string {
 }
 
 func (m *EpochInfo) Json() string {
+	return m.JsonWithStatus(nil)
+}
+
+// JsonWithStatus encodes epoch with the candidate metadata and downtime of peers.
+func (m *EpochInfo) JsonWithStatus(status []*PeerStatus) string {
 	var epoch = struct {
 		ID          uint64
 		Peers       *Peers
@@ -172,6 +227,7 @@ func (m *EpochInfo) Json() string {
 		Proposer    common.Address
 		Status      ProposalStatusType
 		Hash        common.Hash
+		PeerStatus  []*PeerStatus `json:",omitempty"`
 	}{
 		ID:          m.ID,
 		Peers:       m.Peers,
@@ -179,6 +235,7 @@ func (m *EpochInfo) Json() string {
 		Proposer:    m.Proposer,
 		Status:      m.Status,
 		Hash:        m.Hash(),
+		PeerStatus:  status,
 	}
 	bytes, _ := json.Marshal(epoch)
 	return string(bytes)
@@ -224,6 +281,24 @@ func (m *EpochInfo) MemberList() []common.Address {
 	return list
 }
 
+// BLSKeys returns the BLS public keys and proofs of members in the order of MemberList, the keys are nil
+// if none of the members published it.
+func (m *EpochInfo) BLSKeys() (keys [][]byte, proofs [][]byte) {
+	if m == nil || m.Peers == nil {
+		return nil, nil
+	}
+	published := false
+	keys, proofs = make([][]byte, len(m.Peers.List)), make([][]byte, len(m.Peers.List))
+	for i, v := range m.Peers.List {
+		keys[i], proofs[i] = v.BLSPubKey, v.BLSProof
+		published = published || len(v.BLSPubKey) > 0
+	}
+	if !published {
+		return nil, nil
+	}
+	return keys, proofs
+}
+
 func (m *EpochInfo) QuorumSize() int {
 	if m == nil || m.Peers == nil {
 		return 0
@@ -334,3 +409,126 @@ func (m *ConsensusSign) Hash() common.Hash {
 	m.hash.Store(v)
 	return v
 }
+
+// Equivocation records a validator sending conflicting consensus messages in the same view.
+type Equivocation struct {
+	Offender     common.Address
+	Height       uint64 // height of the conflicting messages
+	Round        uint64 // round of the conflicting messages
+	Code         uint64 // message type of the conflicting messages
+	Evidence     common.Hash
+	Reporter     common.Address
+	ReportHeight uint64
+}
+
+func (m *Equivocation) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Offender, m.Height, m.Round, m.Code, m.Evidence, m.Reporter, m.ReportHeight})
+}
+
+func (m *Equivocation) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Offender     common.Address
+		Height       uint64
+		Round        uint64
+		Code         uint64
+		Evidence     common.Hash
+		Reporter     common.Address
+		ReportHeight uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Offender, m.Height, m.Round, m.Code, m.Evidence, m.Reporter, m.ReportHeight = data.Offender, data.Height, data.Round, data.Code, data.Evidence, data.Reporter, data.ReportHeight
+	return nil
+}
+
+type EquivocationList struct {
+	List []*Equivocation
+}
+
+func (m *EquivocationList) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.List})
+}
+
+func (m *EquivocationList) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		List []*Equivocation
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.List = data.List
+	return nil
+}
+
+// Candidate is the node declared to be elected as validator, the metadata is informational only.
+type Candidate struct {
+	Address        common.Address
+	PubKey         string
+	Name           string
+	URL            string
+	RegisterHeight uint64
+}
+
+func (m *Candidate) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Address, m.PubKey, m.Name, m.URL, m.RegisterHeight})
+}
+
+func (m *Candidate) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Address        common.Address
+		PubKey         string
+		Name           string
+		URL            string
+		RegisterHeight uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Address, m.PubKey, m.Name, m.URL, m.RegisterHeight = data.Address, data.PubKey, data.Name, data.URL, data.RegisterHeight
+	return nil
+}
+
+// Downtime records the consecutive blocks missed by validator, and the jail status once it missed too many.
+type Downtime struct {
+	Missed      uint64 // number of consecutive blocks without proposal or committed seal of validator
+	Jailed      bool
+	JailedUntil uint64 // the height since which validator is allowed to unjail
+	JailCount   uint64
+}
+
+func (m *Downtime) EncodeRLP(w io.Writer) error {
+	return rlp.Encode(w, []interface{}{m.Missed, m.Jailed, m.JailedUntil, m.JailCount})
+}
+
+func (m *Downtime) DecodeRLP(s *rlp.Stream) error {
+	var data struct {
+		Missed      uint64
+		Jailed      bool
+		JailedUntil uint64
+		JailCount   uint64
+	}
+
+	if err := s.Decode(&data); err != nil {
+		return err
+	}
+	m.Missed, m.Jailed, m.JailedUntil, m.JailCount = data.Missed, data.Jailed, data.JailedUntil, data.JailCount
+	return nil
+}
+
+// PeerStatus is the candidate metadata and downtime of a peer exposed by json getters.
+type PeerStatus struct {
+	Address        common.Address
+	PubKey         string
+	Name           string
+	URL            string
+	Registered     bool
+	RegisterHeight uint64
+	Missed         uint64
+	Jailed         bool
+	JailedUntil    uint64
+	JailCount      uint64
+}
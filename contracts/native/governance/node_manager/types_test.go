@@ -38,6 +38,25 @@ func TestPeerInfoType(t *testing.T) {
 	t.Logf("peer info length %d", len(enc))
 }
 
+// TestPeerInfoBLSKey checks the peer without BLS key is encoded as before, so the epoch hash keeps unchanged.
+func TestPeerInfoBLSKey(t *testing.T) {
+	peer := generateTestPeer()
+	legacy, err := rlp.EncodeToBytes([]interface{}{peer.PubKey, peer.Address})
+	assert.NoError(t, err)
+	enc, err := rlp.EncodeToBytes(peer)
+	assert.NoError(t, err)
+	assert.Equal(t, legacy, enc)
+
+	peer.BLSPubKey, peer.BLSProof = []byte("bls public key"), []byte("bls proof")
+	enc, err = rlp.EncodeToBytes(peer)
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacy, enc)
+
+	var got *PeerInfo
+	assert.NoError(t, rlp.DecodeBytes(enc, &got))
+	assert.Equal(t, peer, got)
+}
+
 func TestPeersType(t *testing.T) {
 	expect := generateTestPeers(10)
 
@@ -24,6 +24,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/staking"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
@@ -96,6 +97,20 @@ func getEpochListByID(s *native.NativeContract, epochID uint64) ([]*EpochInfo, e
 	return epochList, nil
 }
 
+// getEpochByHeight retrieve the effective epoch at the given height which is not greater than current block height.
+func getEpochByHeight(s *native.NativeContract, height uint64) (*EpochInfo, error) {
+	epoch, err := getCurrentEpoch(s)
+	if err != nil {
+		return nil, err
+	}
+	for epoch.StartHeight > height && epoch.ID > StartEpochID {
+		if epoch, err = getEffectiveEpochByID(s, epoch.ID-1); err != nil {
+			return nil, err
+		}
+	}
+	return epoch, nil
+}
+
 func getChangingEpoch(s *native.NativeContract) (*EpochInfo, error) {
 	curEpochHash, err := getCurrentEpochHash(s)
 	if err != nil {
@@ -155,6 +170,104 @@ func checkPeer(peer *PeerInfo) error {
 	return nil
 }
 
+// electionCandidates returns the candidates of next epoch in priority order, which are the active staking
+// validators ordered by stake, or the registered candidates in registration order if staking validators
+// are not enough. Jailed validators are excluded, and it returns nil if candidates are not enough to form
+// an epoch, e.g: neither staking nor candidate registration launched yet, or before the election fork.
+func electionCandidates(s *native.NativeContract) (*Peers, error) {
+	if !s.ContractRef().Election() {
+		return nil, nil
+	}
+	ranking, err := staking.ValidatorRanking(s.StateDB())
+	if err != nil {
+		return nil, err
+	}
+	peers := &Peers{List: make([]*PeerInfo, 0, len(ranking))}
+	for _, v := range ranking {
+		if jailed, err := isJailed(s, v.Address); err != nil {
+			return nil, err
+		} else if !jailed {
+			peers.List = append(peers.List, &PeerInfo{PubKey: v.PubKey, Address: v.Address})
+		}
+	}
+	if peers.Len() >= MinProposalPeersLen {
+		return peers, nil
+	}
+
+	list, err := getCandidateList(s)
+	if err != nil {
+		return nil, err
+	}
+	peers = &Peers{List: make([]*PeerInfo, 0, len(list))}
+	for _, addr := range list {
+		if jailed, err := isJailed(s, addr); err != nil {
+			return nil, err
+		} else if jailed {
+			continue
+		}
+		candidate, err := getCandidate(s, addr)
+		if err != nil {
+			return nil, err
+		}
+		peers.List = append(peers.List, &PeerInfo{PubKey: candidate.PubKey, Address: addr})
+	}
+	if peers.Len() >= MinProposalPeersLen {
+		return peers, nil
+	}
+	return nil, nil
+}
+
+// fillBLSKeys sets the BLS public keys published by peers, the keys carried in peer list are overridden,
+// so that proposer can't assign keys to others.
+func fillBLSKeys(s *native.NativeContract, peers *Peers) error {
+	for _, peer := range peers.List {
+		key, err := getBLSKey(s, peer.Address)
+		if err != nil {
+			return err
+		}
+		peer.BLSPubKey, peer.BLSProof = nil, nil
+		if key != nil {
+			peer.BLSPubKey, peer.BLSProof = key.PubKey, key.Proof
+		}
+	}
+	return nil
+}
+
+// electPeers selects at most MaxProposalPeersLen members of next epoch from the ranked candidates. The
+// lowest ranked new comers are replaced by the old members ranked behind until at least 2/3 of current
+// epoch members are kept, it returns nil if there are not enough old members in candidates.
+func electPeers(cur *EpochInfo, candidates *Peers) *Peers {
+	size := candidates.Len()
+	if size > MaxProposalPeersLen {
+		size = MaxProposalPeersLen
+	}
+	if size < MinProposalPeersLen {
+		return nil
+	}
+	selected := make([]*PeerInfo, size)
+	copy(selected, candidates.List[:size])
+
+	quorum := cur.QuorumSize()
+	old := cur.OldMemberNum(&Peers{List: selected})
+	rest := make([]*PeerInfo, 0)
+	for _, v := range candidates.List[size:] {
+		if cur.Peers.Contains(v.Address) {
+			rest = append(rest, v)
+		}
+	}
+	for i := size - 1; i >= 0 && old < quorum && len(rest) > 0; i-- {
+		if cur.Peers.Contains(selected[i].Address) {
+			continue
+		}
+		selected[i], rest = rest[0], rest[1:]
+		old++
+	}
+	if old < quorum {
+		return nil
+	}
+	return &Peers{List: selected}
+}
+
 func generateEmptyContext(db *state.StateDB) *native.NativeContract {
 	caller := common.EmptyAddress
 	ref := native.NewContractRef(db, caller, caller, common.Big0, common.EmptyHash, 0, nil)
@@ -0,0 +1,30 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+//go:generate nativegen --abi ../../solidity/staking.abi --gas ../../solidity/staking_gas.json --type Staking --pkg staking --out abi_gen.go
+//go:generate nativegen --abi ../../solidity/staking.abi --type Staking --pkg staking_abi --binding --out ../../go_abi/staking_abi/staking_abi.go
+
+import (
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+)
+
+const contractName = "staking"
+
+var this = utils.StakingContractAddress
@@ -0,0 +1,105 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// DistributeRewards mints the block reward and shares it equally among the active validators which
+// committed the parent block, each share is split into the validator commission and the delegators
+// reward by stake. Committers not staking are skipped, and nothing is minted if none of them is eligible.
+func DistributeRewards(db *state.StateDB, committers []common.Address, reward *big.Int) error {
+	if reward == nil || reward.Sign() <= 0 || len(committers) == 0 {
+		return nil
+	}
+	cache := (*state.CacheDB)(db)
+
+	seen := make(map[common.Address]struct{})
+	eligible := make([]*Validator, 0, len(committers))
+	for _, addr := range committers {
+		if _, ok := seen[addr]; ok {
+			continue
+		}
+		seen[addr] = struct{}{}
+
+		validator, err := getValidator(cache, addr)
+		if err != nil {
+			return err
+		}
+		if validator != nil && validator.Active() && validator.TotalStake.Sign() > 0 {
+			eligible = append(eligible, validator)
+		}
+	}
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	share := new(big.Int).Div(reward, big.NewInt(int64(len(eligible))))
+	if share.Sign() == 0 {
+		return nil
+	}
+	for _, validator := range eligible {
+		commission := new(big.Int).Mul(share, new(big.Int).SetUint64(validator.Commission))
+		commission.Div(commission, new(big.Int).SetUint64(CommissionBase))
+		addRewards(cache, validator.Address, commission)
+
+		rest := new(big.Int).Sub(share, commission)
+		rest.Mul(rest, rewardPrecision)
+		rest.Div(rest, validator.TotalStake)
+		validator.RewardPerStake.Add(validator.RewardPerStake, rest)
+		if err := storeValidator(cache, validator); err != nil {
+			return err
+		}
+	}
+	db.AddBalance(this, new(big.Int).Mul(share, big.NewInt(int64(len(eligible)))))
+	return nil
+}
+
+// ValidatorRanking returns the active validators ordered by total stake descending, validators with
+// the same stake are ordered by address.
+func ValidatorRanking(db *state.StateDB) ([]*Validator, error) {
+	cache := (*state.CacheDB)(db)
+	list, err := getValidatorList(cache)
+	if err != nil {
+		return nil, err
+	}
+	ranking := make([]*Validator, 0, len(list))
+	for _, addr := range list {
+		validator, err := getValidator(cache, addr)
+		if err != nil {
+			return nil, err
+		}
+		if validator != nil && validator.Active() {
+			ranking = append(ranking, validator)
+		}
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if c := ranking[i].TotalStake.Cmp(ranking[j].TotalStake); c != 0 {
+			return c > 0
+		}
+		return bytes.Compare(ranking[i].Address.Bytes(), ranking[j].Address.Bytes()) < 0
+	})
+	return ranking, nil
+}
@@ -0,0 +1,426 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var (
+	ErrInvalidAuthority = errors.New("caller is not equal to origin")
+
+	ErrInvalidPubKey = errors.New("public key not match tx origin")
+
+	ErrInvalidCommission = errors.New("commission rate exceeds 100%")
+
+	ErrInvalidAmount = errors.New("stake amount should be positive")
+
+	ErrNotPayable = errors.New("method does not accept value")
+
+	ErrInsufficientStake = errors.New("insufficient stake to unstake")
+
+	ErrSelfStakeTooLow = errors.New("self stake below minimum")
+
+	ErrValidatorExist = errors.New("validator already registered")
+
+	ErrValidatorNotExist = errors.New("validator not registered")
+
+	ErrValidatorInactive = errors.New("validator is inactive")
+
+	ErrUnbondingEntries = errors.New("too many unbonding entries")
+
+	ErrNothingToWithdraw = errors.New("nothing to withdraw")
+
+	ErrStorage = errors.New("failed to access storage")
+
+	ErrEmitLog = errors.New("failed to emit log")
+)
+
+func InitStaking() {
+	InitABI()
+	native.Contracts[this] = RegisterStakingContract(handler{})
+}
+
+// handler implements the methods of staking contract.
+type handler struct{}
+
+func (handler) Name(s *native.NativeContract, input *MethodNameInput) (*MethodNameOutput, error) {
+	return &MethodNameOutput{Result: contractName}, nil
+}
+
+// checkOrigin only allows the externally owned account to call the mutating methods, and only the payable
+// methods accept value, which has been transferred to the contract with the call.
+func checkOrigin(s *native.NativeContract, payable bool) (common.Address, error) {
+	origin := s.ContractRef().TxOrigin()
+	if origin == common.EmptyAddress || origin != s.ContractRef().CurrentContext().Caller {
+		return common.EmptyAddress, ErrInvalidAuthority
+	}
+	if !payable && s.ContractRef().Value().Sign() > 0 {
+		return common.EmptyAddress, ErrNotPayable
+	}
+	return origin, nil
+}
+
+// RegisterValidator registers tx origin as validator candidate with commission rate, the value of call
+// is the self stake.
+func (handler) RegisterValidator(s *native.NativeContract, input *MethodRegisterValidatorInput) (*MethodRegisterValidatorOutput, error) {
+	height := s.ContractRef().BlockHeight().Uint64()
+	cache := s.GetCacheDB()
+
+	origin, err := checkOrigin(s, true)
+	if err != nil {
+		log.Trace("registerValidator", "check origin failed", err)
+		return nil, err
+	}
+
+	if enc, err := hexutil.Decode(input.PubKey); err != nil {
+		return nil, ErrInvalidPubKey
+	} else if pubKey, err := crypto.DecompressPubkey(enc); err != nil || crypto.PubkeyToAddress(*pubKey) != origin {
+		return nil, ErrInvalidPubKey
+	}
+	if input.Commission > CommissionBase {
+		return nil, ErrInvalidCommission
+	}
+	selfStake := s.ContractRef().Value()
+	if selfStake.Cmp(MinSelfStake) < 0 {
+		return nil, ErrSelfStakeTooLow
+	}
+
+	if validator, err := getValidator(cache, origin); err != nil {
+		log.Trace("registerValidator", "get validator failed", err)
+		return nil, ErrStorage
+	} else if validator != nil {
+		return nil, ErrValidatorExist
+	}
+
+	validator := &Validator{
+		Address:        origin,
+		PubKey:         input.PubKey,
+		Commission:     input.Commission,
+		SelfStake:      new(big.Int),
+		TotalStake:     new(big.Int),
+		RewardPerStake: new(big.Int),
+		RegisterHeight: height,
+	}
+	if err := appendValidatorList(cache, origin); err != nil {
+		log.Trace("registerValidator", "store validator list failed", err)
+		return nil, ErrStorage
+	}
+	if err := EmitValidatorRegistered(s, common.BytesToHash(origin.Bytes()), input.PubKey, input.Commission); err != nil {
+		log.Trace("registerValidator", "emit event log failed", err)
+		return nil, ErrEmitLog
+	}
+	if err := stake(s, origin, validator, selfStake); err != nil {
+		return nil, err
+	}
+	return &MethodRegisterValidatorOutput{Result: true}, nil
+}
+
+// UpdateCommission updates the commission rate of tx origin, it takes effect on the next block reward.
+func (handler) UpdateCommission(s *native.NativeContract, input *MethodUpdateCommissionInput) (*MethodUpdateCommissionOutput, error) {
+	cache := s.GetCacheDB()
+
+	origin, err := checkOrigin(s, false)
+	if err != nil {
+		log.Trace("updateCommission", "check origin failed", err)
+		return nil, err
+	}
+	if input.Commission > CommissionBase {
+		return nil, ErrInvalidCommission
+	}
+
+	validator, err := getValidator(cache, origin)
+	if err != nil {
+		log.Trace("updateCommission", "get validator failed", err)
+		return nil, ErrStorage
+	} else if validator == nil {
+		return nil, ErrValidatorNotExist
+	}
+	validator.Commission = input.Commission
+	if err := storeValidator(cache, validator); err != nil {
+		log.Trace("updateCommission", "store validator failed", err)
+		return nil, ErrStorage
+	}
+	if err := EmitCommissionUpdated(s, common.BytesToHash(origin.Bytes()), input.Commission); err != nil {
+		log.Trace("updateCommission", "emit event log failed", err)
+		return nil, ErrEmitLog
+	}
+	return &MethodUpdateCommissionOutput{Result: true}, nil
+}
+
+// Stake delegates the value of call to validator, staking to itself increases the self stake.
+func (handler) Stake(s *native.NativeContract, input *MethodStakeInput) (*MethodStakeOutput, error) {
+	delegator, err := checkOrigin(s, true)
+	if err != nil {
+		log.Trace("stake", "check origin failed", err)
+		return nil, err
+	}
+
+	validator, err := getValidator(s.GetCacheDB(), input.Validator)
+	if err != nil {
+		log.Trace("stake", "get validator failed", err)
+		return nil, ErrStorage
+	} else if validator == nil {
+		return nil, ErrValidatorNotExist
+	}
+	if delegator != validator.Address && !validator.Active() {
+		return nil, ErrValidatorInactive
+	}
+	if err := stake(s, delegator, validator, s.ContractRef().Value()); err != nil {
+		return nil, err
+	}
+	return &MethodStakeOutput{Result: true}, nil
+}
+
+// stake accounts the amount which has been transferred to the contract by the call as delegation.
+func stake(s *native.NativeContract, delegator common.Address, validator *Validator, amount *big.Int) error {
+	cache := s.GetCacheDB()
+	if amount.Sign() <= 0 {
+		return ErrInvalidAmount
+	}
+
+	delegation, err := getDelegation(cache, delegator, validator.Address)
+	if err != nil {
+		log.Trace("stake", "get delegation failed", err)
+		return ErrStorage
+	}
+	if validator.Address == delegator && new(big.Int).Add(validator.SelfStake, amount).Cmp(MinSelfStake) < 0 {
+		return ErrSelfStakeTooLow
+	}
+
+	// settle reward before the stake changed
+	addRewards(cache, delegator, delegation.pending(validator))
+	delegation.Amount.Add(delegation.Amount, amount)
+	delegation.RewardDebt = delegation.accumulated(validator)
+	validator.TotalStake.Add(validator.TotalStake, amount)
+	if validator.Address == delegator {
+		validator.SelfStake.Add(validator.SelfStake, amount)
+	}
+
+	if err := storeDelegation(cache, delegator, validator.Address, delegation); err != nil {
+		log.Trace("stake", "store delegation failed", err)
+		return ErrStorage
+	}
+	if err := storeValidator(cache, validator); err != nil {
+		log.Trace("stake", "store validator failed", err)
+		return ErrStorage
+	}
+	if err := EmitStaked(s, common.BytesToHash(delegator.Bytes()), common.BytesToHash(validator.Address.Bytes()), amount); err != nil {
+		log.Trace("stake", "emit event log failed", err)
+		return ErrEmitLog
+	}
+	return nil
+}
+
+// Unstake moves amount of delegation into unbonding, which is withdrawable after the unbonding period.
+// Validator should keep the minimum self stake or unstake all to quit.
+func (handler) Unstake(s *native.NativeContract, input *MethodUnstakeInput) (*MethodUnstakeOutput, error) {
+	height := s.ContractRef().BlockHeight().Uint64()
+	cache := s.GetCacheDB()
+
+	delegator, err := checkOrigin(s, false)
+	if err != nil {
+		log.Trace("unstake", "check origin failed", err)
+		return nil, err
+	}
+	amount := input.Amount
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	validator, err := getValidator(cache, input.Validator)
+	if err != nil {
+		log.Trace("unstake", "get validator failed", err)
+		return nil, ErrStorage
+	} else if validator == nil {
+		return nil, ErrValidatorNotExist
+	}
+	delegation, err := getDelegation(cache, delegator, validator.Address)
+	if err != nil {
+		log.Trace("unstake", "get delegation failed", err)
+		return nil, ErrStorage
+	}
+	if delegation.Amount.Cmp(amount) < 0 {
+		return nil, ErrInsufficientStake
+	}
+	if validator.Address == delegator {
+		if left := new(big.Int).Sub(validator.SelfStake, amount); left.Sign() > 0 && left.Cmp(MinSelfStake) < 0 {
+			return nil, ErrSelfStakeTooLow
+		}
+	}
+	unbondings, err := getUnbondings(cache, delegator)
+	if err != nil {
+		log.Trace("unstake", "get unbondings failed", err)
+		return nil, ErrStorage
+	}
+	if len(unbondings) >= MaxUnbondingEntries {
+		return nil, ErrUnbondingEntries
+	}
+
+	// settle reward before the stake changed
+	addRewards(cache, delegator, delegation.pending(validator))
+	delegation.Amount.Sub(delegation.Amount, amount)
+	delegation.RewardDebt = delegation.accumulated(validator)
+	validator.TotalStake.Sub(validator.TotalStake, amount)
+	if validator.Address == delegator {
+		validator.SelfStake.Sub(validator.SelfStake, amount)
+	}
+	unbonding := &Unbonding{Validator: validator.Address, Amount: amount, CompleteHeight: height + UnbondingPeriod}
+
+	if err := storeUnbondings(cache, delegator, append(unbondings, unbonding)); err != nil {
+		log.Trace("unstake", "store unbondings failed", err)
+		return nil, ErrStorage
+	}
+	if err := storeDelegation(cache, delegator, validator.Address, delegation); err != nil {
+		log.Trace("unstake", "store delegation failed", err)
+		return nil, ErrStorage
+	}
+	if err := storeValidator(cache, validator); err != nil {
+		log.Trace("unstake", "store validator failed", err)
+		return nil, ErrStorage
+	}
+	if err := EmitUnstaked(s, common.BytesToHash(delegator.Bytes()), common.BytesToHash(validator.Address.Bytes()), amount,
+		unbonding.CompleteHeight); err != nil {
+		log.Trace("unstake", "emit event log failed", err)
+		return nil, ErrEmitLog
+	}
+	return &MethodUnstakeOutput{Result: true}, nil
+}
+
+// Withdraw transfers the completed unbondings and all rewards to tx origin.
+func (handler) Withdraw(s *native.NativeContract, input *MethodWithdrawInput) (*MethodWithdrawOutput, error) {
+	height := s.ContractRef().BlockHeight().Uint64()
+	cache := s.GetCacheDB()
+
+	delegator, err := checkOrigin(s, false)
+	if err != nil {
+		log.Trace("withdraw", "check origin failed", err)
+		return nil, err
+	}
+	unbondings, err := getUnbondings(cache, delegator)
+	if err != nil {
+		log.Trace("withdraw", "get unbondings failed", err)
+		return nil, ErrStorage
+	}
+
+	principal := new(big.Int)
+	pending := make([]*Unbonding, 0, len(unbondings))
+	for _, unbonding := range unbondings {
+		if unbonding.CompleteHeight <= height {
+			principal.Add(principal, unbonding.Amount)
+		} else {
+			pending = append(pending, unbonding)
+		}
+	}
+	rewards := getRewards(cache, delegator)
+	total := new(big.Int).Add(principal, rewards)
+	if total.Sign() == 0 {
+		return nil, ErrNothingToWithdraw
+	}
+	if err := storeUnbondings(cache, delegator, pending); err != nil {
+		log.Trace("withdraw", "store unbondings failed", err)
+		return nil, ErrStorage
+	}
+	clearRewards(cache, delegator)
+
+	s.StateDB().SubBalance(this, total)
+	s.StateDB().AddBalance(delegator, total)
+	if err := EmitWithdrawn(s, common.BytesToHash(delegator.Bytes()), principal, rewards); err != nil {
+		log.Trace("withdraw", "emit event log failed", err)
+		return nil, ErrEmitLog
+	}
+	return &MethodWithdrawOutput{Result: true}, nil
+}
+
+func (handler) GetValidatorJson(s *native.NativeContract, input *MethodGetValidatorJsonInput) (*MethodGetValidatorJsonOutput, error) {
+	validator, err := getValidator(s.GetCacheDB(), input.Validator)
+	if err != nil {
+		return nil, ErrStorage
+	} else if validator == nil {
+		return nil, ErrValidatorNotExist
+	}
+	enc, err := json.Marshal(validator)
+	if err != nil {
+		return nil, err
+	}
+	return &MethodGetValidatorJsonOutput{Result: string(enc)}, nil
+}
+
+func (handler) GetDelegationJson(s *native.NativeContract, input *MethodGetDelegationJsonInput) (*MethodGetDelegationJsonOutput, error) {
+	cache := s.GetCacheDB()
+	validator, err := getValidator(cache, input.Validator)
+	if err != nil {
+		return nil, ErrStorage
+	} else if validator == nil {
+		return nil, ErrValidatorNotExist
+	}
+	delegation, err := getDelegation(cache, input.Delegator, input.Validator)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	enc, err := json.Marshal(&struct {
+		Amount  *big.Int `json:"amount"`
+		Pending *big.Int `json:"pendingRewards"`
+	}{delegation.Amount, delegation.pending(validator)})
+	if err != nil {
+		return nil, err
+	}
+	return &MethodGetDelegationJsonOutput{Result: string(enc)}, nil
+}
+
+func (handler) GetUnbondingJson(s *native.NativeContract, input *MethodGetUnbondingJsonInput) (*MethodGetUnbondingJsonOutput, error) {
+	list, err := getUnbondings(s.GetCacheDB(), input.Delegator)
+	if err != nil {
+		return nil, ErrStorage
+	}
+	if list == nil {
+		list = []*Unbonding{}
+	}
+	enc, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	return &MethodGetUnbondingJsonOutput{Result: string(enc)}, nil
+}
+
+// GetRewards returns the withdrawable rewards of account, includes the commission and settled
+// delegation rewards, the pending rewards of delegations are excluded.
+func (handler) GetRewards(s *native.NativeContract, input *MethodGetRewardsInput) (*MethodGetRewardsOutput, error) {
+	return &MethodGetRewardsOutput{Result: getRewards(s.GetCacheDB(), input.Account)}, nil
+}
+
+func (handler) GetValidatorRankingJson(s *native.NativeContract, input *MethodGetValidatorRankingJsonInput) (*MethodGetValidatorRankingJsonOutput, error) {
+	ranking, err := ValidatorRanking(s.StateDB())
+	if err != nil {
+		return nil, ErrStorage
+	}
+	enc, err := json.Marshal(ranking)
+	if err != nil {
+		return nil, err
+	}
+	return &MethodGetValidatorRankingJsonOutput{Result: string(enc)}, nil
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testSupplyGas uint64 = 100000000000000000
+	testKeys      []*ecdsa.PrivateKey
+	testAddrs     []common.Address
+	testBalance   = ether(1000000)
+)
+
+func TestMain(m *testing.M) {
+	InitStaking()
+	for i := 0; i < 6; i++ {
+		key, _ := crypto.GenerateKey()
+		testKeys = append(testKeys, key)
+		testAddrs = append(testAddrs, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	os.Exit(m.Run())
+}
+
+func ether(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func newTestStateDB() *state.StateDB {
+	db := utils.NewTestStateDB()
+	for _, addr := range testAddrs {
+		db.AddBalance(addr, testBalance)
+	}
+	return db
+}
+
+// call invokes the contract as evm does, the value is transferred to the contract before the native call
+// and reverted together with the failed call.
+func call(db *state.StateDB, origin common.Address, height uint64, payload []byte, value *big.Int) ([]byte, error) {
+	snapshot := db.Snapshot()
+	if value != nil {
+		db.SubBalance(origin, value)
+		db.AddBalance(this, value)
+	}
+	ref := native.NewContractRef(db, origin, origin, new(big.Int).SetUint64(height), common.EmptyHash, testSupplyGas, nil)
+	ref.SetValue(value)
+	result, _, err := ref.NativeCall(origin, this, payload)
+	if err != nil {
+		db.RevertToSnapshot(snapshot)
+	}
+	return result, err
+}
+
+func register(db *state.StateDB, index int, commission uint64, selfStake *big.Int) error {
+	pubKey := hexutil.Encode(crypto.CompressPubkey(&testKeys[index].PublicKey))
+	payload, _ := (&MethodRegisterValidatorInput{PubKey: pubKey, Commission: commission}).Encode()
+	_, err := call(db, testAddrs[index], 1, payload, selfStake)
+	return err
+}
+
+func stakeCall(db *state.StateDB, method string, delegator, validator common.Address, height uint64, amount *big.Int) error {
+	if method == MethodStake {
+		payload, _ := (&MethodStakeInput{Validator: validator}).Encode()
+		_, err := call(db, delegator, height, payload, amount)
+		return err
+	}
+	payload, _ := (&MethodUnstakeInput{Validator: validator, Amount: amount}).Encode()
+	_, err := call(db, delegator, height, payload, nil)
+	return err
+}
+
+func rewards(t *testing.T, db *state.StateDB, addr common.Address) *big.Int {
+	payload, _ := (&MethodGetRewardsInput{Account: addr}).Encode()
+	result, err := call(db, addr, 1, payload, nil)
+	assert.NoError(t, err)
+	output := new(MethodGetRewardsOutput)
+	assert.NoError(t, output.Decode(result))
+	return output.Result
+}
+
+func TestRegisterValidator(t *testing.T) {
+	db := newTestStateDB()
+	validator := testAddrs[0]
+
+	// public key should match tx origin
+	pubKey := hexutil.Encode(crypto.CompressPubkey(&testKeys[1].PublicKey))
+	payload, _ := (&MethodRegisterValidatorInput{PubKey: pubKey, Commission: 100}).Encode()
+	_, err := call(db, validator, 1, payload, MinSelfStake)
+	assert.Equal(t, ErrInvalidPubKey, err)
+
+	assert.Equal(t, ErrInvalidCommission, register(db, 0, CommissionBase+1, MinSelfStake))
+	assert.Equal(t, ErrSelfStakeTooLow, register(db, 0, 100, new(big.Int).Sub(MinSelfStake, common.Big1)))
+	assert.NoError(t, register(db, 0, 100, MinSelfStake))
+	assert.Equal(t, ErrValidatorExist, register(db, 0, 100, MinSelfStake))
+
+	assert.Equal(t, new(big.Int).Sub(testBalance, MinSelfStake), db.GetBalance(validator))
+	assert.Equal(t, MinSelfStake, db.GetBalance(this))
+
+	payload, _ = (&MethodGetValidatorJsonInput{Validator: validator}).Encode()
+	result, err := call(db, validator, 1, payload, nil)
+	assert.NoError(t, err)
+	output := new(MethodGetValidatorJsonOutput)
+	assert.NoError(t, output.Decode(result))
+	got := new(Validator)
+	assert.NoError(t, json.Unmarshal([]byte(output.Result), got))
+	assert.Equal(t, validator, got.Address)
+	assert.Equal(t, uint64(100), got.Commission)
+	assert.Equal(t, MinSelfStake, got.TotalStake)
+
+	payload, _ = (&MethodUpdateCommissionInput{Commission: 500}).Encode()
+	_, err = call(db, validator, 2, payload, nil)
+	assert.NoError(t, err)
+	_, err = call(db, testAddrs[1], 2, payload, nil)
+	assert.Equal(t, ErrValidatorNotExist, err)
+	v, _ := getValidator((*state.CacheDB)(db), validator)
+	assert.Equal(t, uint64(500), v.Commission)
+}
+
+func TestDistributeRewards(t *testing.T) {
+	db := newTestStateDB()
+	validator, delegator, stranger := testAddrs[0], testAddrs[1], testAddrs[2]
+
+	// 10% commission, delegator holds 3/4 of total stake
+	assert.NoError(t, register(db, 0, 1000, ether(1000)))
+	assert.NoError(t, stakeCall(db, MethodStake, delegator, validator, 2, ether(3000)))
+	assert.Equal(t, ErrInvalidAmount, stakeCall(db, MethodStake, delegator, validator, 2, common.Big0))
+	assert.Equal(t, ErrValidatorNotExist, stakeCall(db, MethodStake, delegator, stranger, 2, ether(1)))
+
+	// duplicate and non-staking committers are ignored
+	balance := db.GetBalance(this)
+	assert.NoError(t, DistributeRewards(db, []common.Address{validator, validator, stranger}, ether(100)))
+	assert.Equal(t, new(big.Int).Add(balance, ether(100)), db.GetBalance(this))
+
+	cache := (*state.CacheDB)(db)
+	v, _ := getValidator(cache, validator)
+	selfDelegation, _ := getDelegation(cache, validator, validator)
+	delegation, _ := getDelegation(cache, delegator, validator)
+	assert.Equal(t, ether(10), rewards(t, db, validator))
+	assert.Equal(t, new(big.Int).Div(ether(45), big.NewInt(2)), selfDelegation.pending(v))
+	assert.Equal(t, new(big.Int).Div(ether(135), big.NewInt(2)), delegation.pending(v))
+
+	// stake more settles the pending rewards
+	assert.NoError(t, stakeCall(db, MethodStake, delegator, validator, 3, ether(1000)))
+	assert.Equal(t, new(big.Int).Div(ether(135), big.NewInt(2)), rewards(t, db, delegator))
+	delegation, _ = getDelegation(cache, delegator, validator)
+	v, _ = getValidator(cache, validator)
+	assert.Equal(t, 0, delegation.pending(v).Sign())
+
+	// no eligible committers, nothing minted
+	balance = db.GetBalance(this)
+	assert.NoError(t, DistributeRewards(db, []common.Address{stranger}, ether(100)))
+	assert.Equal(t, balance, db.GetBalance(this))
+}
+
+func TestUnstakeAndWithdraw(t *testing.T) {
+	db := newTestStateDB()
+	validator, delegator := testAddrs[0], testAddrs[1]
+
+	assert.NoError(t, register(db, 0, 0, ether(2000)))
+	assert.NoError(t, stakeCall(db, MethodStake, delegator, validator, 2, ether(2000)))
+	assert.NoError(t, DistributeRewards(db, []common.Address{validator}, ether(40)))
+
+	// validator should keep the minimum self stake or quit
+	assert.Equal(t, ErrSelfStakeTooLow, stakeCall(db, MethodUnstake, validator, validator, 10, ether(1500)))
+	assert.Equal(t, ErrInsufficientStake, stakeCall(db, MethodUnstake, delegator, validator, 10, ether(2001)))
+	assert.NoError(t, stakeCall(db, MethodUnstake, delegator, validator, 10, ether(500)))
+
+	// only the rewards are withdrawable before unbonding completed, and withdraw accepts no value
+	payload, _ := new(MethodWithdrawInput).Encode()
+	_, err := call(db, delegator, 11, payload, ether(1))
+	assert.Equal(t, ErrNotPayable, err)
+	_, err = call(db, delegator, 11, payload, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(new(big.Int).Sub(testBalance, ether(2000)), ether(20)), db.GetBalance(delegator))
+	_, err = call(db, delegator, 12, payload, nil)
+	assert.Equal(t, ErrNothingToWithdraw, err)
+
+	_, err = call(db, delegator, 10+UnbondingPeriod, payload, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(new(big.Int).Sub(testBalance, ether(1500)), ether(20)), db.GetBalance(delegator))
+	list, _ := getUnbondings((*state.CacheDB)(db), delegator)
+	assert.Equal(t, 0, len(list))
+
+	// quit validator is not delegatable and not rewarded
+	assert.NoError(t, stakeCall(db, MethodUnstake, validator, validator, 20, ether(2000)))
+	assert.Equal(t, ErrValidatorInactive, stakeCall(db, MethodStake, delegator, validator, 21, ether(1)))
+	balance := db.GetBalance(this)
+	assert.NoError(t, DistributeRewards(db, []common.Address{validator}, ether(40)))
+	assert.Equal(t, balance, db.GetBalance(this))
+}
+
+func TestValidatorRanking(t *testing.T) {
+	db := newTestStateDB()
+	assert.NoError(t, register(db, 0, 0, ether(1000)))
+	assert.NoError(t, register(db, 1, 0, ether(3000)))
+	assert.NoError(t, register(db, 2, 0, ether(1000)))
+	assert.NoError(t, register(db, 3, 0, ether(1000)))
+	assert.NoError(t, stakeCall(db, MethodStake, testAddrs[4], testAddrs[0], 2, ether(1000)))
+	assert.NoError(t, stakeCall(db, MethodUnstake, testAddrs[3], testAddrs[3], 2, ether(1000)))
+
+	ranking, err := ValidatorRanking(db)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(ranking))
+	assert.Equal(t, testAddrs[1], ranking[0].Address)
+	assert.Equal(t, testAddrs[0], ranking[1].Address)
+	assert.Equal(t, testAddrs[2], ranking[2].Address)
+}
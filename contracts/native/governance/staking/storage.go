@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// storage key prefix
+const (
+	SKP_VALIDATOR  = "st_validator"
+	SKP_VALIDATORS = "st_validators"
+	SKP_DELEGATION = "st_delegation"
+	SKP_UNBONDING  = "st_unbonding"
+	SKP_REWARDS    = "st_rewards"
+)
+
+func validatorKey(validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_VALIDATOR), validator.Bytes())
+}
+
+func validatorsKey() []byte {
+	return utils.ConcatKey(this, []byte(SKP_VALIDATORS))
+}
+
+func delegationKey(delegator, validator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_DELEGATION), delegator.Bytes(), validator.Bytes())
+}
+
+func unbondingKey(delegator common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_UNBONDING), delegator.Bytes())
+}
+
+func rewardsKey(account common.Address) []byte {
+	return utils.ConcatKey(this, []byte(SKP_REWARDS), account.Bytes())
+}
+
+func getValidator(db *state.CacheDB, addr common.Address) (*Validator, error) {
+	value, err := db.Get(validatorKey(addr))
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	validator := new(Validator)
+	if err := rlp.DecodeBytes(value, validator); err != nil {
+		return nil, err
+	}
+	return validator, nil
+}
+
+func storeValidator(db *state.CacheDB, validator *Validator) error {
+	value, err := rlp.EncodeToBytes(validator)
+	if err != nil {
+		return err
+	}
+	db.Put(validatorKey(validator.Address), value)
+	return nil
+}
+
+// getValidatorList returns all registered validators in registration order.
+func getValidatorList(db *state.CacheDB) ([]common.Address, error) {
+	value, err := db.Get(validatorsKey())
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	list := new(AddressList)
+	if err := rlp.DecodeBytes(value, list); err != nil {
+		return nil, err
+	}
+	return list.List, nil
+}
+
+func appendValidatorList(db *state.CacheDB, addr common.Address) error {
+	list, err := getValidatorList(db)
+	if err != nil {
+		return err
+	}
+	value, err := rlp.EncodeToBytes(&AddressList{List: append(list, addr)})
+	if err != nil {
+		return err
+	}
+	db.Put(validatorsKey(), value)
+	return nil
+}
+
+func getDelegation(db *state.CacheDB, delegator, validator common.Address) (*Delegation, error) {
+	value, err := db.Get(delegationKey(delegator, validator))
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return newDelegation(), nil
+	}
+	delegation := new(Delegation)
+	if err := rlp.DecodeBytes(value, delegation); err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+func storeDelegation(db *state.CacheDB, delegator, validator common.Address, delegation *Delegation) error {
+	if delegation.Amount.Sign() == 0 {
+		db.Delete(delegationKey(delegator, validator))
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(delegation)
+	if err != nil {
+		return err
+	}
+	db.Put(delegationKey(delegator, validator), value)
+	return nil
+}
+
+func getUnbondings(db *state.CacheDB, delegator common.Address) ([]*Unbonding, error) {
+	value, err := db.Get(unbondingKey(delegator))
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	list := new(UnbondingList)
+	if err := rlp.DecodeBytes(value, list); err != nil {
+		return nil, err
+	}
+	return list.List, nil
+}
+
+func storeUnbondings(db *state.CacheDB, delegator common.Address, list []*Unbonding) error {
+	if len(list) == 0 {
+		db.Delete(unbondingKey(delegator))
+		return nil
+	}
+	value, err := rlp.EncodeToBytes(&UnbondingList{List: list})
+	if err != nil {
+		return err
+	}
+	db.Put(unbondingKey(delegator), value)
+	return nil
+}
+
+func getRewards(db *state.CacheDB, account common.Address) *big.Int {
+	value, _ := db.Get(rewardsKey(account))
+	return new(big.Int).SetBytes(value)
+}
+
+func addRewards(db *state.CacheDB, account common.Address, amount *big.Int) {
+	if amount.Sign() <= 0 {
+		return
+	}
+	db.Put(rewardsKey(account), new(big.Int).Add(getRewards(db, account), amount).Bytes())
+}
+
+func clearRewards(db *state.CacheDB, account common.Address) {
+	db.Delete(rewardsKey(account))
+}
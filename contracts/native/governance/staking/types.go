@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// MinSelfStake is the least stake of validator itself to be elected and rewarded
+	MinSelfStake = new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))
+
+	// rewardPrecision scales the accumulated reward per stake unit
+	rewardPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)
+)
+
+const (
+	// Commission rate is denoted in basis points
+	CommissionBase uint64 = 10000
+	// Unbonding stake is locked for as long as an epoch lasts by default, so that it keeps accountable
+	// for misbehaviour in the epoch it staked.
+	UnbondingPeriod uint64 = 86400
+	// Every account can hold at most 16 unbonding entries at the same time
+	MaxUnbondingEntries int = 16
+)
+
+// Validator is the staking candidate, the total stake consists of the self stake and delegations.
+type Validator struct {
+	Address        common.Address `json:"address"`
+	PubKey         string         `json:"pubKey"`
+	Commission     uint64         `json:"commission"`
+	SelfStake      *big.Int       `json:"selfStake"`
+	TotalStake     *big.Int       `json:"totalStake"`
+	RewardPerStake *big.Int       `json:"rewardPerStake"` // accumulated delegators reward per stake unit, scaled by rewardPrecision
+	RegisterHeight uint64         `json:"registerHeight"`
+}
+
+// Active validator holds enough self stake to be elected and rewarded.
+func (v *Validator) Active() bool {
+	return v.SelfStake.Cmp(MinSelfStake) >= 0
+}
+
+// Delegation is the stake of delegator to validator, the validator self stake is the delegation to itself.
+type Delegation struct {
+	Amount     *big.Int `json:"amount"`
+	RewardDebt *big.Int `json:"rewardDebt"` // delegator reward settled until the validator's current reward per stake
+}
+
+func newDelegation() *Delegation {
+	return &Delegation{Amount: new(big.Int), RewardDebt: new(big.Int)}
+}
+
+// accumulated returns the reward of delegation accumulated since the validator registered.
+func (d *Delegation) accumulated(v *Validator) *big.Int {
+	acc := new(big.Int).Mul(d.Amount, v.RewardPerStake)
+	return acc.Div(acc, rewardPrecision)
+}
+
+// pending returns the reward of delegation not settled yet.
+func (d *Delegation) pending(v *Validator) *big.Int {
+	return new(big.Int).Sub(d.accumulated(v), d.RewardDebt)
+}
+
+// Unbonding is the unstaked amount locked until the complete height.
+type Unbonding struct {
+	Validator      common.Address `json:"validator"`
+	Amount         *big.Int       `json:"amount"`
+	CompleteHeight uint64         `json:"completeHeight"`
+}
+
+type UnbondingList struct {
+	List []*Unbonding
+}
+
+type AddressList struct {
+	List []common.Address
+}
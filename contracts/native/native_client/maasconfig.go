@@ -127,3 +127,69 @@ func IsGasUser(state *state.StateDB, address *common.Address) bool {
 
 	return output.Success
 }
+
+// CheckTransfer applies the blacklist and gas manager rules of maas_config to the transfer, `to` is nil
+// for contract creation.
+func CheckTransfer(state *state.StateDB, from common.Address, to *common.Address, value *big.Int) error {
+	if IsBlocked(state, &from) || IsBlocked(state, to) {
+		return ErrAccountBlocked
+	}
+	if value != nil && value.Sign() > 0 {
+		if IsGasManageEnable(state) && !IsGasManager(state, &from) && !IsGasManager(state, to) && !IsGasUser(state, to) {
+			return ErrNotGasManager
+		}
+	}
+	return nil
+}
+
+func IsNodeWhiteEnabled(state *state.StateDB) bool {
+	caller := common.EmptyAddress
+	ref := native.NewContractRef(state, caller, caller, big.NewInt(-1), common.EmptyHash, 0, nil)
+
+	payload, err := utils.PackMethod(maas_config.ABI, maas_config.MethodIsNodeWhiteEnabled)
+	if err != nil {
+		log.Error("[PackMethod]", "pack `IsNodeWhiteEnabled` input failed", err)
+		return false
+	}
+	enc, _, err := ref.NativeCall(caller, utils.MaasConfigContractAddress, payload)
+	if err != nil {
+		return false
+	}
+	output := new(maas_config.MethodBoolOutput)
+	if err := output.Decode(enc, maas_config.MethodIsNodeWhiteEnabled); err != nil {
+		log.Error("[native call]", "unpack `IsNodeWhiteEnabled` output failed", err)
+		return false
+	}
+
+	return output.Success
+}
+
+// GetNodeWhitelist reads the whole node whitelist of maas_config page by page.
+func GetNodeWhitelist(state *state.StateDB) []common.Address {
+	caller := common.EmptyAddress
+	ref := native.NewContractRef(state, caller, caller, big.NewInt(-1), common.EmptyHash, 0, nil)
+
+	list := make([]common.Address, 0)
+	limit := new(big.Int).SetUint64(maas_config.MaxListPageLimit)
+	for {
+		input := &maas_config.MethodListPageInput{Start: big.NewInt(int64(len(list))), Limit: limit}
+		payload, err := input.Encode(maas_config.MethodGetNodeWhitelistPage)
+		if err != nil {
+			log.Error("[PackMethod]", "pack `GetNodeWhitelistPage` input failed", err)
+			return list
+		}
+		enc, _, err := ref.NativeCall(caller, utils.MaasConfigContractAddress, payload)
+		if err != nil {
+			return list
+		}
+		output := new(maas_config.MethodListPageOutput)
+		if err := output.Decode(enc, maas_config.MethodGetNodeWhitelistPage); err != nil {
+			log.Error("[native call]", "unpack `GetNodeWhitelistPage` output failed", err)
+			return list
+		}
+		list = append(list, output.List...)
+		if len(output.List) == 0 || uint64(len(list)) >= output.Total.Uint64() {
+			return list
+		}
+	}
+}
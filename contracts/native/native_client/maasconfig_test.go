@@ -0,0 +1,105 @@
+// Copyright 2016 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native_client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTransfer(t *testing.T) {
+	maas_config.InitMaasConfig()
+
+	db := rawdb.NewMemoryDatabase()
+	sdb, _ := state.New(common.Hash{}, state.NewDatabase(db), nil)
+
+	var (
+		owner   = common.HexToAddress("0x01")
+		blocked = common.HexToAddress("0x02")
+		manager = common.HexToAddress("0x03")
+		user    = common.HexToAddress("0x04")
+		other   = common.HexToAddress("0x05")
+	)
+	call := func(payload []byte, err error) {
+		assert.NoError(t, err)
+		ref := native.NewContractRef(sdb, owner, owner, big.NewInt(1), common.EmptyHash, 100000000, nil)
+		_, _, err = ref.NativeCall(owner, utils.MaasConfigContractAddress, payload)
+		assert.NoError(t, err)
+	}
+	call((&maas_config.MethodChangeOwnerInput{Addr: owner}).Encode())
+	call((&maas_config.MethodBlockAccountInput{Addr: blocked, DoBlock: true}).Encode())
+	call((&maas_config.MethodSetGasManagerInput{Addr: manager, IsManager: true}).Encode())
+	call((&maas_config.MethodSetGasUsersInput{Addrs: []common.Address{user}, AddOrRemove: true}).Encode())
+
+	one := big.NewInt(1)
+	assert.Equal(t, ErrAccountBlocked, CheckTransfer(sdb, blocked, &other, common.Big0))
+	assert.Equal(t, ErrAccountBlocked, CheckTransfer(sdb, other, &blocked, common.Big0))
+	assert.NoError(t, CheckTransfer(sdb, other, &other, one))
+	assert.NoError(t, CheckTransfer(sdb, other, nil, one))
+
+	call((&maas_config.MethodEnableGasManageInput{DoEnable: true}).Encode())
+	assert.Equal(t, ErrNotGasManager, CheckTransfer(sdb, other, &other, one))
+	assert.Equal(t, ErrNotGasManager, CheckTransfer(sdb, other, nil, one))
+	assert.NoError(t, CheckTransfer(sdb, other, &other, common.Big0))
+	assert.NoError(t, CheckTransfer(sdb, manager, &other, one))
+	assert.NoError(t, CheckTransfer(sdb, other, &manager, one))
+	assert.NoError(t, CheckTransfer(sdb, other, &user, one))
+}
+
+func TestNodeWhitelist(t *testing.T) {
+	maas_config.InitMaasConfig()
+
+	db := rawdb.NewMemoryDatabase()
+	sdb, _ := state.New(common.Hash{}, state.NewDatabase(db), nil)
+
+	owner := common.HexToAddress("0x01")
+	call := func(payload []byte, err error) {
+		assert.NoError(t, err)
+		ref := native.NewContractRef(sdb, owner, owner, big.NewInt(1), common.EmptyHash, 100000000, nil)
+		_, _, err = ref.NativeCall(owner, utils.MaasConfigContractAddress, payload)
+		assert.NoError(t, err)
+	}
+	call((&maas_config.MethodChangeOwnerInput{Addr: owner}).Encode())
+	assert.False(t, IsNodeWhiteEnabled(sdb))
+	assert.Empty(t, GetNodeWhitelist(sdb))
+
+	nodes := make([]common.Address, maas_config.MaxListPageLimit+10)
+	for i := range nodes {
+		nodes[i] = common.BigToAddress(big.NewInt(int64(i + 100)))
+	}
+	epochValidators := maas_config.EpochValidators
+	maas_config.EpochValidators = func(s *native.NativeContract) ([]common.Address, error) {
+		return nodes[:1], nil
+	}
+	defer func() { maas_config.EpochValidators = epochValidators }()
+
+	call((&maas_config.MethodSetNodeWhitelistInput{Addrs: nodes, AddOrRemove: true}).Encode())
+	call((&maas_config.MethodEnableNodeWhiteInput{DoEnable: true}).Encode())
+	assert.True(t, IsNodeWhiteEnabled(sdb))
+	assert.Equal(t, nodes, GetNodeWhitelist(sdb))
+
+	call((&maas_config.MethodSetNodeWhitelistInput{Addrs: nodes[1:], AddOrRemove: false}).Encode())
+	assert.Equal(t, nodes[:1], GetNodeWhitelist(sdb))
+}
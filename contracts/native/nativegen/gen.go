@@ -0,0 +1,252 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package nativegen generates the go glue of native contracts from the contract abi and gas
+// annotations, including abi constants, typed input/output codecs, event emitters, the handler
+// interface to be implemented and the registration against native.NativeContract. It also
+// generates the go_abi bindings used by the clients of native contracts.
+package nativegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// GasTable maps the method name to the gas usage of native contract.
+type GasTable map[string]uint64
+
+// ParseGasTable decodes the gas annotation file, which is a json object of method name and gas usage.
+func ParseGasTable(data []byte) (GasTable, error) {
+	gas := make(GasTable)
+	if err := json.Unmarshal(data, &gas); err != nil {
+		return nil, fmt.Errorf("invalid gas annotation: %v", err)
+	}
+	return gas, nil
+}
+
+type tmplArg struct {
+	Name    string // abi argument name
+	Field   string // go field name
+	Param   string // go parameter name
+	Type    string // go type
+	Indexed bool
+}
+
+type tmplMethod struct {
+	Name     string // abi method name
+	Camel    string
+	Const    bool
+	Gas      uint64
+	Inputs   []*tmplArg
+	Outputs  []*tmplArg
+	Original abi.Method
+}
+
+type tmplEvent struct {
+	Name   string
+	Camel  string
+	Inputs []*tmplArg
+}
+
+type tmplData struct {
+	Package  string
+	Type     string
+	InputABI string
+	Methods  []*tmplMethod
+	Events   []*tmplEvent
+}
+
+// Generate generates the go source of native contract named by kind in package pkg.
+func Generate(kind, pkg, abiJSON string, gas GasTable) (string, error) {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return "", err
+	}
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, []byte(abiJSON)); err != nil {
+		return "", err
+	}
+	data := &tmplData{
+		Package:  pkg,
+		Type:     abi.ToCamelCase(kind),
+		InputABI: compacted.String(),
+	}
+
+	// every method should be annotated with gas, and every annotation should match a method
+	for name := range gas {
+		if _, ok := parsed.Methods[name]; !ok {
+			return "", fmt.Errorf("gas annotated for unknown method %s", name)
+		}
+	}
+	for _, name := range sortedKeys(parsed.Methods) {
+		original := parsed.Methods[name]
+		usage, ok := gas[name]
+		if !ok {
+			return "", fmt.Errorf("gas of method %s not annotated", name)
+		}
+		if usage > 0 && usage < nativeFailedTxGasUsage {
+			return "", fmt.Errorf("gas of method %s should be 0 or above %d", name, nativeFailedTxGasUsage)
+		}
+		inputs, err := bindArgs(name, original.Inputs, "Arg")
+		if err != nil {
+			return "", err
+		}
+		outputs, err := bindArgs(name, original.Outputs, "Result")
+		if err != nil {
+			return "", err
+		}
+		data.Methods = append(data.Methods, &tmplMethod{
+			Name:     name,
+			Camel:    abi.ToCamelCase(name),
+			Const:    original.IsConstant(),
+			Gas:      usage,
+			Inputs:   inputs,
+			Outputs:  outputs,
+			Original: original,
+		})
+	}
+	for _, name := range sortedKeys(parsed.Events) {
+		original := parsed.Events[name]
+		inputs, err := bindArgs(name, original.Inputs, "Arg")
+		if err != nil {
+			return "", err
+		}
+		for i, arg := range original.Inputs {
+			if arg.Indexed {
+				inputs[i].Type = "common.Hash"
+				inputs[i].Indexed = true
+			}
+		}
+		data.Events = append(data.Events, &tmplEvent{Name: name, Camel: abi.ToCamelCase(name), Inputs: inputs})
+	}
+
+	buffer := new(bytes.Buffer)
+	tmpl := template.Must(template.New("").Funcs(template.FuncMap{
+		"argList":    argList,
+		"paramList":  paramList,
+		"paramNames": paramNames,
+	}).Parse(tmplSource))
+	if err := tmpl.Execute(buffer, data); err != nil {
+		return "", err
+	}
+	code, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("%v\n%s", err, buffer)
+	}
+	return string(code), nil
+}
+
+// Binding generates the go_abi binding of native contract named by kind in package pkg, which is
+// the abigen binding with the method and event names.
+func Binding(kind, pkg, abiJSON string) (string, error) {
+	return bind.Bind([]string{kind}, []string{abiJSON}, []string{""}, nil, pkg, bind.LangGo, nil, nil)
+}
+
+// nativeFailedTxGasUsage keeps the same as native.FailedTxGasUsage, which is checked in Prepare.
+const nativeFailedTxGasUsage = 100
+
+// bindArgs converts abi arguments to go struct fields, unnamed argument is only allowed if it is
+// the single one, and named after the default field name.
+func bindArgs(name string, args abi.Arguments, defaultField string) ([]*tmplArg, error) {
+	list := make([]*tmplArg, 0, len(args))
+	for i, arg := range args {
+		if arg.Type.T == abi.TupleTy {
+			return nil, fmt.Errorf("%s: tuple argument %d not supported", name, i)
+		}
+		field := abi.ToCamelCase(arg.Name)
+		if arg.Name == "" {
+			if len(args) > 1 {
+				return nil, fmt.Errorf("%s: argument %d should be named", name, i)
+			}
+			field = defaultField
+		}
+		param := strings.ToLower(field[:1]) + field[1:]
+		if token.IsKeyword(param) {
+			param = "_" + param
+		}
+		list = append(list, &tmplArg{Name: arg.Name, Field: field, Param: param, Type: bindType(arg.Type)})
+	}
+	return list, nil
+}
+
+func bindType(typ abi.Type) string {
+	switch typ.T {
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", typ.Size)
+	case abi.SliceTy:
+		return "[]" + bindType(*typ.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]", typ.Size) + bindType(*typ.Elem)
+	default:
+		return typ.GetType().String()
+	}
+}
+
+// argList renders the struct fields prefixed, e.g. `m.Addr, m.DoBlock`.
+func argList(prefix string, args []*tmplArg) string {
+	list := make([]string, 0, len(args))
+	for _, arg := range args {
+		list = append(list, prefix+arg.Field)
+	}
+	return strings.Join(list, ", ")
+}
+
+// paramList renders the function parameters, e.g. `addr common.Hash, doBlock bool`.
+func paramList(args []*tmplArg) string {
+	list := make([]string, 0, len(args))
+	for _, arg := range args {
+		list = append(list, arg.Param+" "+arg.Type)
+	}
+	return strings.Join(list, ", ")
+}
+
+// paramNames renders the function parameter names, e.g. `addr, doBlock`.
+func paramNames(args []*tmplArg) string {
+	list := make([]string, 0, len(args))
+	for _, arg := range args {
+		list = append(list, arg.Param)
+	}
+	return strings.Join(list, ", ")
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch v := m.(type) {
+	case map[string]abi.Method:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	case map[string]abi.Event:
+		for k := range v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
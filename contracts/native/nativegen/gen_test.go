@@ -0,0 +1,282 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package nativegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/compiler"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+)
+
+// maasConfigGas annotates the maas config methods, mutations cost 30000 gas and queries are free.
+func maasConfigGas(t *testing.T) GasTable {
+	parsed, err := abi.JSON(strings.NewReader(maas_config.MaasConfigABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gas := make(GasTable)
+	for name, method := range parsed.Methods {
+		if !method.IsConstant() {
+			gas[name] = 30000
+		} else {
+			gas[name] = 0
+		}
+	}
+	return gas
+}
+
+// roundTripTest is compiled together with the generated binding to check the codecs and registration.
+const roundTripTest = `package maasgen
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+)
+
+type handler struct{ MaasConfigHandler }
+
+func (handler) GetBlacklistPage(s *native.NativeContract, input *MethodGetBlacklistPageInput) (*MethodGetBlacklistPageOutput, error) {
+	return &MethodGetBlacklistPageOutput{List: []common.Address{common.HexToAddress("0x01")}, Total: input.Limit}, nil
+}
+
+func TestRoundTrip(t *testing.T) {
+	InitABI()
+
+	input := &MethodBlockAccountInput{Addr: common.HexToAddress("0x02"), DoBlock: true}
+	payload, err := input.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := new(MethodBlockAccountInput)
+	if err := decoded.Decode(payload); err != nil || *decoded != *input {
+		t.Fatalf("input mismatch, got %v, err %v", decoded, err)
+	}
+
+	output := &MethodGetBlacklistPageOutput{List: []common.Address{common.HexToAddress("0x03")}, Total: big.NewInt(1)}
+	enc, err := output.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(MethodGetBlacklistPageOutput)
+	if err := got.Decode(enc); err != nil || got.List[0] != output.List[0] || got.Total.Cmp(output.Total) != 0 {
+		t.Fatalf("output mismatch, got %v, err %v", got, err)
+	}
+
+	empty := new(MethodNameInput)
+	if payload, err = empty.Encode(); err != nil || len(payload) != 4 {
+		t.Fatalf("unexpected payload %x, err %v", payload, err)
+	}
+	if err := empty.Decode(payload); err != nil {
+		t.Fatal(err)
+	}
+
+	if gasTable[MethodChangeOwner] != 30000 || EventBlockAccount != "BlockAccount" {
+		t.Fatal("unexpected constants")
+	}
+	var _ native.RegisterService = RegisterMaasConfigContract(handler{})
+}
+`
+
+func TestGenerate(t *testing.T) {
+	code, err := Generate("maas_config", "maasgen", maas_config.MaasConfigABI, maasConfigGas(t))
+	if err != nil {
+		t.Fatalf("failed to generate: %v", err)
+	}
+	for _, want := range []string{
+		"\"blockAccount\"",
+		"type MethodGetBlacklistPageOutput struct",
+		"type MaasConfigHandler interface",
+		"func RegisterMaasConfigContract(h MaasConfigHandler) native.RegisterService",
+		"func EmitBlockAccount(s *native.NativeContract, addr common.Hash, doBlock bool) error",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("generated code missing %q", want)
+		}
+	}
+
+	gocmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not available")
+	}
+	if err := os.MkdirAll("testdata", 0700); err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("testdata", "maasgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("testdata")
+	if err := ioutil.WriteFile(filepath.Join(dir, "maasgen.go"), []byte(code), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "maasgen_test.go"), []byte(roundTripTest), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.Command(gocmd, "test", "-count=1", "./"+filepath.ToSlash(dir))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("failed to run generated test: %v\n%s", err, out)
+	}
+}
+
+func TestGenerateGasMismatch(t *testing.T) {
+	gas := maasConfigGas(t)
+	delete(gas, "name")
+	if _, err := Generate("maas_config", "maasgen", maas_config.MaasConfigABI, gas); err == nil {
+		t.Fatal("expected error of method without gas annotation")
+	}
+	gas["name"] = 0
+	gas["unknown"] = 0
+	if _, err := Generate("maas_config", "maasgen", maas_config.MaasConfigABI, gas); err == nil {
+		t.Fatal("expected error of gas annotation without method")
+	}
+	delete(gas, "unknown")
+	gas["name"] = 10
+	if _, err := Generate("maas_config", "maasgen", maas_config.MaasConfigABI, gas); err == nil {
+		t.Fatal("expected error of gas below failed tx gas usage")
+	}
+}
+
+func TestGenerateUnnamedArguments(t *testing.T) {
+	abiJSON := `[{"inputs":[],"name":"pair","outputs":[{"name":"","type":"uint256"},{"name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
+	if _, err := Generate("pair", "pair", abiJSON, GasTable{"pair": 0}); err == nil {
+		t.Fatal("expected error of unnamed arguments")
+	}
+}
+
+// contracts lists the native contracts generated from the abi in solidity directory, the glue is
+// generated only if the gas of contract methods is annotated.
+var contracts = []struct {
+	name, kind, binding string
+}{
+	{"maas_config", "MaasConfig", "maas_config_abi"},
+	{"native_registry", "NativeRegistry", "native_registry_abi"},
+	{"node_manager", "INodeManager", "node_manager_abi"},
+	{"staking", "Staking", "staking_abi"},
+}
+
+// TestUpToDate checks that the go_abi bindings and native contract glue are regenerated after the
+// abi or gas annotation changed, and not modified manually.
+func TestUpToDate(t *testing.T) {
+	for _, tt := range contracts {
+		abiJSON, err := ioutil.ReadFile(filepath.Join("..", "solidity", tt.name+".abi"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		code, err := Binding(tt.kind, tt.binding, string(abiJSON))
+		if err != nil {
+			t.Fatalf("%s: failed to generate binding: %v", tt.name, err)
+		}
+		file, err := ioutil.ReadFile(filepath.Join("..", "go_abi", tt.binding, tt.binding+".go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != string(file) {
+			t.Errorf("%s: binding is out of date, regenerate it with nativegen --binding", tt.name)
+		}
+
+		gasJSON, err := ioutil.ReadFile(filepath.Join("..", "solidity", tt.name+"_gas.json"))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		gas, err := ParseGasTable(gasJSON)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if code, err = Generate(tt.kind, tt.name, string(abiJSON), gas); err != nil {
+			t.Fatalf("%s: failed to generate: %v", tt.name, err)
+		}
+		if file, err = ioutil.ReadFile(filepath.Join("..", "governance", tt.name, "abi_gen.go")); err != nil {
+			t.Fatal(err)
+		}
+		if code != string(file) {
+			t.Errorf("%s: native contract glue is out of date, regenerate it with nativegen --gas", tt.name)
+		}
+	}
+
+	// the hand-written maas config abi should be the same as the generated one
+	abiJSON, err := ioutil.ReadFile(filepath.Join("..", "solidity", "maas_config.abi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maas_config.MaasConfigABI != strings.TrimSpace(string(abiJSON)) {
+		t.Error("maas_config abi differs from solidity/maas_config.abi")
+	}
+}
+
+// TestSolidityUpToDate checks that the abi files are compiled from the solidity interfaces.
+func TestSolidityUpToDate(t *testing.T) {
+	if _, err := compiler.SolidityVersion(""); err != nil {
+		t.Skip("solc not available")
+	}
+	for _, tt := range contracts {
+		compiled, err := compiler.CompileSolidity("", filepath.Join("..", "solidity", tt.name+".sol"))
+		if err != nil {
+			t.Fatalf("%s: failed to compile: %v", tt.name, err)
+		}
+		if len(compiled) != 1 {
+			t.Fatalf("%s: expected single interface, got %d", tt.name, len(compiled))
+		}
+		abiJSON, err := ioutil.ReadFile(filepath.Join("..", "solidity", tt.name+".abi"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want, got []map[string]interface{}
+		if err := json.Unmarshal(abiJSON, &want); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		for _, contract := range compiled {
+			enc, err := json.Marshal(contract.Info.AbiDefinition)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(enc, &got); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if !reflect.DeepEqual(sortABI(got), sortABI(want)) {
+			t.Errorf("%s: abi is out of date, regenerate it with solc --abi", tt.name)
+		}
+	}
+}
+
+// sortABI sorts the abi entries by type and name, which are ordered differently among solc versions.
+func sortABI(entries []map[string]interface{}) []map[string]interface{} {
+	sort.Slice(entries, func(i, j int) bool {
+		ki := fmt.Sprint(entries[i]["type"], entries[i]["name"])
+		kj := fmt.Sprint(entries[j]["type"], entries[j]["name"])
+		return ki < kj
+	})
+	return entries
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package nativegen
+
+// tmplSource is the go source template of native contract glue code.
+const tmplSource = `// Code generated by nativegen - DO NOT EDIT.
+// This file is a generated native contract binding and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+
+const (
+	// abi
+	{{.Type}}ABI = {{printf "%q" .InputABI}}
+
+	// method name
+{{- range .Methods}}
+	Method{{.Camel}} = "{{.Name}}"
+{{- end}}
+
+	// event name
+{{- range .Events}}
+	Event{{.Camel}} = "{{.Name}}"
+{{- end}}
+)
+
+var (
+	ABI *abi.ABI
+
+	// gasTable maps the method name to gas usage
+	gasTable = map[string]uint64{
+{{- range .Methods}}
+		Method{{.Camel}}: {{.Gas}},
+{{- end}}
+	}
+)
+
+func InitABI() {
+	ab, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load abi json string: [%v]", err))
+	}
+	ABI = &ab
+}
+
+{{range .Methods}}
+// Method{{.Camel}}Input is the input of method {{.Original.Sig}}.
+type Method{{.Camel}}Input struct {
+{{- range .Inputs}}
+	{{.Field}} {{.Type}}
+{{- end}}
+}
+
+func (m *Method{{.Camel}}Input) Encode() ([]byte, error) {
+	return utils.PackMethod(ABI, Method{{.Camel}}{{if .Inputs}}, {{argList "m." .Inputs}}{{end}})
+}
+
+func (m *Method{{.Camel}}Input) Decode(payload []byte) error {
+	{{- if .Inputs}}
+	return utils.UnpackMethod(ABI, Method{{.Camel}}, m, payload)
+	{{- else}}
+	if len(payload) != 4 {
+		return fmt.Errorf("invalid payload")
+	}
+	return nil
+	{{- end}}
+}
+
+// Method{{.Camel}}Output is the output of method {{.Original.Sig}}.
+type Method{{.Camel}}Output struct {
+{{- range .Outputs}}
+	{{.Field}} {{.Type}}
+{{- end}}
+}
+
+func (m *Method{{.Camel}}Output) Encode() ([]byte, error) {
+	return utils.PackOutputs(ABI, Method{{.Camel}}{{if .Outputs}}, {{argList "m." .Outputs}}{{end}})
+}
+
+func (m *Method{{.Camel}}Output) Decode(payload []byte) error {
+	{{- if .Outputs}}
+	return utils.UnpackOutputs(ABI, Method{{.Camel}}, m, payload)
+	{{- else}}
+	return nil
+	{{- end}}
+}
+{{end}}
+
+// {{.Type}}Handler is the implementation of native contract methods, the input is decoded from
+// the context payload and the non-nil output is encoded as the call result.
+type {{.Type}}Handler interface {
+{{- range .Methods}}
+	{{.Camel}}(s *native.NativeContract, input *Method{{.Camel}}Input) (*Method{{.Camel}}Output, error)
+{{- end}}
+}
+
+// Register{{.Type}}Contract returns the register service of native contract implemented by h.
+func Register{{.Type}}Contract(h {{.Type}}Handler) native.RegisterService {
+	return func(s *native.NativeContract) {
+		s.Prepare(ABI, gasTable)
+{{range .Methods}}
+		s.Register(Method{{.Camel}}, func(s *native.NativeContract) ([]byte, error) {
+			input := new(Method{{.Camel}}Input)
+			if err := input.Decode(s.ContractRef().CurrentContext().Payload); err != nil {
+				log.Trace("{{.Camel}}", "decode input failed", err)
+				return utils.ByteFailed, err
+			}
+			output, err := h.{{.Camel}}(s, input)
+			if err != nil {
+				log.Trace("{{.Camel}}", "handle failed", err)
+				return utils.ByteFailed, err
+			}
+			return output.Encode()
+		})
+{{- end}}
+	}
+}
+{{range .Events}}
+// Emit{{.Camel}} emits event {{.Name}}, indexed arguments are passed as topic hash.
+func Emit{{.Camel}}(s *native.NativeContract{{if .Inputs}}, {{paramList .Inputs}}{{end}}) error {
+	return s.AddNotify(ABI, []string{Event{{.Camel}}}{{if .Inputs}}, {{paramNames .Inputs}}{{end}})
+}
+{{end}}
+`
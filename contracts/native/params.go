@@ -18,6 +18,8 @@
 package native
 
 import (
+	"errors"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 )
@@ -25,12 +27,25 @@ import (
 // FailedTxGasUsage tx's gas usage should not be greater than an minimum fixed value if it execute failed.
 const FailedTxGasUsage = uint64(100)
 
+// gas schedule of the metered native contract calls
+const (
+	NativeCallGas   uint64 = 2100 // base cost of invoking native contract method
+	InputByteGas    uint64 = 16   // cost per byte of input decoded
+	StorageReadGas  uint64 = 800  // cost per 32 bytes slot read through CacheDB
+	StorageWriteGas uint64 = 5000 // cost per 32 bytes slot written or cleared through CacheDB
+	LogGas          uint64 = 375  // base cost of event emitted
+	LogTopicGas     uint64 = 375  // cost per topic of event
+	LogDataGas      uint64 = 8    // cost per byte of event data
+)
+
+var ErrOutOfGas = errors.New("native contract out of gas")
+
 const (
 	NativeNodeManager = "node_manager"
 	NativeMaasConfig  = "maas_config"
+	NativeRegistry    = "native_registry"
+	NativeStaking     = "staking"
 	// native backup contracts
-	NativeExtra1 = "extra1"
-	NativeExtra2 = "extra2"
 	NativeExtra3 = "extra3"
 	NativeExtra4 = "extra4"
 	// NativeExtra5  = "extra5"
@@ -55,8 +70,8 @@ const (
 )
 
 var NativeContractAddrMap = map[string]common.Address{
-	NativeExtra1:      common.HexToAddress("0x4600691499997fCc224425ba5C93EebC57f3615b"),
-	NativeExtra2:      common.HexToAddress("0xb2799bDE6831449d73C1F22CE815f773D0CafCc5"),
+	NativeRegistry:    utils.NativeRegistryContractAddress,
+	NativeStaking:     utils.StakingContractAddress,
 	NativeExtra3:      common.HexToAddress("0x5747C05FF236F8d18BB21Bc02ecc389deF853cae"),
 	NativeExtra4:      common.HexToAddress("0x5E839898821dB2A2F0eC9F8aAE7D7053744DB051"),
 	NativeNodeManager: utils.NodeManagerContractAddress,
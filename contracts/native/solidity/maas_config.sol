@@ -8,20 +8,39 @@ interface IMaasConfig {
     function blockAccount(address addr, bool doBlock) external returns (bool);
     function isBlocked(address addr) external view returns (bool);
     function getBlacklist() external view returns (string memory);
+    function getBlacklistPage(uint256 start, uint256 limit) external view returns (address[] memory list, uint256 total);
     
     function enableGasManage(bool doEnable) external returns (bool);
     function isGasManageEnabled() external view returns (bool);
     function setGasManager(address addr, bool isManager) external returns (bool);
     function isGasManager(address addr) external view returns (bool);
     function getGasManagerList() external view returns (string memory);
+    function getGasManagerListPage(uint256 start, uint256 limit) external view returns (address[] memory list, uint256 total);
 
     function setGasUsers(address[] memory addrs, bool addOrRemove) external returns (bool);
     function isGasUser(address addr) external view returns (bool);
     function getGasUserList() external view returns (string memory);
+    function getGasUserListPage(uint256 start, uint256 limit) external view returns (address[] memory list, uint256 total);
+
+    function enableNodeWhite(bool doEnable) external returns (bool);
+    function isNodeWhiteEnabled() external view returns (bool);
+    function setNodeWhitelist(address[] memory addrs, bool addOrRemove) external returns (bool);
+    function isInNodeWhitelist(address addr) external view returns (bool);
+    function getNodeWhitelist() external view returns (string memory);
+    function getNodeWhitelistPage(uint256 start, uint256 limit) external view returns (address[] memory list, uint256 total);
     
     function setAdmins(address[] memory addrs, bool addOrRemove) external returns (bool);
     function isAdmin(address addr) external view returns (bool);
     function getAdminList() external view returns (string memory);
+    function getAdminListPage(uint256 start, uint256 limit) external view returns (address[] memory list, uint256 total);
+
+    function setGovernance(uint256 threshold, uint256 delay) external returns (bool);
+    function getGovernance() external view returns (uint256 threshold, uint256 delay);
+    function propose(bytes memory payload) external returns (uint256 id);
+    function approve(uint256 id) external returns (bool);
+    function cancel(uint256 id) external returns (bool);
+    function execute(uint256 id) external returns (bool);
+    function getProposal(uint256 id) external view returns (string memory);
     
     event ChangeOwner(address indexed oldOwner, address indexed newOwner);
     event BlockAccount(address indexed addr, bool doBlock);
@@ -29,4 +48,12 @@ interface IMaasConfig {
     event SetGasManager(address indexed addr, bool isManager);
     event SetGasUsers(address[] addrs, bool addOrRemove);
     event SetAdmins(address[] addrs, bool addOrRemove);
+    event EnableNodeWhite(bool doEnable);
+    event SetNodeWhitelist(address[] addrs, bool addOrRemove);
+    event SetGovernance(uint256 threshold, uint256 delay);
+    event ProposalCreated(uint256 indexed id, address indexed proposer, bytes payload);
+    event ProposalApproved(uint256 indexed id, address indexed approver, uint256 approvals);
+    event ProposalQueued(uint256 indexed id, uint256 eta);
+    event ProposalExecuted(uint256 indexed id);
+    event ProposalCancelled(uint256 indexed id, address indexed canceller);
 }
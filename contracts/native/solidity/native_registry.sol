@@ -0,0 +1,11 @@
+pragma solidity >=0.6.0 <0.9.0;
+
+interface INativeRegistry {
+    function name() external view returns (string memory);
+
+    function approveUpgrade(address contractAddr, uint64 version, uint64 activeHeight) external returns (bool);
+    function getActiveVersion(address contractAddr) external view returns (uint64);
+    function getActivationsJson(address contractAddr) external view returns (string memory);
+
+    event UpgradeApproved(address indexed contractAddr, uint64 version, uint64 activeHeight);
+}
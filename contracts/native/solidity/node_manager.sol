@@ -9,13 +9,28 @@ interface INodeManager {
     function getChangingEpoch() external view returns (bytes memory);
     function getEpochByID(uint64 epochID) external view returns (bytes memory);
     function proof(uint64 epochID) external view returns (bytes memory);
+    function reportEquivocation(bytes memory evidence) external returns (bool);
+    function getEquivocations(address offender) external view returns (bytes memory);
+    function registerCandidate(string memory pubKey, string memory name, string memory url) external returns (bool);
+    function updateCandidate(string memory name, string memory url) external returns (bool);
+    function unregisterCandidate() external returns (bool);
+    function unjail() external returns (bool);
+    function setBLSPublicKey(bytes memory blsPubKey, bytes memory blsProof) external returns (bool);
 
     function getEpochListJson(uint64 epochID) external view returns (string memory);
     function getCurrentEpochJson() external view returns (string memory);
     function getChangingEpochJson() external view returns (string memory);
+    function getCandidateJson(address candidate) external view returns (string memory);
+    function getCandidateListJson() external view returns (string memory);
     
     event Proposed(bytes epoch);
     event Voted(uint64 epochID, bytes epochHash, uint64 votedNumber, uint64 groupSize);
     event EpochChanged(bytes epoch, bytes nextEpoch);
     event ConsensusSigned(string method, bytes input, address signer, uint64 size);
+    event EquivocationReported(address offender, uint64 height, uint64 round, address reporter);
+    event CandidateRegistered(address candidate, string name, string url);
+    event CandidateUpdated(address candidate, string name, string url);
+    event CandidateUnregistered(address candidate);
+    event Unjailed(address validator);
+    event BLSPublicKeySet(address validator, bytes blsPubKey);
 }
\ No newline at end of file
@@ -0,0 +1,23 @@
+pragma solidity >=0.6.0 <0.9.0;
+
+interface IStaking {
+    function name() external view returns (string memory);
+
+    function registerValidator(string memory pubKey, uint64 commission) external payable returns (bool);
+    function updateCommission(uint64 commission) external returns (bool);
+    function stake(address validator) external payable returns (bool);
+    function unstake(address validator, uint256 amount) external returns (bool);
+    function withdraw() external returns (bool);
+
+    function getValidatorJson(address validator) external view returns (string memory);
+    function getDelegationJson(address delegator, address validator) external view returns (string memory);
+    function getUnbondingJson(address delegator) external view returns (string memory);
+    function getRewards(address account) external view returns (uint256);
+    function getValidatorRankingJson() external view returns (string memory);
+
+    event ValidatorRegistered(address indexed validator, string pubKey, uint64 commission);
+    event CommissionUpdated(address indexed validator, uint64 commission);
+    event Staked(address indexed delegator, address indexed validator, uint256 amount);
+    event Unstaked(address indexed delegator, address indexed validator, uint256 amount, uint64 completeHeight);
+    event Withdrawn(address indexed delegator, uint256 principal, uint256 rewards);
+}
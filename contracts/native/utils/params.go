@@ -25,6 +25,8 @@ var (
 )
 
 var (
-	NodeManagerContractAddress = common.HexToAddress("0xA4Bf827047a08510722B2d62e668a72FCCFa232C")
-	MaasConfigContractAddress  = common.HexToAddress("0xD62B67170A6bb645f1c59601FbC6766940ee12e5")
+	NodeManagerContractAddress    = common.HexToAddress("0xA4Bf827047a08510722B2d62e668a72FCCFa232C")
+	MaasConfigContractAddress     = common.HexToAddress("0xD62B67170A6bb645f1c59601FbC6766940ee12e5")
+	NativeRegistryContractAddress = common.HexToAddress("0x4600691499997fCc224425ba5C93EebC57f3615b")
+	StakingContractAddress        = common.HexToAddress("0xb2799bDE6831449d73C1F22CE815f773D0CafCc5")
 )
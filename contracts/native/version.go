@@ -0,0 +1,74 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package native
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+)
+
+// DefaultVersion denotes the implementation registered in `Contracts` at compile time, which is active
+// until an upgrade activated.
+const DefaultVersion uint64 = 0
+
+var (
+	// Versions holds the versioned implementations of native contracts built in binary, they are only
+	// dispatched after the activation approved by validators.
+	Versions = make(map[common.Address]map[uint64]RegisterService)
+
+	// ActiveVersion resolves the version of contract active at block height, it is supplied by the native
+	// registry contract. nil means all native contracts run with the default version.
+	ActiveVersion func(db *state.StateDB, contract common.Address, height *big.Int) uint64
+)
+
+// RegisterVersion binds the implementation to the version of contract, version should be greater than
+// the default version.
+func RegisterVersion(contract common.Address, version uint64, register RegisterService) {
+	if version == DefaultVersion {
+		panic(fmt.Sprintf("native contract %s version %d is reserved", contract.Hex(), version))
+	}
+	if _, ok := Versions[contract]; !ok {
+		Versions[contract] = make(map[uint64]RegisterService)
+	}
+	Versions[contract][version] = register
+}
+
+// resolveContract returns the implementation of contract active at block height, the default version is
+// always used if the call is not versioned.
+func resolveContract(db *state.StateDB, contract common.Address, height *big.Int, versioned bool) (RegisterService, error) {
+	version := DefaultVersion
+	if versioned && ActiveVersion != nil {
+		version = ActiveVersion(db, contract, height)
+	}
+	if version == DefaultVersion {
+		register, ok := Contracts[contract]
+		if !ok {
+			return nil, fmt.Errorf("failed to find contract: [%x]", contract)
+		}
+		return register, nil
+	}
+	register, ok := Versions[contract][version]
+	if !ok {
+		return nil, fmt.Errorf("native contract [%x] version %d is not supported, please upgrade the binary", contract, version)
+	}
+	return register, nil
+}
@@ -89,6 +89,7 @@ const (
 	txLookupCacheLimit  = 1024
 	maxFutureBlocks     = 256
 	maxTimeFutureBlocks = 30
+	pendingStateLimit   = 32
 	TriesInMemory       = 128
 
 	// BlockChainVersion ensures that an incompatible database forces a resync from scratch.
@@ -195,6 +196,7 @@ type BlockChain struct {
 	blockCache    *lru.Cache     // Cache for the most recent entire blocks
 	txLookupCache *lru.Cache     // Cache for the most recent transaction lookup data.
 	futureBlocks  *lru.Cache     // future blocks are blocks added for later processing
+	pendingStates *lru.Cache     // uncommitted states of pre-executed blocks, keyed by state root
 
 	quit          chan struct{}  // blockchain quit channel
 	wg            sync.WaitGroup // chain processing wait group for shutting down
@@ -224,6 +226,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	blockCache, _ := lru.New(blockCacheLimit)
 	txLookupCache, _ := lru.New(txLookupCacheLimit)
 	futureBlocks, _ := lru.New(maxFutureBlocks)
+	pendingStates, _ := lru.New(pendingStateLimit)
 
 	bc := &BlockChain{
 		chainConfig: chainConfig,
@@ -243,6 +246,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		blockCache:     blockCache,
 		txLookupCache:  txLookupCache,
 		futureBlocks:   futureBlocks,
+		pendingStates:  pendingStates,
 		engine:         engine,
 		vmConfig:       vmConfig,
 	}
@@ -691,6 +695,9 @@ func (bc *BlockChain) State() (*state.StateDB, error) {
 
 // StateAt returns a new mutable state based on a particular point in time.
 func (bc *BlockChain) StateAt(root common.Hash) (*state.StateDB, error) {
+	if cached, ok := bc.pendingStates.Get(root); ok {
+		return cached.(*pendingState).state.Copy(), nil
+	}
 	return state.New(root, bc.stateCache, bc.snaps)
 }
 
@@ -1584,6 +1591,7 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	} else {
 		bc.chainSideFeed.Send(ChainSideEvent{Block: block})
 	}
+	bc.prunePendingStates(block.NumberU64())
 	return status, nil
 }
 
@@ -2514,19 +2522,45 @@ func (bc *BlockChain) SubscribeBlockProcessingEvent(ch chan<- bool) event.Subscr
 	return bc.scope.Track(bc.blockProcFeed.Subscribe(ch))
 }
 
-func (bc *BlockChain) PreExecuteBlock(block *types.Block) error {
-	parent := bc.GetBlockByHash(block.ParentHash())
-	statedb, err := bc.StateAt(parent.Root())
+// PreExecuteBlock executes the block on top of its parent and validates the state, the parent may be one of
+// the pending ancestors which are not inserted yet. The resulting state is kept uncommitted in a bounded
+// cache, so that the descendants of block can be executed before it's inserted, and it is dropped once a
+// block of the same height is written.
+func (bc *BlockChain) PreExecuteBlock(block *types.Block, pending []*types.Header) error {
+	chain := consensus.NewPendingChain(bc, bc.engine, pending)
+	parent := chain.GetHeader(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	statedb, err := bc.StateAt(parent.Root)
 	if err != nil {
 		return err
 	}
 
-	receipts, _, usedGas, err := bc.processor.Process(block, statedb, bc.vmConfig)
+	processor := NewStateProcessor(bc.chainConfig, bc, bc.engine)
+	receipts, _, usedGas, err := processor.process(chain, block, statedb, bc.vmConfig)
 	if err != nil {
 		return err
 	}
 	if err := bc.validator.ValidateState(block, statedb, receipts, usedGas); err != nil {
 		return err
 	}
+	bc.pendingStates.Add(block.Root(), &pendingState{number: block.NumberU64(), state: statedb})
 	return nil
 }
+
+// pendingState is the state of a pre-executed block which is not inserted yet.
+type pendingState struct {
+	number uint64
+	state  *state.StateDB
+}
+
+// prunePendingStates drops the pre-executed states up to the given height, they are either written or
+// belong to a branch which can not be committed any more.
+func (bc *BlockChain) prunePendingStates(number uint64) {
+	for _, root := range bc.pendingStates.Keys() {
+		if cached, ok := bc.pendingStates.Peek(root); ok && cached.(*pendingState).number <= number {
+			bc.pendingStates.Remove(root)
+		}
+	}
+}
@@ -31,6 +31,10 @@ var (
 
 	// ErrNoGenesis is returned when there is no Genesis Block.
 	ErrNoGenesis = errors.New("genesis not found in chain")
+
+	// ErrTxOrdering is returned if the transactions of a block are not in an
+	// order the enforced transaction ordering policy could have produced.
+	ErrTxOrdering = errors.New("transactions out of policy order")
 )
 
 // List of evm-call-message pre-checking errors. All state transition messages will
@@ -71,4 +75,8 @@ var (
 	// ErrTxTypeNotSupported is returned if a transaction is not supported in the
 	// current network configuration.
 	ErrTxTypeNotSupported = types.ErrTxTypeNotSupported
+
+	// ErrMaasConfigState is returned if the maas_config rules are enforced but
+	// the state is not a StateDB, which is required to read the native storage.
+	ErrMaasConfigState = errors.New("maas config rules require StateDB")
 )
@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -58,6 +59,9 @@ type Genesis struct {
 	Coinbase   common.Address      `json:"coinbase"`
 	Alloc      GenesisAlloc        `json:"alloc"      gencodec:"required"`
 
+	// NativeAlloc seeds the storage of native contracts
+	NativeAlloc *GenesisNativeAlloc `json:"nativeAlloc,omitempty"`
+
 	// These fields are used for consensus tests. Please don't use them
 	// in actual genesis blocks.
 	Number     uint64      `json:"number"`
@@ -86,10 +90,118 @@ type GenesisAccount struct {
 	Storage    map[common.Hash]common.Hash `json:"storage,omitempty"`
 	Balance    *big.Int                    `json:"balance" gencodec:"required"`
 	Nonce      uint64                      `json:"nonce,omitempty"`
-	PublicKey  []byte                      `json:"publicKey" gencodec:"required"`
+	PublicKey  []byte                      `json:"publicKey,omitempty"` // set for genesis validators only
 	PrivateKey []byte                      `json:"secretKey,omitempty"` // for tests
 }
 
+// Peers returns the genesis validators, which are the accounts with public key.
+func (ga GenesisAlloc) Peers() []GenesisPeer {
+	peers := make([]GenesisPeer, 0)
+	for addr, account := range ga {
+		if len(account.PublicKey) > 0 {
+			peers = append(peers, GenesisPeer{Address: addr, PublicKey: account.PublicKey})
+		}
+	}
+	return peers
+}
+
+// GenesisNativeAlloc specifies the initial storage of native contracts, so that the chain is
+// governed from the genesis block without bootstrap transactions.
+type GenesisNativeAlloc struct {
+	MaasConfig  *maas_config.GenesisConfig `json:"maasConfig,omitempty"`
+	NodeManager *GenesisNodeManager        `json:"nodeManager,omitempty"`
+}
+
+// GenesisNodeManager specifies the peers of the first node manager epoch, the alloc accounts
+// with public key are used if it is not set.
+type GenesisNodeManager struct {
+	Peers []GenesisPeer `json:"peers"`
+}
+
+// GenesisPeer is a validator of the first node manager epoch, the BLS public key with the proof of
+// possession is optional.
+type GenesisPeer struct {
+	Address      common.Address `json:"address"`
+	PublicKey    hexutil.Bytes  `json:"publicKey"`
+	BLSPublicKey hexutil.Bytes  `json:"blsPublicKey,omitempty"`
+	BLSProof     hexutil.Bytes  `json:"blsProof,omitempty"`
+}
+
+func (na *GenesisNativeAlloc) validate() error {
+	if na.MaasConfig != nil {
+		if err := na.MaasConfig.Validate(); err != nil {
+			return fmt.Errorf("invalid native alloc of maas config: %v", err)
+		}
+	}
+	if na.NodeManager != nil {
+		if len(na.NodeManager.Peers) == 0 {
+			return errors.New("invalid native alloc of node manager: no peers")
+		}
+		seen := make(map[common.Address]struct{})
+		for _, peer := range na.NodeManager.Peers {
+			pubkey, err := crypto.DecompressPubkey(peer.PublicKey)
+			if err != nil {
+				return fmt.Errorf("invalid native alloc of node manager: peer %s public key: %v", peer.Address.Hex(), err)
+			}
+			if got := crypto.PubkeyToAddress(*pubkey); got != peer.Address {
+				return fmt.Errorf("invalid native alloc of node manager: expect address %s got %s", peer.Address.Hex(), got.Hex())
+			}
+			if _, ok := seen[peer.Address]; ok {
+				return fmt.Errorf("invalid native alloc of node manager: duplicate peer %s", peer.Address.Hex())
+			}
+			seen[peer.Address] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// validateNativeAlloc checks the native alloc, and the peers of the first epoch against the genesis
+// validators in extra data and the node whitelist, otherwise the node manager epoch differs from the
+// consensus epoch, or the whitelist rejects the validators from the first block.
+func (g *Genesis) validateNativeAlloc() error {
+	na := g.NativeAlloc
+	if err := na.validate(); err != nil {
+		return err
+	}
+	if na.NodeManager != nil && g.Config != nil && g.Config.HotStuff != nil {
+		extra, err := types.ExtractHotstuffExtraPayload(g.ExtraData)
+		if err != nil {
+			return fmt.Errorf("invalid native alloc of node manager: decode extra data: %v", err)
+		}
+		validators := make(map[common.Address]struct{})
+		for _, addr := range extra.Validators {
+			validators[addr] = struct{}{}
+		}
+		peers := make(map[common.Address]struct{})
+		for _, peer := range na.NodeManager.Peers {
+			if _, ok := validators[peer.Address]; !ok {
+				return fmt.Errorf("invalid native alloc of node manager: peer %s not in extra data validators", peer.Address.Hex())
+			}
+			peers[peer.Address] = struct{}{}
+		}
+		for _, addr := range extra.Validators {
+			if _, ok := peers[addr]; !ok {
+				return fmt.Errorf("invalid native alloc of node manager: validator %s not in peers", addr.Hex())
+			}
+		}
+	}
+	if na.MaasConfig != nil && na.MaasConfig.NodeWhiteEnable {
+		if len(na.MaasConfig.NodeWhitelist) == 0 {
+			return errors.New("invalid native alloc of maas config: node whitelist enabled without whitelist")
+		}
+		whitelist := make(map[common.Address]struct{})
+		for _, addr := range na.MaasConfig.NodeWhitelist {
+			whitelist[addr] = struct{}{}
+		}
+		for _, peer := range g.peers() {
+			if _, ok := whitelist[peer.Address]; !ok {
+				return fmt.Errorf("invalid native alloc of maas config: peer %s not in node whitelist", peer.Address.Hex())
+			}
+		}
+	}
+	return nil
+}
+
 // field type overrides for gencodec
 type genesisSpecMarshaling struct {
 	Nonce      math.HexOrDecimal64
@@ -155,13 +267,20 @@ func (e *GenesisMismatchError) Error() string {
 //
 // The returned chain configuration is never nil.
 func SetupGenesisBlock(db ethdb.Database, genesis *Genesis) (*params.ChainConfig, common.Hash, error) {
-	return SetupGenesisBlockWithOverride(db, genesis, nil)
+	return SetupGenesisBlockWithOverride(db, genesis, nil, nil)
 }
 
-func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, overrideLondon *big.Int) (*params.ChainConfig, common.Hash, error) {
+// SetupGenesisBlockWithOverride is SetupGenesisBlock with fork overrides, the overridden config is
+// checked against the stored one and persisted as the chain config.
+func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, overrideLondon *big.Int, overrideHotStuffFork *params.HotStuffFork) (*params.ChainConfig, common.Hash, error) {
 	if genesis != nil && genesis.Config == nil {
 		return params.AllEthashProtocolChanges, common.Hash{}, errGenesisNoConfig
 	}
+	if genesis != nil && overrideHotStuffFork != nil {
+		overridden := *genesis
+		overridden.Config = withHotStuffFork(genesis.Config, overrideHotStuffFork)
+		genesis = &overridden
+	}
 	// Just commit the new block if there is no stored genesis block.
 	stored := rawdb.ReadCanonicalHash(db, 0)
 	if (stored == common.Hash{}) {
@@ -220,7 +339,13 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	// config is supplied. These chains would get AllProtocolChanges (and a compat error)
 	// if we just continued here.
 	if genesis == nil && stored != params.MainnetGenesisHash {
-		return storedcfg, stored, nil
+		if overrideHotStuffFork == nil {
+			return storedcfg, stored, nil
+		}
+		newcfg = withHotStuffFork(storedcfg, overrideHotStuffFork)
+		if err := newcfg.CheckConfigForkOrder(); err != nil {
+			return newcfg, common.Hash{}, err
+		}
 	}
 	// Check config compatibility and write the config. Compatibility errors
 	// are returned to the caller unless we're already at block zero.
@@ -236,6 +361,20 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	return newcfg, stored, nil
 }
 
+// withHotStuffFork returns a copy of config whose HotStuff fork parameters are replaced by fork,
+// config is returned as it is if it is not a HotStuff chain.
+func withHotStuffFork(config *params.ChainConfig, fork *params.HotStuffFork) *params.ChainConfig {
+	if config == nil || config.HotStuff == nil {
+		log.Warn("Ignore HotStuff fork override of non-HotStuff chain")
+		return config
+	}
+	log.Info("Override HotStuff fork", "height", fork.ForkHeight, "epoch", fork.ForkEpochId, "validators", len(fork.ForkValidators))
+	cpy, hotstuff := *config, *config.HotStuff
+	hotstuff.HotStuffFork = *fork
+	cpy.HotStuff = &hotstuff
+	return &cpy
+}
+
 func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 	switch {
 	case g != nil:
@@ -257,7 +396,7 @@ func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 
 var (
 	// RegGenesis store genesis validators and public keys in governance contract
-	RegGenesis func(db *state.StateDB, data GenesisAlloc) error
+	RegGenesis func(db *state.StateDB, peers []GenesisPeer) error
 
 	// StoreGenesis store genesis validators in consensus snapshot
 	StoreGenesis func(db ethdb.Database, header *types.Header) error
@@ -285,7 +424,13 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	for _, v := range native.NativeContractAddrMap {
 		g.createNativeContract(statedb, v)
 	}
-	RegGenesis(statedb, g.Alloc)
+	RegGenesis(statedb, g.peers())
+	if g.Config != nil && g.Config.HotStuff.IsMaasListMigration(new(big.Int).SetUint64(g.Number)) {
+		maas_config.MigrateAddressLists(statedb)
+	}
+	if g.NativeAlloc != nil && g.NativeAlloc.MaasConfig != nil {
+		maas_config.StoreGenesis(statedb, g.NativeAlloc.MaasConfig)
+	}
 
 	root := statedb.IntermediateRoot(false)
 	head := &types.Header{
@@ -316,6 +461,14 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	return types.NewBlock(head, nil, nil, nil, trie.NewStackTrie(nil))
 }
 
+// peers returns the validators of the first node manager epoch.
+func (g *Genesis) peers() []GenesisPeer {
+	if g.NativeAlloc != nil && g.NativeAlloc.NodeManager != nil {
+		return g.NativeAlloc.NodeManager.Peers
+	}
+	return g.Alloc.Peers()
+}
+
 func (g *Genesis) createNativeContract(db *state.StateDB, addr common.Address) {
 	db.CreateAccount(addr)
 	db.SetCode(addr, addr[:])
@@ -328,6 +481,11 @@ func (g *Genesis) createNativeContract(db *state.StateDB, addr common.Address) {
 // Commit writes the block and state of a genesis specification to the database.
 // The block is committed as the canonical head block.
 func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
+	if g.NativeAlloc != nil {
+		if err := g.validateNativeAlloc(); err != nil {
+			return nil, err
+		}
+	}
 	block := g.ToBlock(db)
 	if block.Number().Sign() != 0 {
 		return nil, fmt.Errorf("can't commit genesis block with number > 0")
@@ -17,17 +17,23 @@
 package core
 
 import (
+	"encoding/json"
 	"math/big"
 	"reflect"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestDefaultGenesisBlock(t *testing.T) {
@@ -197,3 +203,112 @@ func TestGenesisHashes(t *testing.T) {
 		}
 	}
 }
+
+func TestGenesisNativeAlloc(t *testing.T) {
+	var stored []GenesisPeer
+	regGenesis, storeGenesis := RegGenesis, StoreGenesis
+	RegGenesis = func(db *state.StateDB, peers []GenesisPeer) error {
+		stored = peers
+		return nil
+	}
+	StoreGenesis = func(db ethdb.Database, header *types.Header) error { return nil }
+	defer func() { RegGenesis, StoreGenesis = regGenesis, storeGenesis }()
+
+	key, _ := crypto.GenerateKey()
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+	user := common.HexToAddress("0x01")
+	owner := common.HexToAddress("0x02")
+
+	var genesis Genesis
+	input := `{
+		"config": {"chainId": 1},
+		"gasLimit": "0x1000",
+		"difficulty": "0x1",
+		"alloc": {"` + user.Hex() + `": {"balance": "0x10"}},
+		"nativeAlloc": {
+			"maasConfig": {"owner": "` + owner.Hex() + `", "blacklist": ["` + user.Hex() + `"], "gasManageEnable": true},
+			"nodeManager": {"peers": [{"address": "` + validator.Hex() + `", "publicKey": "` + hexutil.Encode(crypto.CompressPubkey(&key.PublicKey)) + `"}]}
+		}
+	}`
+	if err := json.Unmarshal([]byte(input), &genesis); err != nil {
+		t.Fatalf("failed to decode genesis: %v", err)
+	}
+	if genesis.NativeAlloc.MaasConfig.Owner != owner || !genesis.NativeAlloc.MaasConfig.GasManageEnable {
+		t.Fatalf("wrong maas config: %+v", genesis.NativeAlloc.MaasConfig)
+	}
+	if len(genesis.Alloc[user].PublicKey) != 0 || len(genesis.Alloc.Peers()) != 0 {
+		t.Fatalf("balance only account should not be a peer")
+	}
+
+	db := rawdb.NewMemoryDatabase()
+	if _, err := genesis.Commit(db); err != nil {
+		t.Fatalf("failed to commit genesis: %v", err)
+	}
+	if len(stored) != 1 || stored[0].Address != validator {
+		t.Fatalf("wrong genesis peers: %v", stored)
+	}
+
+	// genesis with invalid native alloc should be rejected
+	genesis.NativeAlloc.MaasConfig.Blacklist = []common.Address{owner}
+	if _, err := genesis.Commit(db); err == nil {
+		t.Fatalf("expect error for blocked owner")
+	}
+	genesis.NativeAlloc.MaasConfig.Blacklist = nil
+	genesis.NativeAlloc.NodeManager.Peers[0].Address = user
+	if _, err := genesis.Commit(db); err == nil {
+		t.Fatalf("expect error for mismatched peer address")
+	}
+	genesis.NativeAlloc.NodeManager.Peers = append(genesis.NativeAlloc.NodeManager.Peers[:0], GenesisPeer{Address: validator, PublicKey: crypto.CompressPubkey(&key.PublicKey)}, GenesisPeer{Address: validator, PublicKey: crypto.CompressPubkey(&key.PublicKey)})
+	if _, err := genesis.Commit(db); err == nil {
+		t.Fatalf("expect error for duplicate peers")
+	}
+
+	genesis.NativeAlloc.NodeManager.Peers = genesis.NativeAlloc.NodeManager.Peers[:1]
+
+	// peers should be the validators in extra data of hotstuff genesis
+	config := genesis.Config
+	genesis.Config = &params.ChainConfig{ChainID: big.NewInt(1), HotStuff: &params.HotStuffConfig{}}
+	for _, validators := range [][]common.Address{nil, {user}, {validator, user}} {
+		genesis.ExtraData = hotstuffExtra(t, validators...)
+		if err := genesis.validateNativeAlloc(); err == nil {
+			t.Fatalf("expect error for peers mismatched with extra data validators %v", validators)
+		}
+	}
+	genesis.ExtraData = hotstuffExtra(t, validator)
+	if err := genesis.validateNativeAlloc(); err != nil {
+		t.Fatalf("failed to validate native alloc: %v", err)
+	}
+	genesis.Config, genesis.ExtraData = config, nil
+
+	// node whitelist should contain the peers if enabled
+	genesis.NativeAlloc.MaasConfig.NodeWhiteEnable = true
+	for _, whitelist := range [][]common.Address{nil, {user}} {
+		genesis.NativeAlloc.MaasConfig.NodeWhitelist = whitelist
+		if _, err := genesis.Commit(db); err == nil {
+			t.Fatalf("expect error for node whitelist %v without peers", whitelist)
+		}
+	}
+	genesis.NativeAlloc.MaasConfig.NodeWhitelist = []common.Address{user, validator}
+	if err := genesis.validateNativeAlloc(); err != nil {
+		t.Fatalf("failed to validate native alloc: %v", err)
+	}
+	genesis.NativeAlloc.MaasConfig.NodeWhiteEnable, genesis.NativeAlloc.MaasConfig.NodeWhitelist = false, nil
+
+	// peers are taken from the alloc accounts with public key if node manager is not set
+	genesis.NativeAlloc.NodeManager = nil
+	genesis.Alloc[validator] = GenesisAccount{Balance: big.NewInt(1), PublicKey: crypto.CompressPubkey(&key.PublicKey)}
+	if _, err := genesis.Commit(rawdb.NewMemoryDatabase()); err != nil {
+		t.Fatalf("failed to commit genesis: %v", err)
+	}
+	if len(stored) != 1 || stored[0].Address != validator {
+		t.Fatalf("wrong genesis peers: %v", stored)
+	}
+}
+
+func hotstuffExtra(t *testing.T, validators ...common.Address) []byte {
+	payload, err := rlp.EncodeToBytes(&types.HotstuffExtra{Validators: validators, Seal: []byte{}, CommittedSeal: [][]byte{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(make([]byte, types.HotstuffExtraVanity), payload...)
+}
@@ -74,6 +74,11 @@ func (frdb *freezerdb) Freeze(threshold uint64) error {
 	return nil
 }
 
+// PutSync implements ethdb.KeyValueSyncWriter, forwarding to the key-value store.
+func (frdb *freezerdb) PutSync(key []byte, value []byte) error {
+	return putSync(frdb.KeyValueStore, key, value)
+}
+
 // nofreezedb is a database wrapper that disables freezer data retrievals.
 type nofreezedb struct {
 	ethdb.KeyValueStore
@@ -114,6 +119,20 @@ func (db *nofreezedb) Sync() error {
 	return errNotSupported
 }
 
+// PutSync implements ethdb.KeyValueSyncWriter, forwarding to the key-value store.
+func (db *nofreezedb) PutSync(key []byte, value []byte) error {
+	return putSync(db.KeyValueStore, key, value)
+}
+
+// putSync flushes the write to disk if the database supports it, or falls back to
+// a plain write, e.g. for the in-memory database.
+func putSync(db ethdb.KeyValueWriter, key []byte, value []byte) error {
+	if w, ok := db.(ethdb.KeyValueSyncWriter); ok {
+		return w.PutSync(key, value)
+	}
+	return db.Put(key, value)
+}
+
 // NewDatabase creates a high level database on top of a given key-value data
 // store without a freezer moving immutable chain segments into cold storage.
 func NewDatabase(db ethdb.KeyValueStore) ethdb.Database {
@@ -21,6 +21,7 @@ package rawdb
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/ethdb"
 )
@@ -28,6 +29,9 @@ import (
 var (
 	keyCurEpoch    = []byte("hs-cur-ep-ht")
 	keyEpochPrefix = []byte("hs-ep")
+	keySafetyRules = []byte("hs-safety")
+
+	keyCommitRoundPrefix = []byte("hs-cr")
 )
 
 func WriteCurrentEpochHeight(db ethdb.KeyValueWriter, height uint64) error {
@@ -52,6 +56,43 @@ func ReadEpoch(db ethdb.Reader, height uint64) ([]byte, error) {
 	return db.Get(key)
 }
 
+// WriteSafetyRules stores the rlp encoded consensus safety rules of local validator, the write is flushed
+// to disk before the vote being signed so that it survives a crash.
+func WriteSafetyRules(db ethdb.KeyValueWriter, blob []byte) error {
+	return putSync(db, keySafetyRules, blob)
+}
+
+// ReadSafetyRules retrieves the consensus safety rules of local validator, it returns nil without error if
+// not found, and the database failure otherwise.
+func ReadSafetyRules(db ethdb.KeyValueReader) ([]byte, error) {
+	if has, err := db.Has(keySafetyRules); err != nil || !has {
+		return nil, err
+	}
+	return db.Get(keySafetyRules)
+}
+
+// WriteCommitRound stores the round in which the block committed by local validator was proposed.
+func WriteCommitRound(db ethdb.KeyValueWriter, hash common.Hash, round uint64) error {
+	return db.Put(keyCommitRound(hash), new(big.Int).SetUint64(round).Bytes())
+}
+
+// ReadCommitRound retrieves the round in which the block was proposed, it returns false if the block was
+// not committed by local validator, e.g: synchronized from the others.
+func ReadCommitRound(db ethdb.KeyValueReader, hash common.Hash) (uint64, bool) {
+	if has, err := db.Has(keyCommitRound(hash)); err != nil || !has {
+		return 0, false
+	}
+	blob, err := db.Get(keyCommitRound(hash))
+	if err != nil {
+		return 0, false
+	}
+	return new(big.Int).SetBytes(blob).Uint64(), true
+}
+
+func keyCommitRound(hash common.Hash) []byte {
+	return append(append([]byte{}, keyCommitRoundPrefix...), hash.Bytes()...)
+}
+
 func keyHeight(height uint64) []byte {
 	dat := uint64Bytes(height)
 	return append(keyEpochPrefix, dat...)
@@ -24,6 +24,31 @@ import (
 
 type CacheDB StateDB
 
+// CacheMeter is charged by the number of 32 bytes storage slots read or written through CacheDB.
+type CacheMeter interface {
+	ChargeRead(slots uint64)
+	ChargeWrite(slots uint64)
+}
+
+// SetMeter sets the meter of storage operations and returns the previous one.
+func (c *CacheDB) SetMeter(meter CacheMeter) CacheMeter {
+	prev := c.cacheMeter
+	c.cacheMeter = meter
+	return prev
+}
+
+func (c *CacheDB) chargeRead(slots uint64) {
+	if c.cacheMeter != nil {
+		c.cacheMeter.ChargeRead(slots)
+	}
+}
+
+func (c *CacheDB) chargeWrite(slots uint64) {
+	if c.cacheMeter != nil {
+		c.cacheMeter.ChargeWrite(slots)
+	}
+}
+
 func (c *CacheDB) Put(key []byte, value []byte) {
 	if len(key) <= common.AddressLength {
 		panic("CacheDB should only be used for native contract storage")
@@ -77,6 +102,7 @@ func (c *CacheDB) putValue(so *stateObject, slot common.Hash, value []byte, more
 	s := (*StateDB)(c)
 	hashValue := common.BytesToHash(value)
 	so.SetState(s.db, slot, hashValue)
+	c.chargeWrite(1)
 }
 
 func Key2Slot(key []byte) common.Hash {
@@ -107,6 +133,7 @@ func (c *CacheDB) Get(key []byte) ([]byte, error) {
 		var result []byte
 		slot := Key2Slot(key[common.AddressLength:])
 		value := so.GetState(s.db, slot)
+		c.chargeRead(1)
 		meta := value[:][0]
 		more := meta&1 == 1
 		if more {
@@ -121,6 +148,7 @@ func (c *CacheDB) Get(key []byte) ([]byte, error) {
 		for more {
 			slot = c.nextSlot(slot)
 			value = so.GetState(s.db, slot)
+			c.chargeRead(1)
 			meta = value[:][0]
 			more = meta&1 == 1
 			if more {
@@ -147,11 +175,17 @@ func (c *CacheDB) Delete(key []byte) {
 		slot := Key2Slot(key[common.AddressLength:])
 		value := so.GetState(s.db, slot)
 		so.SetState(s.db, slot, common.Hash{})
+		if value != (common.Hash{}) {
+			c.chargeWrite(1)
+		} else {
+			c.chargeRead(1)
+		}
 		more := value[:][0]&1 == 1
 		for more {
 			slot = c.nextSlot(slot)
 			value = so.GetState(s.db, slot)
 			so.SetState(s.db, slot, common.Hash{})
+			c.chargeWrite(1)
 			more = value[:][0]&1 == 1
 		}
 	}
@@ -107,6 +107,9 @@ type StateDB struct {
 	validRevisions []revision
 	nextRevisionId int
 
+	// Meter charged by storage operations through CacheDB, it's set during metered native contract calls
+	cacheMeter CacheMeter
+
 	// Measurements gathered during execution for debugging purposes
 	AccountReads         time.Duration
 	AccountHashes        time.Duration
@@ -22,6 +22,8 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
+	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/log"
@@ -211,6 +213,22 @@ func (st *StateTransition) preCheck() error {
 	return st.buyGas()
 }
 
+// checkMaasConfig applies the blacklist and gas manager rules of maas_config to the sender and recipient
+// after the fork, they were checked in tx pool only before.
+func (st *StateTransition) checkMaasConfig() error {
+	if !st.evm.ChainConfig().HotStuff.IsMaasConfig(st.evm.Context.BlockNumber) {
+		return nil
+	}
+	sdb, ok := st.state.(*state.StateDB)
+	if !ok {
+		return fmt.Errorf("%w: got %T", ErrMaasConfigState, st.state)
+	}
+	if err := native_client.CheckTransfer(sdb, st.msg.From(), st.msg.To(), st.msg.Value()); err != nil {
+		return fmt.Errorf("%w: from %v, to %v", err, st.msg.From().Hex(), st.msg.To())
+	}
+	return nil
+}
+
 // TransitionDb will transition the state by applying the current message and
 // returning the evm execution result with following fields.
 //
@@ -235,6 +253,11 @@ func (st *StateTransition) TransitionDb() (*ExecutionResult, error) {
 	// 5. there is no overflow when calculating intrinsic gas
 	// 6. caller has enough balance to cover asset transfer for **topmost** call
 
+	// Check maas_config rules, so that blocks containing blocked transfers are rejected by all validators
+	if err := st.checkMaasConfig(); err != nil {
+		return nil, err
+	}
+
 	// Check clauses 1-3, buy gas if everything is correct
 	if err := st.preCheck(); err != nil {
 		return nil, err
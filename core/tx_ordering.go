@@ -0,0 +1,397 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"container/heap"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Names of the transaction ordering policies a block proposer can be configured with.
+const (
+	TxOrderingPrice      = "price"      // Highest gas price first, local accounts ahead of remote ones
+	TxOrderingFIFO       = "fifo"       // Earliest arrival first
+	TxOrderingFairShare  = "fairshare"  // One transaction per account in turn
+	TxOrderingGasManager = "gasmanager" // Gas managers of maas_config ahead of everyone else
+)
+
+// TransactionsIterator is an ordered set of pending transactions a block is
+// filled from, honouring the nonce order of every account.
+type TransactionsIterator interface {
+	// Peek returns the next transaction to include, nil if the set is exhausted.
+	Peek() *types.Transaction
+
+	// Shift replaces the current transaction with the next one from the same account.
+	Shift()
+
+	// Pop removes the current transaction together with all the following ones
+	// from the same account.
+	Pop()
+}
+
+// TxOrdering is a policy deciding the order in which the proposer packs pending
+// transactions into a block.
+type TxOrdering interface {
+	// Name returns the name the policy is configured with.
+	Name() string
+
+	// Order returns the pending transactions of local and remote accounts as an
+	// iterator filling the block executed on top of statedb. The iterator may
+	// read statedb while the block is being filled.
+	Order(signer types.Signer, statedb *state.StateDB, locals, remotes map[common.Address]types.Transactions) TransactionsIterator
+}
+
+// VerifiableTxOrdering is a policy which the order of block transactions can be
+// checked against by every node, so that it can be enforced by the chain config.
+type VerifiableTxOrdering interface {
+	TxOrdering
+
+	// Verify checks that the transactions of a block are in an order the policy
+	// could have produced, statedb being the state before the first transaction.
+	Verify(signer types.Signer, statedb *state.StateDB, txs types.Transactions) error
+}
+
+// NewTxOrdering returns the transaction ordering policy with the given name,
+// the empty name selecting the gas price ordering.
+func NewTxOrdering(name string) (TxOrdering, error) {
+	switch name {
+	case "", TxOrderingPrice:
+		return priceOrdering{}, nil
+	case TxOrderingFIFO:
+		return fifoOrdering{}, nil
+	case TxOrderingFairShare:
+		return fairShareOrdering{}, nil
+	case TxOrderingGasManager:
+		return gasManagerOrdering{}, nil
+	default:
+		return nil, fmt.Errorf("unknown transaction ordering policy %q", name)
+	}
+}
+
+// NewVerifiableTxOrdering returns the transaction ordering policy with the given
+// name, the policies depending on local knowledge of the proposer are rejected.
+func NewVerifiableTxOrdering(name string) (VerifiableTxOrdering, error) {
+	ordering, err := NewTxOrdering(name)
+	if err != nil {
+		return nil, err
+	}
+	verifiable, ok := ordering.(VerifiableTxOrdering)
+	if !ok {
+		return nil, fmt.Errorf("transaction ordering policy %q is not verifiable", ordering.Name())
+	}
+	return verifiable, nil
+}
+
+// verifyTxOrdering checks the transactions of block against the ordering policy
+// enforced by the chain config, statedb being the state before the first transaction.
+func verifyTxOrdering(config *params.ChainConfig, block *types.Block, statedb *state.StateDB) error {
+	if !config.HotStuff.IsTxOrdering(block.Number()) {
+		return nil
+	}
+	ordering, err := NewVerifiableTxOrdering(config.HotStuff.TxOrdering)
+	if err != nil {
+		return err
+	}
+	return ordering.Verify(types.MakeSigner(config, block.Number()), statedb, block.Transactions())
+}
+
+// mergeTransactions joins the pending transactions of local and remote accounts,
+// for the policies which don't favour local accounts.
+func mergeTransactions(locals, remotes map[common.Address]types.Transactions) map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions, len(locals)+len(remotes))
+	for from, accTxs := range remotes {
+		txs[from] = accTxs
+	}
+	for from, accTxs := range locals {
+		txs[from] = accTxs
+	}
+	return txs
+}
+
+// chainedTransactions hands out the transactions of several sets one set after
+// the other.
+type chainedTransactions []TransactionsIterator
+
+func (c *chainedTransactions) Peek() *types.Transaction {
+	for len(*c) > 0 {
+		if tx := (*c)[0].Peek(); tx != nil {
+			return tx
+		}
+		*c = (*c)[1:]
+	}
+	return nil
+}
+
+func (c *chainedTransactions) Shift() { (*c)[0].Shift() }
+func (c *chainedTransactions) Pop()   { (*c)[0].Pop() }
+
+// priceOrdering packs the transactions of local accounts first, each group by
+// gas price. As the split between local and remote accounts is only known to the
+// proposer, it is not verifiable.
+type priceOrdering struct{}
+
+func (priceOrdering) Name() string { return TxOrderingPrice }
+
+func (priceOrdering) Order(signer types.Signer, statedb *state.StateDB, locals, remotes map[common.Address]types.Transactions) TransactionsIterator {
+	return &chainedTransactions{
+		types.NewTransactionsByPriceAndNonce(signer, locals),
+		types.NewTransactionsByPriceAndNonce(signer, remotes),
+	}
+}
+
+// fifoOrdering packs transactions in the order they were first seen. As the
+// arrival time is only known to the proposer, it is not verifiable.
+type fifoOrdering struct{}
+
+func (fifoOrdering) Name() string { return TxOrderingFIFO }
+
+func (fifoOrdering) Order(signer types.Signer, statedb *state.StateDB, locals, remotes map[common.Address]types.Transactions) TransactionsIterator {
+	return newTransactionsByTimeAndNonce(signer, mergeTransactions(locals, remotes))
+}
+
+// txByTime implements the heap interface, sorting transactions by the time they
+// were first seen and then by gas price.
+type txByTime types.Transactions
+
+func (s txByTime) Len() int { return len(s) }
+func (s txByTime) Less(i, j int) bool {
+	if ti, tj := s[i].Time(), s[j].Time(); !ti.Equal(tj) {
+		return ti.Before(tj)
+	}
+	return s[i].GasPrice().Cmp(s[j].GasPrice()) > 0
+}
+func (s txByTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s *txByTime) Push(x interface{}) {
+	*s = append(*s, x.(*types.Transaction))
+}
+
+func (s *txByTime) Pop() interface{} {
+	old := *s
+	n := len(old)
+	x := old[n-1]
+	*s = old[0 : n-1]
+	return x
+}
+
+// transactionsByTimeAndNonce is the arrival time counterpart of
+// types.TransactionsByPriceAndNonce.
+type transactionsByTimeAndNonce struct {
+	txs    map[common.Address]types.Transactions // Per account nonce-sorted list of transactions
+	heads  txByTime                              // Next transaction for each unique account (arrival heap)
+	signer types.Signer                          // Signer for the set of transactions
+}
+
+func newTransactionsByTimeAndNonce(signer types.Signer, txs map[common.Address]types.Transactions) *transactionsByTimeAndNonce {
+	heads := make(txByTime, 0, len(txs))
+	for from, accTxs := range txs {
+		// Ensure the sender address is from the signer
+		if acc, _ := types.Sender(signer, accTxs[0]); acc != from {
+			delete(txs, from)
+			continue
+		}
+		heads = append(heads, accTxs[0])
+		txs[from] = accTxs[1:]
+	}
+	heap.Init(&heads)
+
+	return &transactionsByTimeAndNonce{
+		txs:    txs,
+		heads:  heads,
+		signer: signer,
+	}
+}
+
+func (t *transactionsByTimeAndNonce) Peek() *types.Transaction {
+	if len(t.heads) == 0 {
+		return nil
+	}
+	return t.heads[0]
+}
+
+func (t *transactionsByTimeAndNonce) Shift() {
+	acc, _ := types.Sender(t.signer, t.heads[0])
+	if txs, ok := t.txs[acc]; ok && len(txs) > 0 {
+		t.heads[0], t.txs[acc] = txs[0], txs[1:]
+		heap.Fix(&t.heads, 0)
+	} else {
+		heap.Pop(&t.heads)
+	}
+}
+
+func (t *transactionsByTimeAndNonce) Pop() {
+	heap.Pop(&t.heads)
+}
+
+// fairShareOrdering packs transactions in rounds, every account getting at most
+// one transaction included per round and the accounts of a round being served
+// by gas price. A block is accepted if the k-th transaction of any account
+// never precedes the j-th transaction of another one, for j < k.
+type fairShareOrdering struct{}
+
+func (fairShareOrdering) Name() string { return TxOrderingFairShare }
+
+func (fairShareOrdering) Order(signer types.Signer, statedb *state.StateDB, locals, remotes map[common.Address]types.Transactions) TransactionsIterator {
+	return newTransactionsByRound(signer, statedb, mergeTransactions(locals, remotes))
+}
+
+func (fairShareOrdering) Verify(signer types.Signer, statedb *state.StateDB, txs types.Transactions) error {
+	var (
+		included = make(map[common.Address]uint64)
+		round    uint64
+	)
+	for i, tx := range txs {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return err
+		}
+		if included[from] < round {
+			return fmt.Errorf("%w: transaction %d of %s in round %d after round %d", ErrTxOrdering, i, from, included[from], round)
+		}
+		round = included[from]
+		included[from]++
+	}
+	return nil
+}
+
+// transactionsByRound is the transaction set of the fair share policy. Whether
+// a transaction made it into the block is told apart from a skipped one by the
+// account nonce in the state the block is filled on.
+type transactionsByRound struct {
+	txs     map[common.Address]types.Transactions // Per account nonce-sorted list of transactions
+	current types.TxByPriceAndTime                // Next transaction of the accounts left in the current round
+	next    types.TxByPriceAndTime                // Next transaction of the accounts served in the current round
+	nonces  map[common.Address]uint64             // Account nonces before the first transaction
+	served  map[common.Address]uint64             // Number of included transactions per account
+	signer  types.Signer                          // Signer for the set of transactions
+	statedb *state.StateDB                        // State the block is filled on
+}
+
+func newTransactionsByRound(signer types.Signer, statedb *state.StateDB, txs map[common.Address]types.Transactions) *transactionsByRound {
+	heads := make(types.TxByPriceAndTime, 0, len(txs))
+	nonces := make(map[common.Address]uint64, len(txs))
+	for from, accTxs := range txs {
+		// Ensure the sender address is from the signer
+		if acc, _ := types.Sender(signer, accTxs[0]); acc != from {
+			delete(txs, from)
+			continue
+		}
+		heads = append(heads, accTxs[0])
+		txs[from] = accTxs[1:]
+		nonces[from] = statedb.GetNonce(from)
+	}
+	heap.Init(&heads)
+
+	return &transactionsByRound{
+		txs:     txs,
+		current: heads,
+		nonces:  nonces,
+		served:  make(map[common.Address]uint64, len(txs)),
+		signer:  signer,
+		statedb: statedb,
+	}
+}
+
+func (t *transactionsByRound) Peek() *types.Transaction {
+	if len(t.current) == 0 {
+		// Every account left got its turn, start the next round
+		t.current, t.next = t.next, t.current
+	}
+	if len(t.current) == 0 {
+		return nil
+	}
+	return t.current[0]
+}
+
+func (t *transactionsByRound) Shift() {
+	acc, _ := types.Sender(t.signer, t.current[0])
+	txs, ok := t.txs[acc]
+	if !ok || len(txs) == 0 {
+		heap.Pop(&t.current)
+		return
+	}
+	t.txs[acc] = txs[1:]
+
+	if included := t.statedb.GetNonce(acc) - t.nonces[acc]; included > t.served[acc] {
+		// The current transaction was included, the account waits for the next round
+		t.served[acc] = included
+		heap.Pop(&t.current)
+		heap.Push(&t.next, txs[0])
+	} else {
+		t.current[0] = txs[0]
+		heap.Fix(&t.current, 0)
+	}
+}
+
+func (t *transactionsByRound) Pop() {
+	heap.Pop(&t.current)
+}
+
+// gasManagerOrdering packs the transactions of the maas_config gas managers
+// ahead of all others, each group by gas price. A block is accepted if no gas
+// manager transaction follows one of another account, gas managers being looked
+// up in the state before the first transaction, as they are when packing.
+type gasManagerOrdering struct{}
+
+func (gasManagerOrdering) Name() string { return TxOrderingGasManager }
+
+func (gasManagerOrdering) Order(signer types.Signer, statedb *state.StateDB, locals, remotes map[common.Address]types.Transactions) TransactionsIterator {
+	managers := make(map[common.Address]types.Transactions)
+	others := mergeTransactions(locals, remotes)
+	for from, accTxs := range others {
+		if native_client.IsGasManager(statedb, &from) {
+			managers[from] = accTxs
+			delete(others, from)
+		}
+	}
+	return &chainedTransactions{
+		types.NewTransactionsByPriceAndNonce(signer, managers),
+		types.NewTransactionsByPriceAndNonce(signer, others),
+	}
+}
+
+func (gasManagerOrdering) Verify(signer types.Signer, statedb *state.StateDB, txs types.Transactions) error {
+	var (
+		managers = make(map[common.Address]bool)
+		others   bool
+	)
+	for i, tx := range txs {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			return err
+		}
+		manager, ok := managers[from]
+		if !ok {
+			manager = native_client.IsGasManager(statedb, &from)
+			managers[from] = manager
+		}
+		if !manager {
+			others = true
+		} else if others {
+			return fmt.Errorf("%w: gas manager transaction %d of %s after other accounts", ErrTxOrdering, i, from)
+		}
+	}
+	return nil
+}
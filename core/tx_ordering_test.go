@@ -0,0 +1,227 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// fillBlock drains the ordered transactions the way the miner does, including
+// all of them but the skipped ones.
+func fillBlock(statedb *state.StateDB, txset TransactionsIterator, skip map[common.Hash]bool) types.Transactions {
+	var txs types.Transactions
+	for tx := txset.Peek(); tx != nil; tx = txset.Peek() {
+		if !skip[tx.Hash()] {
+			from, _ := types.Sender(types.HomesteadSigner{}, tx)
+			statedb.SetNonce(from, tx.Nonce()+1)
+			txs = append(txs, tx)
+		}
+		txset.Shift()
+	}
+	return txs
+}
+
+func newOrderingTestAccounts(n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		addrs[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	return keys, addrs
+}
+
+func TestNewTxOrdering(t *testing.T) {
+	for _, name := range []string{"", TxOrderingPrice, TxOrderingFIFO, TxOrderingFairShare, TxOrderingGasManager} {
+		ordering, err := NewTxOrdering(name)
+		if err != nil {
+			t.Fatalf("policy %q: %v", name, err)
+		}
+		if want := name; want != "" && ordering.Name() != want {
+			t.Errorf("policy %q: name mismatch, have %s", name, ordering.Name())
+		}
+	}
+	if _, err := NewTxOrdering("lottery"); err == nil {
+		t.Error("expected unknown policy to fail")
+	}
+	// policies relying on what only the proposer knows can't be enforced
+	for _, name := range []string{TxOrderingPrice, TxOrderingFIFO} {
+		if _, err := NewVerifiableTxOrdering(name); err == nil {
+			t.Errorf("policy %q: expected to be unverifiable", name)
+		}
+	}
+}
+
+func TestPriceOrderingLocalsFirst(t *testing.T) {
+	keys, addrs := newOrderingTestAccounts(2)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	local := pricedTransaction(0, 21000, big.NewInt(1), keys[0])
+	locals := map[common.Address]types.Transactions{addrs[0]: {local}}
+	remotes := map[common.Address]types.Transactions{
+		addrs[1]: {pricedTransaction(0, 21000, big.NewInt(100), keys[1])},
+	}
+	ordering, _ := NewTxOrdering(TxOrderingPrice)
+	txs := fillBlock(statedb, ordering.Order(types.HomesteadSigner{}, statedb, locals, remotes), nil)
+	if len(txs) != 2 || txs[0] != local {
+		t.Fatalf("local transaction not packed first: %v", txs)
+	}
+}
+
+func TestFIFOOrdering(t *testing.T) {
+	keys, addrs := newOrderingTestAccounts(3)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	// Transactions are created in arrival order, cheaper ones last so that a
+	// time tie doesn't change the expected order either
+	var (
+		arrived types.Transactions
+		pending = make(map[common.Address]types.Transactions)
+	)
+	for i, sender := range []int{2, 0, 2, 1, 0} {
+		tx := pricedTransaction(uint64(len(pending[addrs[sender]])), 21000, big.NewInt(int64(10-i)), keys[sender])
+		arrived = append(arrived, tx)
+		pending[addrs[sender]] = append(pending[addrs[sender]], tx)
+	}
+	ordering, _ := NewTxOrdering(TxOrderingFIFO)
+	txs := fillBlock(statedb, ordering.Order(types.HomesteadSigner{}, statedb, nil, pending), nil)
+	if len(txs) != len(arrived) {
+		t.Fatalf("transaction count mismatch: have %d, want %d", len(txs), len(arrived))
+	}
+	for i := range txs {
+		if txs[i] != arrived[i] {
+			t.Errorf("transaction %d: have %x, want %x", i, txs[i].Hash(), arrived[i].Hash())
+		}
+	}
+}
+
+func TestFairShareOrdering(t *testing.T) {
+	keys, addrs := newOrderingTestAccounts(3)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	statedb.SetNonce(addrs[1], 6)
+
+	// A busy account with plenty of expensive transactions shouldn't starve
+	// the others, even with one of them having a stale transaction skipped
+	pending := make(map[common.Address]types.Transactions)
+	for i := 0; i < 5; i++ {
+		pending[addrs[0]] = append(pending[addrs[0]], pricedTransaction(uint64(i), 21000, big.NewInt(100), keys[0]))
+	}
+	for i := 5; i < 8; i++ {
+		pending[addrs[1]] = append(pending[addrs[1]], pricedTransaction(uint64(i), 21000, big.NewInt(10), keys[1]))
+	}
+	pending[addrs[2]] = types.Transactions{pricedTransaction(0, 21000, big.NewInt(1), keys[2])}
+	skip := map[common.Hash]bool{pending[addrs[1]][0].Hash(): true}
+
+	ordering, _ := NewVerifiableTxOrdering(TxOrderingFairShare)
+	signer := types.HomesteadSigner{}
+	txs := fillBlock(statedb, ordering.Order(signer, statedb, nil, pending), skip)
+
+	want := []common.Address{addrs[0], addrs[1], addrs[2], addrs[0], addrs[1], addrs[0], addrs[0], addrs[0]}
+	if len(txs) != len(want) {
+		t.Fatalf("transaction count mismatch: have %d, want %d", len(txs), len(want))
+	}
+	for i, tx := range txs {
+		if from, _ := types.Sender(signer, tx); from != want[i] {
+			t.Errorf("transaction %d: sender mismatch, have %x, want %x", i, from, want[i])
+		}
+	}
+	if err := ordering.Verify(signer, nil, txs); err != nil {
+		t.Fatalf("failed to verify fair share block: %v", err)
+	}
+	// Serving the busy account twice in a row before the others had their turn
+	unfair := types.Transactions{txs[0], txs[3], txs[1], txs[2]}
+	if err := ordering.Verify(signer, nil, unfair); !errors.Is(err, ErrTxOrdering) {
+		t.Fatalf("verify error mismatch: have %v, want %v", err, ErrTxOrdering)
+	}
+}
+
+func TestGasManagerOrdering(t *testing.T) {
+	maas_config.InitMaasConfig()
+
+	keys, addrs := newOrderingTestAccounts(3)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	maas_config.StoreGenesis(statedb, &maas_config.GenesisConfig{GasManagers: []common.Address{addrs[2]}})
+
+	locals := map[common.Address]types.Transactions{
+		addrs[0]: {pricedTransaction(0, 21000, big.NewInt(100), keys[0])},
+	}
+	remotes := map[common.Address]types.Transactions{
+		addrs[1]: {pricedTransaction(0, 21000, big.NewInt(10), keys[1])},
+		addrs[2]: {pricedTransaction(0, 21000, big.NewInt(1), keys[2]), pricedTransaction(1, 21000, big.NewInt(1), keys[2])},
+	}
+	ordering, _ := NewVerifiableTxOrdering(TxOrderingGasManager)
+	signer := types.HomesteadSigner{}
+	parent := statedb.Copy()
+	txs := fillBlock(statedb, ordering.Order(signer, statedb, locals, remotes), nil)
+
+	want := []common.Address{addrs[2], addrs[2], addrs[0], addrs[1]}
+	if len(txs) != len(want) {
+		t.Fatalf("transaction count mismatch: have %d, want %d", len(txs), len(want))
+	}
+	for i, tx := range txs {
+		if from, _ := types.Sender(signer, tx); from != want[i] {
+			t.Errorf("transaction %d: sender mismatch, have %x, want %x", i, from, want[i])
+		}
+	}
+	if err := ordering.Verify(signer, parent, txs); err != nil {
+		t.Fatalf("failed to verify gas manager block: %v", err)
+	}
+	late := types.Transactions{txs[0], txs[2], txs[1], txs[3]}
+	if err := ordering.Verify(signer, parent, late); !errors.Is(err, ErrTxOrdering) {
+		t.Fatalf("verify error mismatch: have %v, want %v", err, ErrTxOrdering)
+	}
+}
+
+func TestVerifyTxOrdering(t *testing.T) {
+	keys, _ := newOrderingTestAccounts(2)
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	unfair := types.Transactions{
+		pricedTransaction(0, 21000, big.NewInt(1), keys[0]),
+		pricedTransaction(1, 21000, big.NewInt(1), keys[0]),
+		pricedTransaction(0, 21000, big.NewInt(1), keys[1]),
+	}
+	config := *params.TestChainConfig
+	config.HotStuff = &params.HotStuffConfig{TxOrderingBlock: big.NewInt(2), TxOrdering: TxOrderingFairShare}
+	block := func(number int64) *types.Block {
+		return types.NewBlock(&types.Header{Number: big.NewInt(number)}, unfair, nil, nil, trie.NewStackTrie(nil))
+	}
+
+	if err := verifyTxOrdering(&config, block(1), statedb); err != nil {
+		t.Fatalf("policy enforced before fork: %v", err)
+	}
+	if err := verifyTxOrdering(&config, block(2), statedb); !errors.Is(err, ErrTxOrdering) {
+		t.Fatalf("verify error mismatch: have %v, want %v", err, ErrTxOrdering)
+	}
+	config.HotStuff.TxOrdering = TxOrderingFIFO
+	if err := verifyTxOrdering(&config, block(2), statedb); err == nil {
+		t.Fatal("expected unverifiable policy to fail")
+	}
+}
@@ -540,16 +540,9 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if err != nil {
 		return ErrInvalidSender
 	}
-	// check if address is blocked
-	if native_client.IsBlocked(pool.currentState, &from) || native_client.IsBlocked(pool.currentState, tx.To()) {
-		return native_client.ErrAccountBlocked
-	}
-	// gas manager check
-	if tx.Value().Cmp(common.Big0) > 0 {
-		if native_client.IsGasManageEnable(pool.currentState) && !native_client.IsGasManager(pool.currentState, &from) &&
-			!native_client.IsGasManager(pool.currentState, tx.To()) && !native_client.IsGasUser(pool.currentState, tx.To()) {
-			return native_client.ErrNotGasManager
-		}
+	// check if address is blocked and gas manager rules
+	if err := native_client.CheckTransfer(pool.currentState, from, tx.To(), tx.Value()); err != nil {
+		return err
 	}
 
 	// Drop non-local transactions under our own minimal accepted gas price or tip
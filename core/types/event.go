@@ -25,4 +25,9 @@ type EpochChangeEvent struct {
 	StartHeight uint64
 	Validators  []common.Address
 	Hash        common.Hash
+
+	// BLS public keys and proofs of validators published in node manager, in the same order of
+	// validators. Keys are nil if none of the validators published it.
+	BLSPublicKeys [][]byte
+	BLSProofs     [][]byte
 }
@@ -33,6 +33,9 @@ var (
 	HotstuffExtraVanity = 32 // Fixed number of extra-data bytes reserved for validator vanity
 	HotstuffExtraSeal   = 65 // Fixed number of extra-data bytes reserved for validator seal
 
+	HotstuffExtraVRFProof = 64 // Fixed number of extra-data salt bytes reserved for vrf proof
+	HotstuffExtraVRFValue = 65 // Fixed number of extra-data salt bytes reserved for vrf output
+
 	// ErrInvalidHotstuffHeaderExtra is returned if the length of extra-data is less than 32 bytes
 	ErrInvalidHotstuffHeaderExtra = errors.New("invalid istanbul header extra-data")
 )
@@ -42,33 +45,92 @@ type HotstuffExtra struct {
 	Seal          []byte           // proposer signature
 	CommittedSeal [][]byte         // consensus participants signatures and it's size should be greater than 2/3 of validators
 	Salt          []byte           // omit empty
+
+	AggregatedSeal    []byte // BLS aggregated signature of committers, used instead of `CommittedSeal` after fork. omit empty
+	ParticipantBitmap []byte // committers index bitmap in sorted validator set, bit i represents validator i. omit empty
+
+	ParentCommittedSeal     [][]byte // committed seals of parent block, they are part of the block hash. omit empty
+	ParentAggregatedSeal    []byte   // aggregated seal of parent block, it is part of the block hash. omit empty
+	ParentParticipantBitmap []byte   // committers bitmap of parent aggregated seal. omit empty
+
+	ValidatorBLSKeys   [][]byte // BLS public keys of `Validators` published in node manager, in the same order. omit empty
+	ValidatorBLSProofs [][]byte // proofs of possession of `ValidatorBLSKeys`. omit empty
 }
 
 // EncodeRLP serializes ist into the Ethereum RLP format.
 func (ist *HotstuffExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	list := []interface{}{
 		ist.Validators,
 		ist.Seal,
 		ist.CommittedSeal,
 		ist.Salt,
-	})
+	}
+	// optional fields are present if any of the following ones is set
+	hasBLSKeys := ist.HasBLSKeys()
+	hasParentSeal := ist.HasParentSeal() || hasBLSKeys
+	if ist.IsAggregated() || hasParentSeal {
+		list = append(list, ist.AggregatedSeal, ist.ParticipantBitmap)
+	}
+	if hasParentSeal {
+		list = append(list, ist.ParentCommittedSeal, ist.ParentAggregatedSeal, ist.ParentParticipantBitmap)
+	}
+	if hasBLSKeys {
+		list = append(list, ist.ValidatorBLSKeys, ist.ValidatorBLSProofs)
+	}
+	return rlp.Encode(w, list)
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
 func (ist *HotstuffExtra) DecodeRLP(s *rlp.Stream) error {
 	var extra struct {
-		Validators    []common.Address
-		Seal          []byte
-		CommittedSeal [][]byte
-		Salt          []byte
+		Validators        []common.Address
+		Seal              []byte
+		CommittedSeal     [][]byte
+		Salt              []byte
+		AggregatedSeal    []byte `rlp:"optional"`
+		ParticipantBitmap []byte `rlp:"optional"`
+
+		ParentCommittedSeal     [][]byte `rlp:"optional"`
+		ParentAggregatedSeal    []byte   `rlp:"optional"`
+		ParentParticipantBitmap []byte   `rlp:"optional"`
+
+		ValidatorBLSKeys   [][]byte `rlp:"optional"`
+		ValidatorBLSProofs [][]byte `rlp:"optional"`
 	}
 	if err := s.Decode(&extra); err != nil {
 		return err
 	}
 	ist.Validators, ist.Seal, ist.CommittedSeal, ist.Salt = extra.Validators, extra.Seal, extra.CommittedSeal, extra.Salt
+	ist.AggregatedSeal, ist.ParticipantBitmap = extra.AggregatedSeal, extra.ParticipantBitmap
+	ist.ParentCommittedSeal, ist.ParentAggregatedSeal, ist.ParentParticipantBitmap = extra.ParentCommittedSeal, extra.ParentAggregatedSeal, extra.ParentParticipantBitmap
+	ist.ValidatorBLSKeys, ist.ValidatorBLSProofs = extra.ValidatorBLSKeys, extra.ValidatorBLSProofs
 	return nil
 }
 
+// IsAggregated returns true if the committed seals are aggregated into one BLS signature.
+func (ist *HotstuffExtra) IsAggregated() bool {
+	return len(ist.AggregatedSeal) > 0
+}
+
+// HasParentSeal returns true if the committed seals of parent block are carried in the extra.
+func (ist *HotstuffExtra) HasParentSeal() bool {
+	return len(ist.ParentCommittedSeal) > 0 || len(ist.ParentAggregatedSeal) > 0
+}
+
+// HasBLSKeys returns true if the BLS public keys of next epoch validators are carried in the extra.
+func (ist *HotstuffExtra) HasBLSKeys() bool {
+	return len(ist.ValidatorBLSKeys) > 0
+}
+
+// VRF splits the salt into the verifiable random output and it's proof, both of them will be
+// nil if the salt is not filled with vrf.
+func (ist *HotstuffExtra) VRF() (value []byte, proof []byte) {
+	if len(ist.Salt) != HotstuffExtraVRFProof+HotstuffExtraVRFValue {
+		return nil, nil
+	}
+	return ist.Salt[HotstuffExtraVRFProof:], ist.Salt[:HotstuffExtraVRFProof]
+}
+
 // ExtractHotstuffExtra extracts all values of the HotstuffExtra from the header. It returns an
 // error if the length of the given extra-data is less than 32 bytes or the extra-data can not
 // be decoded.
@@ -103,6 +165,8 @@ func HotstuffFilteredHeader(h *Header, keepSeal bool) *Header {
 		extra.Seal = []byte{}
 	}
 	extra.CommittedSeal = [][]byte{}
+	extra.AggregatedSeal = nil
+	extra.ParticipantBitmap = nil
 	//extra.Salt = []byte{}
 
 	payload, err := rlp.EncodeToBytes(&extra)
@@ -141,3 +205,46 @@ func HotstuffHeaderFillWithValidators(header *Header, vals []common.Address) err
 	header.Extra = append(buf.Bytes(), payload...)
 	return nil
 }
+
+// HotstuffHeaderFillWithBLSKeys fills the BLS public keys and proofs of next epoch validators, which
+// should be filled with the same order of validators.
+func HotstuffHeaderFillWithBLSKeys(header *Header, keys, proofs [][]byte) error {
+	extra, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	if len(keys) != len(extra.Validators) || len(proofs) != len(keys) {
+		return errors.New("BLS keys mismatch validators")
+	}
+	extra.ValidatorBLSKeys, extra.ValidatorBLSProofs = keys, proofs
+
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	return nil
+}
+
+// HotstuffHeaderFillWithParentSeal copies the committed seals of parent into the header extra, unlike
+// the committed seals of header itself they are covered by the header hash.
+func HotstuffHeaderFillWithParentSeal(header *Header, parent *Header) error {
+	extra, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	parentExtra, err := ExtractHotstuffExtra(parent)
+	if err != nil {
+		return err
+	}
+	extra.ParentCommittedSeal = parentExtra.CommittedSeal
+	extra.ParentAggregatedSeal = parentExtra.AggregatedSeal
+	extra.ParentParticipantBitmap = parentExtra.ParticipantBitmap
+
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	return nil
+}
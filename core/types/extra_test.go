@@ -45,3 +45,64 @@ func TestExtraMissingField(t *testing.T) {
 	assert.NoError(t, rlp.DecodeBytes(enc, &got))
 	assert.Equal(t, expect, got)
 }
+
+func TestExtraParentSeal(t *testing.T) {
+	parent := &Header{Number: common.Big1, MixDigest: HotstuffDigest}
+	assert.NoError(t, HotstuffHeaderFillWithValidators(parent, nil))
+	parentExtra, err := ExtractHotstuffExtra(parent)
+	assert.NoError(t, err)
+	parentExtra.AggregatedSeal, parentExtra.ParticipantBitmap = []byte("aggregated"), []byte{0x07}
+	payload, err := rlp.EncodeToBytes(parentExtra)
+	assert.NoError(t, err)
+	parent.Extra = append(parent.Extra[:HotstuffExtraVanity], payload...)
+
+	header := &Header{Number: common.Big2, ParentHash: parent.Hash(), MixDigest: HotstuffDigest}
+	assert.NoError(t, HotstuffHeaderFillWithValidators(header, nil))
+	unsealed := header.Hash()
+	assert.NoError(t, HotstuffHeaderFillWithParentSeal(header, parent))
+
+	extra, err := ExtractHotstuffExtra(header)
+	assert.NoError(t, err)
+	assert.True(t, extra.HasParentSeal())
+	assert.False(t, extra.IsAggregated())
+	assert.Equal(t, parentExtra.AggregatedSeal, extra.ParentAggregatedSeal)
+	assert.Equal(t, parentExtra.ParticipantBitmap, extra.ParentParticipantBitmap)
+
+	// parent seal is covered by the hash while the committed seals are not
+	hash := header.Hash()
+	assert.NotEqual(t, unsealed, hash)
+	extra.CommittedSeal = [][]byte{[]byte("12"), []byte("13")}
+	payload, err = rlp.EncodeToBytes(extra)
+	assert.NoError(t, err)
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	assert.Equal(t, hash, header.Hash())
+
+	extra.ParentParticipantBitmap = []byte{0x0b}
+	payload, err = rlp.EncodeToBytes(extra)
+	assert.NoError(t, err)
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	assert.NotEqual(t, hash, header.Hash())
+}
+
+func TestExtraBLSKeys(t *testing.T) {
+	vals := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	header := &Header{Number: common.Big1, MixDigest: HotstuffDigest}
+	assert.NoError(t, HotstuffHeaderFillWithValidators(header, vals))
+	withoutKeys := header.Hash()
+
+	assert.Error(t, HotstuffHeaderFillWithBLSKeys(header, [][]byte{[]byte("key1")}, [][]byte{[]byte("proof1")}))
+	keys := [][]byte{[]byte("key1"), []byte("key2")}
+	proofs := [][]byte{[]byte("proof1"), []byte("proof2")}
+	assert.NoError(t, HotstuffHeaderFillWithBLSKeys(header, keys, proofs))
+	assert.NotEqual(t, withoutKeys, header.Hash())
+
+	// keys are kept with the preceding optional fields empty
+	extra, err := ExtractHotstuffExtra(header)
+	assert.NoError(t, err)
+	assert.True(t, extra.HasBLSKeys())
+	assert.False(t, extra.HasParentSeal())
+	assert.False(t, extra.IsAggregated())
+	assert.Equal(t, vals, extra.Validators)
+	assert.Equal(t, keys, extra.ValidatorBLSKeys)
+	assert.Equal(t, proofs, extra.ValidatorBLSProofs)
+}
@@ -23,8 +23,11 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core/state"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
@@ -221,6 +224,10 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	if value.Sign() != 0 && !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
 	}
+	// Fail if internal value transfer is forbidden by maas_config, the top-level one has been checked in state transition
+	if err := evm.checkInternalTransfer(caller.Address(), addr, value); err != nil {
+		return nil, gas, err
+	}
 	snapshot := evm.StateDB.Snapshot()
 	p, isPrecompile := evm.precompile(addr)
 
@@ -246,7 +253,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 	}
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, value)
 	} else {
 		if isPrecompile {
 			ret, gas, err = RunPrecompiledContract(p, input, gas)
@@ -305,10 +312,14 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, gas, ErrInsufficientBalance
 	}
+	// Fail if the value attached to the call is forbidden by maas_config
+	if err := evm.checkInternalTransfer(caller.Address(), addr, value); err != nil {
+		return nil, gas, err
+	}
 	var snapshot = evm.StateDB.Snapshot()
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		// It is allowed to call precompiles, even via delegatecall
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
@@ -349,7 +360,7 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 	var snapshot = evm.StateDB.Snapshot()
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		// It is allowed to call precompiles, even via delegatecall
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
@@ -399,7 +410,7 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 	evm.StateDB.AddBalance(addr, big0)
 
 	if native.IsNativeContract(addr) {
-		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas)
+		ret, gas, err = evm.nativeCall(caller.Address(), addr, input, gas, nil)
 	} else {
 		if p, isPrecompile := evm.precompile(addr); isPrecompile {
 			ret, gas, err = RunPrecompiledContract(p, input, gas)
@@ -433,10 +444,12 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 // `call`, `staticCall`, `delegateCall` and `callCode`, because the context of native contract contains
 // the entire stateDB, and there is no need to find the safe caller's memory storage in calling operation.
 //
-// In addition, the gas of native call temporarily uses a fixed value
+// In addition, the gas of native call uses a fixed value per method before the native gas meter fork,
+// and is charged by storage operations, input bytes and events after it. The value of call has been
+// transferred to the native contract before, it's exposed as `msg.value` of the entry contract.
 //
 // todo(fuk): try to test precompile and ensure that `nativeCall` is safe enough
-func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGas uint64) (ret []byte, leftOverGas uint64, err error) {
+func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGas uint64, value *big.Int) (ret []byte, leftOverGas uint64, err error) {
 	sdb := evm.StateDB.(*state.StateDB)
 	blockNumber := evm.Context.BlockNumber
 
@@ -446,11 +459,58 @@ func (evm *EVM) nativeCall(caller, addr common.Address, input []byte, suppliedGa
 		msgSender = evm.TxContext.Origin
 	}
 	contractRef := native.NewContractRef(sdb, msgSender, caller, blockNumber, txHash, suppliedGas, evm.Callback)
+	if evm.chainConfig.HotStuff.IsNativeGasMeter(blockNumber) {
+		contractRef.EnableGasMeter()
+	}
+	if evm.chainConfig.HotStuff.IsNativeRevert(blockNumber) {
+		contractRef.EnableRevert()
+	}
+	if evm.chainConfig.HotStuff.IsElection(blockNumber) {
+		contractRef.EnableElection()
+	}
+	if evm.chainConfig.HotStuff.IsAggregateSeal(blockNumber) {
+		contractRef.EnableBLSKeys()
+	}
+	// staking contract takes the backup address extra2, which stays unavailable before the staking fork.
+	if !evm.chainConfig.HotStuff.IsStaking(blockNumber) {
+		contractRef.DisableContract(utils.StakingContractAddress)
+	}
+	// native registry takes the backup address extra1, the versions approved in it are dispatched after the fork.
+	if evm.chainConfig.HotStuff.IsNativeRegistry(blockNumber) {
+		contractRef.EnableVersioning()
+	} else {
+		contractRef.DisableContract(utils.NativeRegistryContractAddress)
+	}
+	contractRef.SetValue(value)
+	contractRef.SetChainID(evm.chainConfig.ChainID)
 
 	ret, leftOverGas, err = contractRef.NativeCall(caller, addr, input)
+	if err != nil && evm.chainConfig.HotStuff.IsNativeRevert(blockNumber) {
+		// state changes of the failed frame have been reverted in native call, surface the error
+		// as solidity revert reason, and only out of gas consumes all gas left.
+		if err == native.ErrOutOfGas {
+			return nil, 0, ErrOutOfGas
+		}
+		if reason, packErr := abi.PackRevert(err.Error()); packErr == nil {
+			return reason, leftOverGas, ErrExecutionReverted
+		}
+	}
 	return
 }
 
+// checkInternalTransfer applies the blacklist and gas manager rules of maas_config to value transfers of
+// internal calls, creations and self-destructs after the fork.
+func (evm *EVM) checkInternalTransfer(from, to common.Address, value *big.Int) error {
+	if evm.depth == 0 || value.Sign() == 0 || !evm.chainConfig.HotStuff.IsMaasInternalTransfer(evm.Context.BlockNumber) {
+		return nil
+	}
+	sdb, ok := evm.StateDB.(*state.StateDB)
+	if !ok {
+		return nil
+	}
+	return native_client.CheckTransfer(sdb, from, &to, value)
+}
+
 // Callback used when the native contract call back the evm contracts.
 func (evm *EVM) Callback(nativeCaller, addr common.Address, input []byte) (ret []byte, leftOverGas uint64, err error) {
 	accRef := AccountRef(nativeCaller)
@@ -479,6 +539,10 @@ func (evm *EVM) create(caller ContractRef, codeAndHash *codeAndHash, gas uint64,
 	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
+	// Fail if the endowment is forbidden by maas_config, the top-level creation has been checked in state transition
+	if err := evm.checkInternalTransfer(caller.Address(), address, value); err != nil {
+		return nil, common.Address{}, gas, err
+	}
 	nonce := evm.StateDB.GetNonce(caller.Address())
 	evm.StateDB.SetNonce(caller.Address(), nonce+1)
 	// We add this to the access list _before_ taking a snapshot. Even if the creation fails,
@@ -27,12 +27,17 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
+	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/asm"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -688,3 +693,81 @@ func TestColdAccountAccessCost(t *testing.T) {
 		}
 	}
 }
+
+// TestMaasConfigInternalTransfer tests that the maas_config blacklist is applied to the value transferred
+// by CREATE, CALLCODE and SELFDESTRUCT inside contracts after the fork.
+func TestMaasConfigInternalTransfer(t *testing.T) {
+	maas_config.InitMaasConfig()
+
+	var (
+		owner    = common.HexToAddress("0x01")
+		blocked  = common.HexToAddress("0x02")
+		address  = common.BytesToAddress([]byte("contract"))
+		created  = crypto.CreateAddress(address, 0)
+		balance  = big.NewInt(10)
+		retValue = []byte{byte(vm.PUSH1), 0, byte(vm.MSTORE), byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN)}
+	)
+	newState := func(target common.Address) *state.StateDB {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		for _, input := range []interface{ Encode() ([]byte, error) }{
+			&maas_config.MethodChangeOwnerInput{Addr: owner},
+			&maas_config.MethodBlockAccountInput{Addr: target, DoBlock: true},
+		} {
+			payload, err := input.Encode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ref := native.NewContractRef(statedb, owner, owner, big.NewInt(1), common.EmptyHash, 100000000, nil)
+			if _, _, err := ref.NativeCall(owner, utils.MaasConfigContractAddress, payload); err != nil {
+				t.Fatal(err)
+			}
+		}
+		statedb.AddBalance(address, balance)
+		return statedb
+	}
+	newConfig := func(statedb *state.StateDB, forked bool) *Config {
+		cfg := &Config{State: statedb}
+		setDefaults(cfg)
+		if forked {
+			cfg.ChainConfig.HotStuff = &params.HotStuffConfig{MaasInternalTransferBlock: big.NewInt(0)}
+		}
+		return cfg
+	}
+
+	selfdestruct := append(append([]byte{byte(vm.PUSH20)}, blocked.Bytes()...), byte(vm.SELFDESTRUCT))
+	create := append([]byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 1, byte(vm.CREATE)}, retValue...)
+	callcode := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 1, byte(vm.PUSH20)}
+	callcode = append(append(append(callcode, blocked.Bytes()...), byte(vm.GAS), byte(vm.CALLCODE)), retValue...)
+
+	for _, forked := range []bool{false, true} {
+		// SELFDESTRUCT to the blocked beneficiary
+		statedb := newState(blocked)
+		_, _, err := Execute(selfdestruct, nil, newConfig(statedb, forked))
+		if forked && err != native_client.ErrAccountBlocked {
+			t.Errorf("forked selfdestruct: have %v, want %v", err, native_client.ErrAccountBlocked)
+		}
+		if !forked && (err != nil || statedb.GetBalance(blocked).Cmp(balance) != 0) {
+			t.Errorf("selfdestruct: err %v, beneficiary balance %v", err, statedb.GetBalance(blocked))
+		}
+
+		// CREATE with endowment to the blocked contract address
+		statedb = newState(created)
+		ret, _, err := Execute(create, nil, newConfig(statedb, forked))
+		if err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		if success := common.BytesToAddress(ret) == created; success == forked {
+			t.Errorf("create forked %v: have created %v", forked, success)
+		}
+
+		// CALLCODE with value to the blocked address
+		statedb = newState(blocked)
+		ret, _, err = Execute(callcode, nil, newConfig(statedb, forked))
+		if err != nil {
+			t.Fatalf("callcode: %v", err)
+		}
+		if success := new(big.Int).SetBytes(ret).Sign() != 0; success == forked {
+			t.Errorf("callcode forked %v: have success %v", forked, success)
+		}
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/contracts/native/native_client"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -92,7 +93,8 @@ type Ethereum struct {
 	networkID     uint64
 	netRPCService *ethapi.PublicNetAPI
 
-	p2pServer *p2p.Server
+	p2pServer          *p2p.Server
+	closeNodeWhitelist chan struct{}
 
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price and etherbase)
 }
@@ -131,7 +133,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
-	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlockWithOverride(chainDb, config.Genesis, config.OverrideLondon)
+	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlockWithOverride(chainDb, config.Genesis, config.OverrideLondon, config.OverrideHotStuffFork)
 	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
 		return nil, genesisErr
 	}
@@ -140,12 +142,16 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
 		log.Error("Failed to recover state", "error", err)
 	}
+	engine, err := ethconfig.CreateConsensusEngine(stack, chainConfig, &ethashConfig, &config.HotStuff, config.Miner.Notify, config.Miner.Noverify, chainDb)
+	if err != nil {
+		return nil, err
+	}
 	eth := &Ethereum{
 		config:            config,
 		chainDb:           chainDb,
 		eventMux:          stack.EventMux(),
 		accountManager:    stack.AccountManager(),
-		engine:            ethconfig.CreateConsensusEngine(stack, chainConfig, &ethashConfig, config.Miner.Notify, config.Miner.Noverify, chainDb),
+		engine:            engine,
 		closeBloomHandler: make(chan struct{}),
 		networkID:         config.NetworkId,
 		gasPrice:          config.Miner.GasPrice,
@@ -153,6 +159,8 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		bloomRequests:     make(chan chan *bloombits.Retrieval),
 		bloomIndexer:      core.NewBloomIndexer(chainDb, params.BloomBitsBlocks, params.BloomConfirms),
 		p2pServer:         stack.Server(),
+
+		closeNodeWhitelist: make(chan struct{}),
 	}
 
 	bcVersion := rawdb.ReadDatabaseVersion(chainDb)
@@ -207,8 +215,8 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	}
 	eth.txPool = core.NewTxPool(config.TxPool, chainConfig, eth.blockchain)
 
-	// set blockchain for p2pServer
-	eth.p2pServer.Chain = eth.blockchain
+	// apply the node whitelist of latest state before the p2p server accepts any peer
+	eth.updateNodeWhitelist(eth.blockchain.CurrentBlock())
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
@@ -549,9 +557,40 @@ func (s *Ethereum) Start() error {
 	}
 	// Start the networking layer and the light server if requested
 	s.handler.Start(maxPeers)
+
+	go s.nodeWhitelistLoop()
 	return nil
 }
 
+// nodeWhitelistLoop keeps the node whitelist of p2p server in sync with the maas_config
+// contract, so that the peers removed from whitelist are dropped once the change is imported.
+func (s *Ethereum) nodeWhitelistLoop() {
+	headCh := make(chan core.ChainHeadEvent, 10)
+	sub := s.blockchain.SubscribeChainHeadEvent(headCh)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-headCh:
+			s.updateNodeWhitelist(ev.Block)
+		case <-sub.Err():
+			return
+		case <-s.closeNodeWhitelist:
+			return
+		}
+	}
+}
+
+// updateNodeWhitelist reads the node whitelist from the state of block and applies it to p2p server.
+func (s *Ethereum) updateNodeWhitelist(block *types.Block) {
+	statedb, err := s.blockchain.StateAt(block.Root())
+	if err != nil {
+		log.Warn("Failed to load node whitelist", "number", block.NumberU64(), "err", err)
+		return
+	}
+	s.p2pServer.SetNodeWhitelist(native_client.IsNodeWhiteEnabled(statedb), native_client.GetNodeWhitelist(statedb))
+}
+
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
@@ -559,6 +598,7 @@ func (s *Ethereum) Stop() error {
 	s.ethDialCandidates.Close()
 	s.snapDialCandidates.Close()
 	s.handler.Stop()
+	close(s.closeNodeWhitelist)
 
 	// Then stop everything else.
 	s.bloomIndexer.Close()
@@ -172,6 +172,9 @@ type Config struct {
 	// Ethash options
 	Ethash ethash.Config
 
+	// HotStuff options, the zero values keep the defaults of the protocol
+	HotStuff hotstuff.Config
+
 	// Transaction pool options
 	TxPool core.TxPoolConfig
 
@@ -205,18 +208,38 @@ type Config struct {
 
 	// Berlin block override (TODO: remove after the fork)
 	OverrideLondon *big.Int `toml:",omitempty"`
+
+	// HotStuff fork parameters override, persisted as part of the chain config
+	OverrideHotStuffFork *params.HotStuffFork `toml:",omitempty"`
 }
 
+// datadirBLSKey is the file of hotstuff BLS secret key in the instance directory.
+const datadirBLSKey = "blskey"
+
 // CreateConsensusEngine creates a consensus engine for the given chain configuration.
-func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, notify []string, noverify bool, db ethdb.Database) consensus.Engine {
+func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, config *ethash.Config, hotstuffConfig *hotstuff.Config, notify []string, noverify bool, db ethdb.Database) (consensus.Engine, error) {
 	// If proof-of-authority is requested, set it up
 	if chainConfig.Clique != nil {
-		return clique.New(chainConfig.Clique, db)
+		return clique.New(chainConfig.Clique, db), nil
 	}
 	if chainConfig.HotStuff != nil {
-		config := hotstuff.DefaultBasicConfig
+		config := *hotstuff.DefaultBasicConfig
+		if hotstuff.HotstuffProtocol(chainConfig.HotStuff.Protocol) == hotstuff.HOTSTUFF_PROTOCOL_EVENT_DRIVEN {
+			config = *hotstuff.DefaultEventDrivenConfig
+		}
+		config.Override(hotstuffConfig)
+		if config.BLSKeyFile == "" {
+			config.BLSKeyFile = stack.ResolvePath(datadirBLSKey)
+		}
+		// fork parameters are part of the chain config persisted with genesis
+		config.HotStuffConfig = chainConfig.HotStuff
+		config.ChainID = chainConfig.ChainID
 		nodeKey := stack.Config().NodeKey()
-		return hsb.New(config, nodeKey, db)
+		engine, err := hsb.New(&config, nodeKey, db)
+		if err != nil {
+			return nil, err
+		}
+		return engine, nil
 	}
 	// Otherwise assume proof-of-work
 	switch config.PowMode {
@@ -240,5 +263,5 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 		NotifyFull:       config.NotifyFull,
 	}, notify, noverify)
 	engine.SetThreads(-1) // Disable CPU mining
-	return engine
+	return engine, nil
 }
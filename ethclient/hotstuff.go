@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2021 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ethclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+)
+
+// HotstuffBlockSigners returns the proposer, committers, round and validator set of the block which decoded
+// from the header extra. The latest block is used if number is nil.
+func (ec *Client) HotstuffBlockSigners(ctx context.Context, number *big.Int) (*hotstuff.BlockSigners, error) {
+	var result *hotstuff.BlockSigners
+	if err := ec.c.CallContext(ctx, &result, "hotstuff_getBlockSigners", toBlockNumArg(number)); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ethereum.NotFound
+	}
+	return result, nil
+}
+
+// HotstuffParticipation returns the number of blocks proposed and signed by every validator in range [from, to].
+func (ec *Client) HotstuffParticipation(ctx context.Context, from, to *big.Int) (*hotstuff.ParticipationStats, error) {
+	var result *hotstuff.ParticipationStats
+	if err := ec.c.CallContext(ctx, &result, "hotstuff_getParticipation", toBlockNumArg(from), toBlockNumArg(to)); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, ethereum.NotFound
+	}
+	return result, nil
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (C) 2022 The Zion Authors
+ * This file is part of The Zion library.
+ *
+ * The Zion is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Zion is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Zion.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package ethclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSigners = &hotstuff.BlockSigners{
+	Number:           3,
+	Hash:             common.HexToHash("0x01"),
+	Proposer:         common.HexToAddress("0x02"),
+	Committers:       []common.Address{common.HexToAddress("0x02"), common.HexToAddress("0x03")},
+	EpochStartHeight: 1,
+	Validators:       []common.Address{common.HexToAddress("0x02"), common.HexToAddress("0x03"), common.HexToAddress("0x04")},
+}
+
+// testHotstuffService serves the signers of block 3 and latest block only.
+type testHotstuffService struct{}
+
+func (s *testHotstuffService) GetBlockSigners(number *rpc.BlockNumber) (*hotstuff.BlockSigners, error) {
+	if number == nil || *number == rpc.LatestBlockNumber || number.Int64() == 3 {
+		return testSigners, nil
+	}
+	return nil, nil
+}
+
+func (s *testHotstuffService) GetParticipation(from, to rpc.BlockNumber) (*hotstuff.ParticipationStats, error) {
+	if to > 3 {
+		return nil, nil
+	}
+	return &hotstuff.ParticipationStats{
+		From: hexutil.Uint64(from),
+		To:   hexutil.Uint64(to),
+		Validators: []*hotstuff.Participation{
+			{Address: common.HexToAddress("0x02"), Blocks: 3, Proposed: 2, Signed: 3},
+		},
+	}, nil
+}
+
+func newHotstuffTestClient(t *testing.T) *Client {
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("hotstuff", new(testHotstuffService)))
+	t.Cleanup(server.Stop)
+	return NewClient(rpc.DialInProc(server))
+}
+
+func TestHotstuffBlockSigners(t *testing.T) {
+	ec := newHotstuffTestClient(t)
+	defer ec.Close()
+
+	signers, err := ec.HotstuffBlockSigners(context.Background(), big.NewInt(3))
+	assert.NoError(t, err)
+	assert.Equal(t, testSigners, signers)
+
+	signers, err = ec.HotstuffBlockSigners(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, testSigners, signers)
+
+	_, err = ec.HotstuffBlockSigners(context.Background(), big.NewInt(4))
+	assert.Equal(t, ethereum.NotFound, err)
+}
+
+func TestHotstuffParticipation(t *testing.T) {
+	ec := newHotstuffTestClient(t)
+	defer ec.Close()
+
+	stats, err := ec.HotstuffParticipation(context.Background(), big.NewInt(1), big.NewInt(3))
+	assert.NoError(t, err)
+	assert.Equal(t, hexutil.Uint64(1), stats.From)
+	assert.Equal(t, hexutil.Uint64(3), stats.To)
+	assert.Equal(t, []*hotstuff.Participation{
+		{Address: common.HexToAddress("0x02"), Blocks: 3, Proposed: 2, Signed: 3},
+	}, stats.Validators)
+
+	_, err = ec.HotstuffParticipation(context.Background(), big.NewInt(1), big.NewInt(4))
+	assert.Equal(t, ethereum.NotFound, err)
+}
@@ -37,6 +37,14 @@ type KeyValueWriter interface {
 	Delete(key []byte) error
 }
 
+// KeyValueSyncWriter wraps the PutSync method of a backing data store which is able
+// to flush a single write to disk before returning.
+type KeyValueSyncWriter interface {
+	// PutSync inserts the given value into the key-value data store, and returns
+	// after the write is durable.
+	PutSync(key []byte, value []byte) error
+}
+
 // Stater wraps the Stat method of a backing data store.
 type Stater interface {
 	// Stat returns a particular internal stat of the database.
@@ -23,6 +23,7 @@ var Modules = map[string]string{
 	"chequebook": ChequebookJs,
 	"clique":     CliqueJs,
 	"ethash":     EthashJs,
+	"hotstuff":   HotstuffJs,
 	"debug":      DebugJs,
 	"eth":        EthJs,
 	"miner":      MinerJs,
@@ -118,6 +119,46 @@ web3._extend({
 });
 `
 
+const HotstuffJs = `
+web3._extend({
+	property: 'hotstuff',
+	methods: [
+		new web3._extend.Method({
+			name: 'getBlockSigners',
+			call: 'hotstuff_getBlockSigners',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getBlockSignersAtHash',
+			call: 'hotstuff_getBlockSignersAtHash',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getParticipation',
+			call: 'hotstuff_getParticipation',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getEvidences',
+			call: 'hotstuff_evidences',
+			params: 0
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'status',
+			getter: 'hotstuff_status'
+		}),
+		new web3._extend.Property({
+			name: 'epochs',
+			getter: 'hotstuff_epochs'
+		}),
+	]
+});
+`
+
 const EthashJs = `
 web3._extend({
 	property: 'ethash',
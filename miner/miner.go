@@ -54,6 +54,7 @@ type Config struct {
 	GasPrice   *big.Int       // Minimum gas price for mining a transaction
 	Recommit   time.Duration  // The time interval for miner to re-create mining work.
 	Noverify   bool           // Disable remote mining solution verification(only useful in ethash).
+	TxOrdering string         `toml:",omitempty"` // Policy ordering the transactions of mined blocks unless the chain config enforces one (price, fifo, fairshare or gasmanager)
 }
 
 // Miner creates blocks and searches for proof-of-work values.
@@ -29,6 +29,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/contracts/native"
+	"github.com/ethereum/go-ethereum/contracts/native/governance/maas_config"
 	nm "github.com/ethereum/go-ethereum/contracts/native/governance/node_manager"
 	"github.com/ethereum/go-ethereum/contracts/native/utils"
 	"github.com/ethereum/go-ethereum/core"
@@ -97,6 +98,8 @@ type environment struct {
 	header   *types.Header
 	txs      []*types.Transaction
 	receipts []*types.Receipt
+
+	chain *consensus.PendingChain // the chain extended with certified blocks which the header is built on
 }
 
 // task contains all information for consensus engine sealing and result submitting.
@@ -132,6 +135,7 @@ type worker struct {
 	config      *Config
 	chainConfig *params.ChainConfig
 	engine      consensus.Engine
+	ordering    core.TxOrdering
 	eth         Backend
 	chain       *core.BlockChain
 
@@ -148,6 +152,8 @@ type worker struct {
 	chainSideSub   event.Subscription
 	epochChangeCh  chan types.EpochChangeEvent
 	epochChangeSub event.Subscription
+	certifiedCh    chan *types.Block
+	certifiedSub   event.Subscription
 
 	nextEpoch *types.EpochChangeEvent
 	epochMu   sync.Mutex
@@ -215,6 +221,7 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		chainHeadCh:        make(chan core.ChainHeadEvent, chainHeadChanSize),
 		chainSideCh:        make(chan core.ChainSideEvent, chainSideChanSize),
 		epochChangeCh:      make(chan types.EpochChangeEvent, epochChangeChanSize),
+		certifiedCh:        make(chan *types.Block, chainHeadChanSize),
 		newWorkCh:          make(chan *newWorkReq),
 		taskCh:             make(chan *task),
 		resultCh:           make(chan *types.Block, resultQueueSize),
@@ -223,13 +230,16 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		resubmitIntervalCh: make(chan time.Duration),
 		resubmitAdjustCh:   make(chan *intervalAdjust, resubmitAdjustChanSize),
 	}
-	worker.loadForkConfig()
 	// Subscribe NewTxsEvent for tx pool
 	worker.txsSub = eth.TxPool().SubscribeNewTxsEvent(worker.txsCh)
 	// Subscribe events for blockchain
 	worker.chainHeadSub = eth.BlockChain().SubscribeChainHeadEvent(worker.chainHeadCh)
 	worker.chainSideSub = eth.BlockChain().SubscribeChainSideEvent(worker.chainSideCh)
 	worker.epochChangeSub = nm.SubscribeEpochChange(worker.epochChangeCh)
+	// Subscribe blocks certified by hotstuff validators, the next block is built before they are inserted
+	if engine, ok := engine.(consensus.HotStuff); ok {
+		worker.certifiedSub = engine.SubscribeCertifiedHead(worker.certifiedCh)
+	}
 
 	// Sanitize recommit interval if the user-specified one is too short.
 	recommit := worker.config.Recommit
@@ -237,6 +247,13 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		log.Warn("Sanitizing miner recommit interval", "provided", recommit, "updated", minRecommitInterval)
 		recommit = minRecommitInterval
 	}
+	// Sanitize transaction ordering policy if the user-specified one is unknown.
+	ordering, err := core.NewTxOrdering(worker.config.TxOrdering)
+	if err != nil {
+		log.Warn("Sanitizing miner transaction ordering", "provided", worker.config.TxOrdering, "updated", core.TxOrderingPrice, "err", err)
+		ordering, _ = core.NewTxOrdering(core.TxOrderingPrice)
+	}
+	worker.ordering = ordering
 
 	worker.initChangingEpoch()
 
@@ -411,10 +428,15 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 			if h, ok := w.engine.(consensus.Handler); ok {
 				h.NewChainHead(head.Block.Header())
 			}
+			w.notifyRotatedEpoch(head.Block)
 			clearPending(head.Block.NumberU64())
 			timestamp = time.Now().Unix()
 			commit(false, commitInterruptNewHead)
 
+		case <-w.certifiedCh:
+			timestamp = time.Now().Unix()
+			commit(false, commitInterruptNewHead)
+
 		case change := <-w.epochChangeCh:
 			log.Debug("[miner worker]", "receive epoch change event", change.Hash.Hex(), "ID", change.EpochID)
 			w.processEpochChange(&change)
@@ -471,6 +493,9 @@ func (w *worker) mainLoop() {
 	defer w.txsSub.Unsubscribe()
 	defer w.chainHeadSub.Unsubscribe()
 	defer w.chainSideSub.Unsubscribe()
+	if w.certifiedSub != nil {
+		defer w.certifiedSub.Unsubscribe()
+	}
 
 	for {
 		select {
@@ -536,7 +561,7 @@ func (w *worker) mainLoop() {
 					acc, _ := types.Sender(w.current.signer, tx)
 					txs[acc] = append(txs[acc], tx)
 				}
-				txset := types.NewTransactionsByPriceAndNonce(w.current.signer, txs)
+				txset := w.txOrdering(w.current.header.Number).Order(w.current.signer, w.current.state, nil, txs)
 				tcount := w.current.tcount
 				w.commitTransactions(txset, coinbase, nil)
 				// Only update the snapshot if any new transactons were added
@@ -659,6 +684,11 @@ func (w *worker) resultLoop() {
 				}
 				logs = append(logs, receipt.Logs...)
 			}
+			// Logs emitted out of transactions in finalization are delivered with the block logs.
+			for _, log := range task.state.GetLogs(common.Hash{}) {
+				log.BlockHash = hash
+				logs = append(logs, log)
+			}
 			// Commit block and state to database.
 			_, err := w.chain.WriteBlockWithState(block, receipts, logs, task.state, true)
 			if err != nil {
@@ -681,7 +711,7 @@ func (w *worker) resultLoop() {
 }
 
 // makeCurrent creates a new environment for the current cycle.
-func (w *worker) makeCurrent(parent *types.Block, header *types.Header) error {
+func (w *worker) makeCurrent(parent *types.Block, header *types.Header, chain *consensus.PendingChain) error {
 	// Retrieve the parent state to execute on top and start a prefetcher for
 	// the miner to speed block sealing up a bit
 	state, err := w.chain.StateAt(parent.Root())
@@ -697,6 +727,7 @@ func (w *worker) makeCurrent(parent *types.Block, header *types.Header) error {
 		family:    mapset.NewSet(),
 		uncles:    mapset.NewSet(),
 		header:    header,
+		chain:     chain,
 	}
 	// when 08 is processed ancestors contain 07 (quick block)
 	for _, ancestor := range w.chain.GetBlocksFromHash(parent.Hash(), 7) {
@@ -773,7 +804,7 @@ func (w *worker) updateSnapshot() {
 func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Address) ([]*types.Log, error) {
 	snap := w.current.state.Snapshot()
 
-	receipt, err := core.ApplyTransaction(w.chainConfig, w.chain, &coinbase, w.current.gasPool, w.current.state, w.current.header, tx, &w.current.header.GasUsed, *w.chain.GetVMConfig())
+	receipt, err := core.ApplyTransaction(w.chainConfig, w.current.chain, &coinbase, w.current.gasPool, w.current.state, w.current.header, tx, &w.current.header.GasUsed, *w.chain.GetVMConfig())
 	if err != nil {
 		w.current.state.RevertToSnapshot(snap)
 		return nil, err
@@ -784,7 +815,21 @@ func (w *worker) commitTransaction(tx *types.Transaction, coinbase common.Addres
 	return receipt.Logs, nil
 }
 
-func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coinbase common.Address, interrupt *int32) bool {
+// txOrdering returns the policy filling the block at number, the one enforced by
+// the chain config takes precedence over the locally configured one.
+func (w *worker) txOrdering(number *big.Int) core.TxOrdering {
+	if !w.chainConfig.HotStuff.IsTxOrdering(number) {
+		return w.ordering
+	}
+	ordering, err := core.NewVerifiableTxOrdering(w.chainConfig.HotStuff.TxOrdering)
+	if err != nil {
+		log.Error("Invalid enforced transaction ordering", "err", err)
+		return w.ordering
+	}
+	return ordering
+}
+
+func (w *worker) commitTransactions(txs core.TransactionsIterator, coinbase common.Address, interrupt *int32) bool {
 	// Short circuit if current is nil
 	if w.current == nil {
 		return true
@@ -904,13 +949,34 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 
 var isForkingEpochChanged bool = false
 
+// parentBlock returns the block which the new work is built on, it's the latest block certified by hotstuff
+// validators if it is higher than the chain head. Headers of the certified blocks not inserted yet are
+// returned as well.
+func (w *worker) parentBlock() (*types.Block, []*types.Header) {
+	head := w.chain.CurrentBlock()
+	engine, ok := w.engine.(consensus.HotStuff)
+	if !ok {
+		return head, nil
+	}
+	blocks := engine.CertifiedBlocks()
+	if len(blocks) == 0 || blocks[len(blocks)-1].NumberU64() <= head.NumberU64() {
+		return head, nil
+	}
+	headers := make([]*types.Header, len(blocks))
+	for i, block := range blocks {
+		headers[i] = block.Header()
+	}
+	return blocks[len(blocks)-1], headers
+}
+
 // commitNewWork generates several new sealing tasks based on the parent block.
 func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
 	tstart := time.Now()
-	parent := w.chain.CurrentBlock()
+	parent, certified := w.parentBlock()
+	chain := consensus.NewPendingChain(w.chain, w.engine, certified)
 	if parent.Time() >= uint64(timestamp) {
 		timestamp = int64(parent.Time() + 1)
 	}
@@ -940,7 +1006,7 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		header.Coinbase = w.coinbase
 		log.Trace("commitNewWork", "number", num.Uint64())
 	}
-	if err := w.engine.Prepare(w.chain, header); err != nil {
+	if err := w.engine.Prepare(chain, header); err != nil {
 		log.Error("Failed to prepare header for mining", "err", err)
 		return
 	}
@@ -958,7 +1024,7 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		}
 	}
 	// Could potentially happen if starting to mine in an odd state.
-	err := w.makeCurrent(parent, header)
+	err := w.makeCurrent(parent, header, chain)
 	if err != nil {
 		log.Error("Failed to create mining context", "err", err)
 		return
@@ -968,6 +1034,9 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	if w.chainConfig.DAOForkSupport && w.chainConfig.DAOForkBlock != nil && w.chainConfig.DAOForkBlock.Cmp(header.Number) == 0 {
 		misc.ApplyDAOHardFork(env.state)
 	}
+	if w.chainConfig.HotStuff.IsMaasListMigration(header.Number) {
+		maas_config.MigrateAddressLists(env.state)
+	}
 	// Accumulate the uncles for the current block
 	uncles := make([]*types.Header, 0, 2)
 	commitUncles := func(blocks map[common.Hash]*types.Block) {
@@ -1012,14 +1081,8 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 			localTxs[account] = txs
 		}
 	}
-	if len(localTxs) > 0 {
-		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, localTxs)
-		if w.commitTransactions(txs, w.coinbase, interrupt) {
-			return
-		}
-	}
-	if len(remoteTxs) > 0 {
-		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, remoteTxs)
+	if len(localTxs) > 0 || len(remoteTxs) > 0 {
+		txs := w.txOrdering(header.Number).Order(w.current.signer, w.current.state, localTxs, remoteTxs)
 		if w.commitTransactions(txs, w.coinbase, interrupt) {
 			return
 		}
@@ -1033,7 +1096,7 @@ func (w *worker) commit(uncles []*types.Header, interval func(), update bool, st
 	// Deep copy receipts here to avoid interaction between different tasks.
 	receipts := copyReceipts(w.current.receipts)
 	s := w.current.state.Copy()
-	block, err := w.engine.FinalizeAndAssemble(w.chain, w.current.header, s, w.current.txs, uncles, receipts)
+	block, err := w.engine.FinalizeAndAssemble(w.current.chain, w.current.header, s, w.current.txs, uncles, receipts)
 	if err != nil {
 		return err
 	}
@@ -1121,15 +1184,35 @@ func (w *worker) initChangingEpoch() {
 	}
 
 	epoch := output.Epoch
+	keys, proofs := epoch.BLSKeys()
 	w.nextEpoch = &types.EpochChangeEvent{
-		EpochID:     epoch.ID,
-		StartHeight: epoch.StartHeight,
-		Validators:  epoch.MemberList(),
-		Hash:        epoch.Hash(),
+		EpochID:       epoch.ID,
+		StartHeight:   epoch.StartHeight,
+		Validators:    epoch.MemberList(),
+		Hash:          epoch.Hash(),
+		BLSPublicKeys: keys,
+		BLSProofs:     proofs,
 	}
 	log.Info("[miner worker]", "miner will changing epoch", epoch.String())
 }
 
+// notifyRotatedEpoch emits the epoch change event if the epoch is rotated automatically in the new chain
+// head, the event is received by the buffered epochChangeCh in the same loop.
+func (w *worker) notifyRotatedEpoch(block *types.Block) {
+	cfg := w.chainConfig.HotStuff
+	if !cfg.IsEpochRotation(block.Number()) {
+		return
+	}
+	statedb, err := w.chain.StateAt(block.Root())
+	if err != nil {
+		log.Error("[miner worker]", "get state of rotated epoch failed", err)
+		return
+	}
+	if err := nm.NotifyRotatedEpoch(statedb, block.NumberU64(), cfg.EpochRotationBlock.Uint64(), cfg.EpochLength); err != nil {
+		log.Error("[miner worker]", "notify rotated epoch failed", err)
+	}
+}
+
 func (w *worker) processEpochChange(event *types.EpochChangeEvent) {
 	w.epochMu.Lock()
 	defer w.epochMu.Unlock()
@@ -1162,21 +1245,24 @@ func (w *worker) checkEpoch(st *state.StateDB, blockNum uint64) {
 
 func (w *worker) beforeEpochChange(h *types.Header) {
 	height := h.Number.Uint64()
-	if height == w.chainConfig.HotStuff.ForkHeight && !isForkingEpochChanged {
+	if w.chainConfig.HotStuff.IsForkHeight(height) && !isForkingEpochChanged {
 		log.Debug("[beforeEpochChange]", "change validators at the starting forking block heihgt", height)
-		forkingValidators := make([]common.Address, 0)
-		for _, validator := range w.chainConfig.HotStuff.ForkValidators {
-			forkingValidators = append(forkingValidators, common.HexToAddress(validator))
-		}
 		w.nextEpoch = new(types.EpochChangeEvent)
 		w.nextEpoch.EpochID = w.chainConfig.HotStuff.ForkEpochId
-		w.nextEpoch.Validators = forkingValidators
+		w.nextEpoch.Validators = w.chainConfig.HotStuff.Validators()
 		w.nextEpoch.StartHeight = w.chainConfig.HotStuff.ForkHeight
 		types.HotstuffHeaderFillWithValidators(h, w.nextEpoch.Validators)
 	}
 	if w.nextEpoch != nil && w.nextEpoch.Validators != nil && nm.EpochChangeAtNextBlock(height, w.nextEpoch.StartHeight) {
 		log.Debug("[beforeEpochChange]", "hotstuffHeaderFillWithValidators", w.nextEpoch.Validators)
 		types.HotstuffHeaderFillWithValidators(h, w.nextEpoch.Validators)
+		// BLS keys are announced with validators, so that the aggregated committed seals are verifiable
+		// by the nodes syncing headers only.
+		if len(w.nextEpoch.BLSPublicKeys) > 0 {
+			if err := types.HotstuffHeaderFillWithBLSKeys(h, w.nextEpoch.BLSPublicKeys, w.nextEpoch.BLSProofs); err != nil {
+				log.Error("[beforeEpochChange]", "fill BLS keys failed", err)
+			}
+		}
 	}
 }
 
@@ -1202,27 +1288,16 @@ func (w *worker) handleEpochChange(st *state.StateDB, height uint64) {
 		return
 	}
 
-	log.Debug("Restart consensus engine", "next epoch validators", w.nextEpoch.Validators)
-	w.Stop()
+	// consensus engine picks up the validators of new epoch when it enters the start height, the epoch
+	// is usually announced by the parent header already, and saving it again is a no-op.
+	log.Debug("Change epoch", "next epoch validators", w.nextEpoch.Validators)
 	if err := engine.ChangeEpoch(w.nextEpoch.StartHeight, w.nextEpoch.Validators); err != nil {
 		log.Error("Change Epoch", "change failed", err)
 		return
 	}
-	time.Sleep(30 * time.Second)
 	isForkingEpochChanged = true
-	w.Start()
 }
 
 func (w *worker) clearEpoch() {
 	w.nextEpoch = nil
 }
-
-func (w *worker) loadForkConfig() {
-	w.chainConfig.HotStuff = new(params.HotStuffConfig)
-	defaultForkFilePath := "maasfork.json"
-	err := w.chainConfig.HotStuff.LoadForkConfig(defaultForkFilePath)
-	if err != nil {
-		log.Error("Load fork config file", "loading failed", err)
-		panic(err)
-	}
-}
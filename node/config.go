@@ -92,7 +92,8 @@ type Config struct {
 	// InsecureUnlockAllowed allows user to unlock accounts in unsafe http environment.
 	InsecureUnlockAllowed bool `toml:",omitempty"`
 
-	// Node whitelist config file path.
+	// Deprecated: the node whitelist is governed by maas config contract, the field is
+	// only kept so that old config files can still be loaded.
 	NodeWhitePath string `toml:",omitempty"`
 
 	// NoUSB disables hardware wallet monitoring and connectivity.
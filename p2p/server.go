@@ -31,8 +31,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/mclock"
-	"github.com/ethereum/go-ethereum/core/state"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -41,7 +39,6 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
-	"github.com/ethereum/go-ethereum/params"
 )
 
 const (
@@ -67,7 +64,10 @@ const (
 	frameWriteTimeout = 20 * time.Second
 )
 
-var errServerStopped = errors.New("server stopped")
+var (
+	errServerStopped    = errors.New("server stopped")
+	errNodeNotWhitelist = errors.New("node address is not in whitelist")
+)
 
 // Config holds Server options.
 type Config struct {
@@ -161,22 +161,11 @@ type Config struct {
 	clock mclock.Clock
 }
 
-// blockChain provides the state of blockchain
-// some pre checks in p2p Server.
-type blockChain interface {
-	CurrentBlock() *types.Block
-	GetBlock(hash common.Hash, number uint64) *types.Block
-	StateAt(root common.Hash) (*state.StateDB, error)
-}
-
 // Server manages all peer connections.
 type Server struct {
 	// Config fields may not be modified while the server is running.
 	Config
 
-	// block chain
-	Chain blockChain
-
 	// Hooks for testing. These are useful because we can inhibit
 	// the whole protocol stack.
 	newTransport func(net.Conn, *ecdsa.PublicKey) transport
@@ -186,6 +175,9 @@ type Server struct {
 	lock    sync.Mutex // protects running
 	running bool
 
+	whitelistLock sync.RWMutex // protects whitelist
+	whitelist     *nodeWhitelist
+
 	listener     net.Listener
 	ourHandshake *protoHandshake
 	loopWG       sync.WaitGroup // loop, listenLoop
@@ -384,6 +376,43 @@ func (srv *Server) RemoveTrustedPeer(node *enode.Node) {
 	}
 }
 
+// SetNodeWhitelist replaces the node whitelist, only the nodes whose address is in addrs
+// are allowed to connect once the whitelist is enabled. Connected peers which are not in
+// the new whitelist are disconnected.
+func (srv *Server) SetNodeWhitelist(enabled bool, addrs []common.Address) {
+	whitelist := newNodeWhitelist(enabled, addrs)
+	srv.whitelistLock.Lock()
+	if whitelist.equal(srv.whitelist) {
+		srv.whitelistLock.Unlock()
+		return
+	}
+	srv.whitelist = whitelist
+	srv.whitelistLock.Unlock()
+
+	srv.lock.Lock()
+	running := srv.running
+	srv.lock.Unlock()
+	if !running {
+		return
+	}
+	srv.log.Info("Node whitelist updated", "enabled", enabled, "size", len(addrs))
+	if !enabled {
+		return
+	}
+	for _, p := range srv.Peers() {
+		if pubkey := p.Node().Pubkey(); pubkey == nil || !whitelist.contains(crypto.PubkeyToAddress(*pubkey)) {
+			p.log.Debug("Disconnecting peer not in node whitelist")
+			p.Disconnect(DiscUselessPeer)
+		}
+	}
+}
+
+func (srv *Server) isWhitelisted(addr common.Address) bool {
+	srv.whitelistLock.RLock()
+	defer srv.whitelistLock.RUnlock()
+	return srv.whitelist == nil || !srv.whitelist.enabled || srv.whitelist.contains(addr)
+}
+
 // SubscribePeers subscribes the given channel to peer events
 func (srv *Server) SubscribeEvents(ch chan *PeerEvent) event.Subscription {
 	return srv.peerFeed.Subscribe(ch)
@@ -976,10 +1005,9 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 
 	// check if address is in node whitelist
 	remoteAddr := crypto.PubkeyToAddress(*remotePubkey)
-	var nodeWhiteConfig = params.GetNodeWhiteConfig()
-	if !nodeWhiteConfig.CheckNodeWhitelist(&remoteAddr) {
-		srv.log.Trace("Node address not in whitelist", "addr", remoteAddr.String(), "err", params.ErrNodeWhitelist)
-		return params.ErrNodeWhitelist
+	if !srv.isWhitelisted(remoteAddr) {
+		srv.log.Trace("Node address not in whitelist", "addr", remoteAddr.String(), "err", errNodeNotWhitelist)
+		return errNodeNotWhitelist
 	}
 
 	if dialDest != nil {
@@ -1014,6 +1042,38 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 	return nil
 }
 
+// nodeWhitelist is a snapshot of the node addresses allowed to connect, all nodes are
+// allowed if it is disabled.
+type nodeWhitelist struct {
+	enabled bool
+	addrs   map[common.Address]struct{}
+}
+
+func newNodeWhitelist(enabled bool, addrs []common.Address) *nodeWhitelist {
+	w := &nodeWhitelist{enabled: enabled, addrs: make(map[common.Address]struct{}, len(addrs))}
+	for _, addr := range addrs {
+		w.addrs[addr] = struct{}{}
+	}
+	return w
+}
+
+func (w *nodeWhitelist) contains(addr common.Address) bool {
+	_, ok := w.addrs[addr]
+	return ok
+}
+
+func (w *nodeWhitelist) equal(other *nodeWhitelist) bool {
+	if other == nil || w.enabled != other.enabled || len(w.addrs) != len(other.addrs) {
+		return false
+	}
+	for addr := range w.addrs {
+		if !other.contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
 func nodeFromConn(pubkey *ecdsa.PublicKey, conn net.Conn) *enode.Node {
 	var ip net.IP
 	var port int
@@ -27,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/internal/testlog"
 	"github.com/ethereum/go-ethereum/log"
@@ -233,6 +234,55 @@ func TestServerRemovePeerDisconnect(t *testing.T) {
 	}
 }
 
+// This test checks that peers removed from the node whitelist are disconnected and
+// can not connect again.
+func TestServerNodeWhitelist(t *testing.T) {
+	srv1 := &Server{Config: Config{
+		PrivateKey:  newkey(),
+		MaxPeers:    1,
+		NoDiscovery: true,
+		Logger:      testlog.Logger(t, log.LvlTrace).New("server", "1"),
+	}}
+	srv2 := &Server{Config: Config{
+		PrivateKey:  newkey(),
+		MaxPeers:    1,
+		NoDiscovery: true,
+		NoDial:      true,
+		ListenAddr:  "127.0.0.1:0",
+		Logger:      testlog.Logger(t, log.LvlTrace).New("server", "2"),
+	}}
+	srv1.Start()
+	defer srv1.Stop()
+	srv2.Start()
+	defer srv2.Stop()
+
+	addr2 := crypto.PubkeyToAddress(srv2.PrivateKey.PublicKey)
+	srv1.SetNodeWhitelist(true, []common.Address{addr2})
+	if !syncAddPeer(srv1, srv2.Self()) {
+		t.Fatal("whitelisted peer not connected")
+	}
+
+	srv1.SetNodeWhitelist(true, nil)
+	deadline := time.Now().Add(time.Second)
+	for srv1.PeerCount() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("peer removed from whitelist still connected")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := srv1.SetupConn(mustDial(t, srv2.ListenAddr), dynDialedConn, srv2.Self()); err != errNodeNotWhitelist {
+		t.Fatalf("wrong setup error: got %v, want %v", err, errNodeNotWhitelist)
+	}
+}
+
+func mustDial(t *testing.T, addr string) net.Conn {
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	if err != nil {
+		t.Fatalf("could not dial: %v", err)
+	}
+	return conn
+}
+
 // This test checks that connections are disconnected just after the encryption handshake
 // when the server is at capacity. Trusted connections should still be accepted.
 func TestServerAtCap(t *testing.T) {
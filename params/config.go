@@ -17,6 +17,7 @@
 package params
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -24,6 +25,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -343,14 +345,211 @@ type ChainConfig struct {
 	HotStuff *HotStuffConfig `json:"hotstuff"`
 }
 
-// EthashConfig is the consensus engine configs for proof-of-work based sealing.
-// todo:
+// HotStuffFork is the validator set switched to at the fork height of a chain migrated to
+// HotStuff, together with the incentive minted to them at that block.
+type HotStuffFork struct {
+	ForkHeight     uint64   `json:"forkHeight"`     // block switching to the fork validators (0 = no fork)
+	ForkValidators []string `json:"forkValidators"` // validators of the forking epoch
+	ForkEpochId    uint64   `json:"forkEpochId"`    // id of the forking epoch
+	Incentive      uint64   `json:"incentive"`      // wei minted to every fork validator at fork height
+}
+
+// LoadHotStuffFork reads the HotStuff fork parameters from a json file, the file is only used
+// to override the parameters persisted with genesis.
+func LoadHotStuffFork(path string) (*HotStuffFork, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	fork := new(HotStuffFork)
+	if err := json.Unmarshal(data, fork); err != nil {
+		return nil, err
+	}
+	return fork, nil
+}
+
+// Validators returns the fork validators as addresses.
+func (f *HotStuffFork) Validators() []common.Address {
+	list := make([]common.Address, 0, len(f.ForkValidators))
+	for _, validator := range f.ForkValidators {
+		list = append(list, common.HexToAddress(validator))
+	}
+	return list
+}
+
+func (f *HotStuffFork) validate() error {
+	if f.ForkHeight == 0 {
+		return nil
+	}
+	if len(f.ForkValidators) == 0 {
+		return fmt.Errorf("hotstuff fork at %d without validators", f.ForkHeight)
+	}
+	seen := make(map[common.Address]struct{}, len(f.ForkValidators))
+	for _, validator := range f.ForkValidators {
+		if !common.IsHexAddress(validator) {
+			return fmt.Errorf("invalid hotstuff fork validator %q", validator)
+		}
+		addr := common.HexToAddress(validator)
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate hotstuff fork validator %s", addr.Hex())
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func (f *HotStuffFork) equal(other *HotStuffFork) bool {
+	if f.ForkHeight != other.ForkHeight || f.ForkEpochId != other.ForkEpochId || f.Incentive != other.Incentive {
+		return false
+	}
+	left, right := f.Validators(), other.Validators()
+	if len(left) != len(right) {
+		return false
+	}
+	for i := range left {
+		if left[i] != right[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HotStuffConfig is the consensus engine configs for HotStuff based sealing.
 type HotStuffConfig struct {
-	Protocol       string   `json:"protocol"`
-	ForkHeight     uint64   `json:"forkHeight"`
-	ForkValidators []string `json:"forkValidators"`
-	ForkEpochId    uint64   `json:"forkEpochId"`
-	Incentive      uint64   `json:"incentive"`
+	Protocol string `json:"protocol"`
+	HotStuffFork
+
+	VRFBlock           *big.Int                         `json:"vrfBlock,omitempty"`           // VRF proposer selection switch block (nil = no fork)
+	AggregateSealBlock *big.Int                         `json:"aggregateSealBlock,omitempty"` // BLS aggregated committed seal switch block (nil = no fork)
+	BLSPublicKeys      map[common.Address]hexutil.Bytes `json:"blsPublicKeys,omitempty"`      // validators' BLS public key used to verify aggregated committed seal, fallback of the keys published in node manager
+	BLSProofs          map[common.Address]hexutil.Bytes `json:"blsProofs,omitempty"`          // validators' proof of possession of BLS secret key, the signature of the public key
+
+	MaasConfigBlock           *big.Int `json:"maasConfigBlock,omitempty"`           // maas_config blacklist and gas manager enforced at block execution switch block (nil = tx pool only)
+	MaasInternalTransferBlock *big.Int `json:"maasInternalTransferBlock,omitempty"` // maas_config rules applied to value transfers of internal calls switch block (nil = no fork)
+	MaasListMigrationBlock    *big.Int `json:"maasListMigrationBlock,omitempty"`    // maas_config address lists migrated to per-address storage at this block (nil = no fork)
+	NativeGasMeterBlock       *big.Int `json:"nativeGasMeterBlock,omitempty"`       // native contracts metered by actual work switch block (nil = no fork)
+	NativeRevertBlock         *big.Int `json:"nativeRevertBlock,omitempty"`         // failed native calls surfaced as solidity revert switch block (nil = no fork)
+	NativeRegistryBlock       *big.Int `json:"nativeRegistryBlock,omitempty"`       // native contracts dispatched to versions approved in native registry switch block (nil = no fork)
+	StakingBlock              *big.Int `json:"stakingBlock,omitempty"`              // staking rewards distributed to committers switch block (nil = no fork)
+	StakingBlockReward        *big.Int `json:"stakingBlockReward,omitempty"`        // wei minted per block and shared by committers of the parent block
+	ElectionBlock             *big.Int `json:"electionBlock,omitempty"`             // epoch members elected from staking validators or registered candidates switch block (nil = any proposed peers)
+	EpochRotationBlock        *big.Int `json:"epochRotationBlock,omitempty"`        // validators rotated from stake ranking automatically switch block (nil = manual proposals only)
+	EpochLength               uint64   `json:"epochLength,omitempty"`               // blocks of each automatically rotated epoch counting from the rotation block
+	DowntimeJailBlock         *big.Int `json:"downtimeJailBlock,omitempty"`         // validators missing committed seals jailed switch block (nil = no fork)
+	TxOrderingBlock           *big.Int `json:"txOrderingBlock,omitempty"`           // transaction ordering policy enforced at block execution switch block (nil = no fork)
+	TxOrdering                string   `json:"txOrdering,omitempty"`                // transaction ordering policy enforced since the fork, fairshare or gasmanager
+}
+
+// IsVRF returns whether num is either equal to the VRF proposer selection fork block or greater.
+func (h *HotStuffConfig) IsVRF(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.VRFBlock, num)
+}
+
+// IsAggregateSeal returns whether num is either equal to the aggregated committed seal fork block or greater.
+func (h *HotStuffConfig) IsAggregateSeal(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.AggregateSealBlock, num)
+}
+
+// IsMaasConfig returns whether num is either equal to the maas_config enforcement fork block or greater.
+func (h *HotStuffConfig) IsMaasConfig(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.MaasConfigBlock, num)
+}
+
+// IsMaasInternalTransfer returns whether num is either equal to the internal transfer enforcement fork block or greater.
+func (h *HotStuffConfig) IsMaasInternalTransfer(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.MaasInternalTransferBlock, num)
+}
+
+// IsMaasListMigration returns whether num is the block that the maas_config address lists migrated at.
+func (h *HotStuffConfig) IsMaasListMigration(num *big.Int) bool {
+	if h == nil || h.MaasListMigrationBlock == nil || num == nil {
+		return false
+	}
+	return h.MaasListMigrationBlock.Cmp(num) == 0
+}
+
+// IsNativeGasMeter returns whether num is either equal to the native gas meter fork block or greater.
+func (h *HotStuffConfig) IsNativeGasMeter(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.NativeGasMeterBlock, num)
+}
+
+// IsNativeRevert returns whether num is either equal to the native revert fork block or greater.
+func (h *HotStuffConfig) IsNativeRevert(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.NativeRevertBlock, num)
+}
+
+// IsNativeRegistry returns whether num is either equal to the native registry fork block or greater.
+func (h *HotStuffConfig) IsNativeRegistry(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.NativeRegistryBlock, num)
+}
+
+// IsStaking returns whether num is either equal to the staking rewards fork block or greater.
+func (h *HotStuffConfig) IsStaking(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.StakingBlock, num)
+}
+
+// IsElection returns whether num is either equal to the epoch members election fork block or greater.
+func (h *HotStuffConfig) IsElection(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.ElectionBlock, num)
+}
+
+// IsEpochRotation returns whether num is either equal to the automatic epoch rotation fork block or greater.
+func (h *HotStuffConfig) IsEpochRotation(num *big.Int) bool {
+	if h == nil || h.EpochLength == 0 {
+		return false
+	}
+	return isForked(h.EpochRotationBlock, num)
+}
+
+// IsDowntimeJail returns whether num is either equal to the downtime jail fork block or greater.
+func (h *HotStuffConfig) IsDowntimeJail(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.DowntimeJailBlock, num)
+}
+
+// IsTxOrdering returns whether num is either equal to the transaction ordering fork block or greater.
+func (h *HotStuffConfig) IsTxOrdering(num *big.Int) bool {
+	if h == nil {
+		return false
+	}
+	return isForked(h.TxOrderingBlock, num)
+}
+
+// IsForkHeight returns whether height is the block switching to the fork validators.
+func (h *HotStuffConfig) IsForkHeight(height uint64) bool {
+	if h == nil || h.ForkHeight == 0 {
+		return false
+	}
+	return h.ForkHeight == height
 }
 
 func (h *HotStuffConfig) Decode(data []byte) error {
@@ -358,12 +557,89 @@ func (h *HotStuffConfig) Decode(data []byte) error {
 	return err
 }
 
+// LoadForkConfig overrides the fork parameters with the ones in file, the other fields are kept.
 func (h *HotStuffConfig) LoadForkConfig(hscPath string) error {
-	data, err := ioutil.ReadFile(hscPath)
+	fork, err := LoadHotStuffFork(hscPath)
 	if err != nil {
 		return err
 	}
-	return h.Decode(data)
+	h.HotStuffFork = *fork
+	return nil
+}
+
+// forkBlock returns the fork height as a fork block, nil if there is no fork.
+func (h *HotStuffConfig) forkBlock() *big.Int {
+	if h == nil || h.ForkHeight == 0 {
+		return nil
+	}
+	return new(big.Int).SetUint64(h.ForkHeight)
+}
+
+func (h *HotStuffConfig) checkCompatible(newcfg *HotStuffConfig, head *big.Int) *ConfigCompatError {
+	if h == nil {
+		h = new(HotStuffConfig)
+	}
+	if newcfg == nil {
+		newcfg = new(HotStuffConfig)
+	}
+	if isForkIncompatible(h.forkBlock(), newcfg.forkBlock(), head) {
+		return newCompatError("HotStuff fork height", h.forkBlock(), newcfg.forkBlock())
+	}
+	if isForked(h.forkBlock(), head) && !h.HotStuffFork.equal(&newcfg.HotStuffFork) {
+		return newCompatError("HotStuff fork validators", h.forkBlock(), newcfg.forkBlock())
+	}
+	for _, fork := range []struct {
+		name         string
+		stored, next *big.Int
+	}{
+		{"HotStuff vrf block", h.VRFBlock, newcfg.VRFBlock},
+		{"HotStuff aggregate seal block", h.AggregateSealBlock, newcfg.AggregateSealBlock},
+		{"HotStuff maas config block", h.MaasConfigBlock, newcfg.MaasConfigBlock},
+		{"HotStuff maas internal transfer block", h.MaasInternalTransferBlock, newcfg.MaasInternalTransferBlock},
+		{"HotStuff maas list migration block", h.MaasListMigrationBlock, newcfg.MaasListMigrationBlock},
+		{"HotStuff native gas meter block", h.NativeGasMeterBlock, newcfg.NativeGasMeterBlock},
+		{"HotStuff native revert block", h.NativeRevertBlock, newcfg.NativeRevertBlock},
+		{"HotStuff native registry block", h.NativeRegistryBlock, newcfg.NativeRegistryBlock},
+		{"HotStuff staking block", h.StakingBlock, newcfg.StakingBlock},
+		{"HotStuff election block", h.ElectionBlock, newcfg.ElectionBlock},
+		{"HotStuff epoch rotation block", h.EpochRotationBlock, newcfg.EpochRotationBlock},
+		{"HotStuff downtime jail block", h.DowntimeJailBlock, newcfg.DowntimeJailBlock},
+		{"HotStuff tx ordering block", h.TxOrderingBlock, newcfg.TxOrderingBlock},
+	} {
+		if isForkIncompatible(fork.stored, fork.next, head) {
+			return newCompatError(fork.name, fork.stored, fork.next)
+		}
+	}
+	// parameters of the forks which already took effect
+	if isForked(h.StakingBlock, head) && !configNumEqual(h.StakingBlockReward, newcfg.StakingBlockReward) {
+		return newCompatError("HotStuff staking block reward", h.StakingBlock, newcfg.StakingBlock)
+	}
+	if isForked(h.EpochRotationBlock, head) && h.EpochLength != newcfg.EpochLength {
+		return newCompatError("HotStuff epoch length", h.EpochRotationBlock, newcfg.EpochRotationBlock)
+	}
+	if isForked(h.TxOrderingBlock, head) && h.TxOrdering != newcfg.TxOrdering {
+		return newCompatError("HotStuff tx ordering", h.TxOrderingBlock, newcfg.TxOrderingBlock)
+	}
+	if isForked(h.AggregateSealBlock, head) {
+		if !blsKeysRetained(h.BLSPublicKeys, newcfg.BLSPublicKeys) {
+			return newCompatError("HotStuff BLS public keys", h.AggregateSealBlock, newcfg.AggregateSealBlock)
+		}
+		if !blsKeysRetained(h.BLSProofs, newcfg.BLSProofs) {
+			return newCompatError("HotStuff BLS proofs", h.AggregateSealBlock, newcfg.AggregateSealBlock)
+		}
+	}
+	return nil
+}
+
+// blsKeysRetained returns true if all the entries of stored are kept unchanged in next, new validators'
+// keys are allowed to be appended after the aggregated seal fork.
+func blsKeysRetained(stored, next map[common.Address]hexutil.Bytes) bool {
+	for addr, key := range stored {
+		if !bytes.Equal(key, next[addr]) {
+			return false
+		}
+	}
+	return true
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -551,6 +827,18 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	if c.HotStuff != nil {
+		if err := c.HotStuff.HotStuffFork.validate(); err != nil {
+			return err
+		}
+		if rotation, election := c.HotStuff.EpochRotationBlock, c.HotStuff.ElectionBlock; rotation != nil &&
+			(election == nil || election.Cmp(rotation) > 0) {
+			return fmt.Errorf("hotstuff epoch rotation at %v before election at %v", rotation, election)
+		}
+		if c.HotStuff.TxOrderingBlock != nil && c.HotStuff.TxOrdering == "" {
+			return fmt.Errorf("hotstuff tx ordering fork at %v without policy", c.HotStuff.TxOrderingBlock)
+		}
+	}
 	return nil
 }
 
@@ -604,6 +892,11 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.EWASMBlock, newcfg.EWASMBlock, head) {
 		return newCompatError("ewasm fork block", c.EWASMBlock, newcfg.EWASMBlock)
 	}
+	if c.HotStuff != nil || newcfg.HotStuff != nil {
+		if err := c.HotStuff.checkCompatible(newcfg.HotStuff, head); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -17,10 +17,13 @@
 package params
 
 import (
-	"github.com/stretchr/testify/assert"
 	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCheckCompatible(t *testing.T) {
@@ -105,3 +108,132 @@ func TestHotStuffConfig_LoadForkConfig(t *testing.T) {
 	t.Log("hsc ForkHeight", hsc.ForkHeight)
 	t.Log("hsc ForkValidators", hsc.ForkValidators)
 }
+
+func TestHotStuffConfig_IsMaasConfig(t *testing.T) {
+	var nilConfig *HotStuffConfig
+	assert.False(t, nilConfig.IsMaasConfig(big.NewInt(1)))
+	assert.False(t, nilConfig.IsMaasInternalTransfer(big.NewInt(1)))
+
+	hsc := &HotStuffConfig{MaasConfigBlock: big.NewInt(10)}
+	assert.False(t, hsc.IsMaasConfig(big.NewInt(9)))
+	assert.True(t, hsc.IsMaasConfig(big.NewInt(10)))
+	assert.False(t, hsc.IsMaasInternalTransfer(big.NewInt(10)))
+}
+
+func TestHotStuffConfig_IsVRF(t *testing.T) {
+	var nilConfig *HotStuffConfig
+	assert.False(t, nilConfig.IsVRF(big.NewInt(1)))
+
+	hsc := &HotStuffConfig{VRFBlock: big.NewInt(10)}
+	assert.False(t, hsc.IsVRF(big.NewInt(9)))
+	assert.True(t, hsc.IsVRF(big.NewInt(10)))
+	assert.Nil(t, (&ChainConfig{HotStuff: hsc}).CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{VRFBlock: big.NewInt(12)}}, 9))
+	assert.NotNil(t, (&ChainConfig{HotStuff: hsc}).CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{}}, 10))
+}
+
+func TestHotStuffForkCompatible(t *testing.T) {
+	fork := HotStuffFork{
+		ForkHeight:     18,
+		ForkValidators: []string{"0x2Fd775b2E8040dd69821C517e000A2DF2711F9cE", "0x7049e48af8B9cBeF78C4437682AfCa347aE7b900"},
+		ForkEpochId:    2,
+	}
+	stored := &ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: fork}}
+
+	otherValidators := fork
+	otherValidators.ForkValidators = fork.ForkValidators[:1]
+	assert.Nil(t, stored.CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: otherValidators}}, 17))
+	assert.Equal(t, &ConfigCompatError{
+		What:         "HotStuff fork validators",
+		StoredConfig: big.NewInt(18),
+		NewConfig:    big.NewInt(18),
+		RewindTo:     17,
+	}, stored.CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: otherValidators}}, 18))
+
+	otherHeight := fork
+	otherHeight.ForkHeight = 20
+	assert.Equal(t, &ConfigCompatError{
+		What:         "HotStuff fork height",
+		StoredConfig: big.NewInt(18),
+		NewConfig:    big.NewInt(20),
+		RewindTo:     17,
+	}, stored.CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: otherHeight}}, 19))
+	assert.NotNil(t, stored.CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{}}, 19))
+
+	stored.HotStuff.StakingBlock = big.NewInt(30)
+	assert.Nil(t, stored.CheckCompatible(stored, 100))
+	assert.NotNil(t, stored.CheckCompatible(&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: fork}}, 30))
+}
+
+func TestHotStuffParamsCompatible(t *testing.T) {
+	validator, other := common.HexToAddress("0x2Fd775b2E8040dd69821C517e000A2DF2711F9cE"), common.HexToAddress("0x7049e48af8B9cBeF78C4437682AfCa347aE7b900")
+	stored := &HotStuffConfig{
+		StakingBlock:       big.NewInt(10),
+		StakingBlockReward: big.NewInt(1e18),
+		EpochRotationBlock: big.NewInt(20),
+		EpochLength:        100,
+		AggregateSealBlock: big.NewInt(30),
+		BLSPublicKeys:      map[common.Address]hexutil.Bytes{validator: {0x01}},
+		BLSProofs:          map[common.Address]hexutil.Bytes{validator: {0x02}},
+	}
+	modify := func(f func(cfg *HotStuffConfig)) *ChainConfig {
+		cfg := *stored
+		f(&cfg)
+		return &ChainConfig{HotStuff: &cfg}
+	}
+	chain := &ChainConfig{HotStuff: stored}
+
+	reward := modify(func(cfg *HotStuffConfig) { cfg.StakingBlockReward = big.NewInt(2e18) })
+	assert.Nil(t, chain.CheckCompatible(reward, 9))
+	assert.Equal(t, &ConfigCompatError{
+		What:         "HotStuff staking block reward",
+		StoredConfig: big.NewInt(10),
+		NewConfig:    big.NewInt(10),
+		RewindTo:     9,
+	}, chain.CheckCompatible(reward, 10))
+
+	length := modify(func(cfg *HotStuffConfig) { cfg.EpochLength = 200 })
+	assert.Nil(t, chain.CheckCompatible(length, 19))
+	assert.Equal(t, "HotStuff epoch length", chain.CheckCompatible(length, 20).What)
+
+	// keys of new validators are allowed to be appended
+	appended := modify(func(cfg *HotStuffConfig) {
+		cfg.BLSPublicKeys = map[common.Address]hexutil.Bytes{validator: {0x01}, other: {0x03}}
+		cfg.BLSProofs = map[common.Address]hexutil.Bytes{validator: {0x02}, other: {0x04}}
+	})
+	assert.Nil(t, chain.CheckCompatible(appended, 30))
+	keys := modify(func(cfg *HotStuffConfig) { cfg.BLSPublicKeys = map[common.Address]hexutil.Bytes{validator: {0x03}} })
+	assert.Nil(t, chain.CheckCompatible(keys, 29))
+	assert.Equal(t, "HotStuff BLS public keys", chain.CheckCompatible(keys, 30).What)
+	proofs := modify(func(cfg *HotStuffConfig) { cfg.BLSProofs = nil })
+	assert.Equal(t, "HotStuff BLS proofs", chain.CheckCompatible(proofs, 30).What)
+
+	stored.TxOrderingBlock, stored.TxOrdering = big.NewInt(40), "fairshare"
+	ordering := modify(func(cfg *HotStuffConfig) { cfg.TxOrdering = "gasmanager" })
+	assert.Nil(t, chain.CheckCompatible(ordering, 39))
+	assert.Equal(t, "HotStuff tx ordering", chain.CheckCompatible(ordering, 40).What)
+	unscheduled := modify(func(cfg *HotStuffConfig) { cfg.TxOrderingBlock = nil })
+	assert.Equal(t, "HotStuff tx ordering block", chain.CheckCompatible(unscheduled, 40).What)
+}
+
+func TestHotStuffForkValidate(t *testing.T) {
+	assert.NoError(t, (&ChainConfig{HotStuff: &HotStuffConfig{}}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: HotStuffFork{ForkHeight: 1}}}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{HotStuff: &HotStuffConfig{TxOrderingBlock: big.NewInt(1)}}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{HotStuff: &HotStuffConfig{EpochRotationBlock: big.NewInt(1)}}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{HotStuff: &HotStuffConfig{EpochRotationBlock: big.NewInt(1), ElectionBlock: big.NewInt(2)}}).CheckConfigForkOrder())
+	assert.NoError(t, (&ChainConfig{HotStuff: &HotStuffConfig{EpochRotationBlock: big.NewInt(2), ElectionBlock: big.NewInt(2)}}).CheckConfigForkOrder())
+	assert.Error(t, (&ChainConfig{HotStuff: &HotStuffConfig{HotStuffFork: HotStuffFork{
+		ForkHeight: 1, ForkValidators: []string{"0x01", "invalid"},
+	}}}).CheckConfigForkOrder())
+
+	fork, err := LoadHotStuffFork("maasfork.json")
+	assert.NoError(t, err)
+	hsc := &HotStuffConfig{Protocol: "basic", StakingBlock: big.NewInt(1)}
+	assert.NoError(t, hsc.LoadForkConfig("maasfork.json"))
+	assert.Equal(t, *fork, hsc.HotStuffFork)
+	assert.Equal(t, "basic", hsc.Protocol)
+	assert.Equal(t, big.NewInt(1), hsc.StakingBlock)
+	assert.True(t, hsc.IsForkHeight(fork.ForkHeight))
+	assert.Len(t, hsc.Validators(), len(fork.ForkValidators))
+	assert.NoError(t, (&ChainConfig{HotStuff: hsc}).CheckConfigForkOrder())
+}